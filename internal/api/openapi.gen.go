@@ -2,7 +2,7 @@
 
 // Package api provides primitives to interact with the openapi HTTP API.
 //
-// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
 package api
 
 import (
@@ -12,6 +12,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
@@ -21,30 +22,296 @@ import (
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/oapi-codegen/runtime"
 	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Defines values for ApiKeyScope.
+const (
+	ApiKeyScopeAdmin ApiKeyScope = "admin"
+	ApiKeyScopeRead  ApiKeyScope = "read"
+	ApiKeyScopeWrite ApiKeyScope = "write"
+)
+
+// Defines values for AssignmentStrategy.
+const (
+	LeastLoaded AssignmentStrategy = "least_loaded"
+	Random      AssignmentStrategy = "random"
+)
+
+// Defines values for AuditResult.
+const (
+	Denied  AuditResult = "denied"
+	Failure AuditResult = "failure"
+	Success AuditResult = "success"
 )
 
 // Defines values for ErrorResponseErrorCode.
 const (
-	NOCANDIDATE ErrorResponseErrorCode = "NO_CANDIDATE"
-	NOTASSIGNED ErrorResponseErrorCode = "NOT_ASSIGNED"
-	NOTFOUND    ErrorResponseErrorCode = "NOT_FOUND"
-	PREXISTS    ErrorResponseErrorCode = "PR_EXISTS"
-	PRMERGED    ErrorResponseErrorCode = "PR_MERGED"
-	TEAMEXISTS  ErrorResponseErrorCode = "TEAM_EXISTS"
+	BADREQUEST      ErrorResponseErrorCode = "BAD_REQUEST"
+	FORBIDDEN       ErrorResponseErrorCode = "FORBIDDEN"
+	LOGINTAKEN      ErrorResponseErrorCode = "LOGIN_TAKEN"
+	NOCANDIDATE     ErrorResponseErrorCode = "NO_CANDIDATE"
+	NOTASSIGNED     ErrorResponseErrorCode = "NOT_ASSIGNED"
+	NOTFOUND        ErrorResponseErrorCode = "NOT_FOUND"
+	NOTPENDING      ErrorResponseErrorCode = "NOT_PENDING"
+	PRCLOSED        ErrorResponseErrorCode = "PR_CLOSED"
+	PREXISTS        ErrorResponseErrorCode = "PR_EXISTS"
+	PRMERGED        ErrorResponseErrorCode = "PR_MERGED"
+	TEAMEXISTS      ErrorResponseErrorCode = "TEAM_EXISTS"
+	TEAMINUSE       ErrorResponseErrorCode = "TEAM_IN_USE"
+	UNAUTHORIZED    ErrorResponseErrorCode = "UNAUTHORIZED"
+	USERINOTHERTEAM ErrorResponseErrorCode = "USER_IN_OTHER_TEAM"
+	VERSIONCONFLICT ErrorResponseErrorCode = "VERSION_CONFLICT"
+)
+
+// Defines values for ExternalAccountProvider.
+const (
+	Github ExternalAccountProvider = "github"
+	Gitlab ExternalAccountProvider = "gitlab"
+)
+
+// Defines values for MemberFairnessLoad.
+const (
+	Balanced MemberFairnessLoad = "balanced"
+	Over     MemberFairnessLoad = "over"
+	Under    MemberFairnessLoad = "under"
+)
+
+// Defines values for PrincipalKind.
+const (
+	PrincipalKindAdminToken PrincipalKind = "admin_token"
+	PrincipalKindApiKey     PrincipalKind = "api_key"
+	PrincipalKindJwt        PrincipalKind = "jwt"
 )
 
 // Defines values for PullRequestStatus.
 const (
+	PullRequestStatusCLOSED PullRequestStatus = "CLOSED"
 	PullRequestStatusMERGED PullRequestStatus = "MERGED"
 	PullRequestStatusOPEN   PullRequestStatus = "OPEN"
 )
 
 // Defines values for PullRequestShortStatus.
 const (
+	PullRequestShortStatusCLOSED PullRequestShortStatus = "CLOSED"
 	PullRequestShortStatusMERGED PullRequestShortStatus = "MERGED"
 	PullRequestShortStatusOPEN   PullRequestShortStatus = "OPEN"
 )
 
+// Defines values for RepositoryReviewerSource.
+const (
+	AuthorTeam RepositoryReviewerSource = "author_team"
+	OwnerTeam  RepositoryReviewerSource = "owner_team"
+)
+
+// Defines values for Role.
+const (
+	RoleAdmin    Role = "admin"
+	RoleMember   Role = "member"
+	RoleTeamLead Role = "team_lead"
+)
+
+// Defines values for ScheduledActivationStatus.
+const (
+	ScheduledActivationStatusAPPLIED   ScheduledActivationStatus = "APPLIED"
+	ScheduledActivationStatusCANCELLED ScheduledActivationStatus = "CANCELLED"
+	ScheduledActivationStatusFAILED    ScheduledActivationStatus = "FAILED"
+	ScheduledActivationStatusPENDING   ScheduledActivationStatus = "PENDING"
+)
+
+// Defines values for ScimMetaResourceType.
+const (
+	ScimMetaResourceTypeGroup ScimMetaResourceType = "Group"
+	ScimMetaResourceTypeUser  ScimMetaResourceType = "User"
+)
+
+// Defines values for WebhookResultStatus.
+const (
+	Ignored   WebhookResultStatus = "ignored"
+	Processed WebhookResultStatus = "processed"
+)
+
+// Defines values for ExportFormatQuery.
+const (
+	ExportFormatQueryCsv    ExportFormatQuery = "csv"
+	ExportFormatQueryJson   ExportFormatQuery = "json"
+	ExportFormatQueryNdjson ExportFormatQuery = "ndjson"
+)
+
+// Defines values for PostAdminImportParamsFormat.
+const (
+	PostAdminImportParamsFormatCsv  PostAdminImportParamsFormat = "csv"
+	PostAdminImportParamsFormatYaml PostAdminImportParamsFormat = "yaml"
+)
+
+// Defines values for GetStatsReviewGraphParamsFormat.
+const (
+	GetStatsReviewGraphParamsFormatDot  GetStatsReviewGraphParamsFormat = "dot"
+	GetStatsReviewGraphParamsFormatJson GetStatsReviewGraphParamsFormat = "json"
+)
+
+// Defines values for GetStatsReviewerAssignmentsParamsStatus.
+const (
+	CLOSED GetStatsReviewerAssignmentsParamsStatus = "CLOSED"
+	MERGED GetStatsReviewerAssignmentsParamsStatus = "MERGED"
+	OPEN   GetStatsReviewerAssignmentsParamsStatus = "OPEN"
+)
+
+// Defines values for GetStatsReviewerAssignmentsParamsGroupBy.
+const (
+	GetStatsReviewerAssignmentsParamsGroupByTeam GetStatsReviewerAssignmentsParamsGroupBy = "team"
+	GetStatsReviewerAssignmentsParamsGroupByWeek GetStatsReviewerAssignmentsParamsGroupBy = "week"
+)
+
+// Defines values for GetStatsReviewerAssignmentsParamsFormat.
+const (
+	GetStatsReviewerAssignmentsParamsFormatCsv    GetStatsReviewerAssignmentsParamsFormat = "csv"
+	GetStatsReviewerAssignmentsParamsFormatJson   GetStatsReviewerAssignmentsParamsFormat = "json"
+	GetStatsReviewerAssignmentsParamsFormatNdjson GetStatsReviewerAssignmentsParamsFormat = "ndjson"
+)
+
+// Defines values for GetStatsTurnaroundParamsGroupBy.
+const (
+	GetStatsTurnaroundParamsGroupByAuthor   GetStatsTurnaroundParamsGroupBy = "author"
+	GetStatsTurnaroundParamsGroupByReviewer GetStatsTurnaroundParamsGroupBy = "reviewer"
+	GetStatsTurnaroundParamsGroupByTeam     GetStatsTurnaroundParamsGroupBy = "team"
+)
+
+// Defines values for GetStatsTurnaroundParamsFormat.
+const (
+	GetStatsTurnaroundParamsFormatCsv    GetStatsTurnaroundParamsFormat = "csv"
+	GetStatsTurnaroundParamsFormatJson   GetStatsTurnaroundParamsFormat = "json"
+	GetStatsTurnaroundParamsFormatNdjson GetStatsTurnaroundParamsFormat = "ndjson"
+)
+
+// Defines values for DeleteTeamParamsPolicy.
+const (
+	Reassign DeleteTeamParamsPolicy = "reassign"
+	Refuse   DeleteTeamParamsPolicy = "refuse"
+)
+
+// Defines values for GetUsersGetReviewParamsFormat.
+const (
+	GetUsersGetReviewParamsFormatCsv    GetUsersGetReviewParamsFormat = "csv"
+	GetUsersGetReviewParamsFormatJson   GetUsersGetReviewParamsFormat = "json"
+	GetUsersGetReviewParamsFormatNdjson GetUsersGetReviewParamsFormat = "ndjson"
+)
+
+// Defines values for GetUsersListParamsSort.
+const (
+	UserId   GetUsersListParamsSort = "user_id"
+	Username GetUsersListParamsSort = "username"
+)
+
+// Defines values for GetUsersListParamsOrder.
+const (
+	Asc  GetUsersListParamsOrder = "asc"
+	Desc GetUsersListParamsOrder = "desc"
+)
+
+// Defines values for GetUsersListParamsFormat.
+const (
+	Csv    GetUsersListParamsFormat = "csv"
+	Json   GetUsersListParamsFormat = "json"
+	Ndjson GetUsersListParamsFormat = "ndjson"
+)
+
+// Defines values for GetUsersScheduledActivationsParamsStatus.
+const (
+	GetUsersScheduledActivationsParamsStatusAPPLIED   GetUsersScheduledActivationsParamsStatus = "APPLIED"
+	GetUsersScheduledActivationsParamsStatusCANCELLED GetUsersScheduledActivationsParamsStatus = "CANCELLED"
+	GetUsersScheduledActivationsParamsStatusFAILED    GetUsersScheduledActivationsParamsStatus = "FAILED"
+	GetUsersScheduledActivationsParamsStatusPENDING   GetUsersScheduledActivationsParamsStatus = "PENDING"
+)
+
+// AnonymizeResult defines model for AnonymizeResult.
+type AnonymizeResult struct {
+	AnonymizedAt time.Time                `json:"anonymized_at"`
+	Reassignment ReviewReassignmentResult `json:"reassignment"`
+	User         User                     `json:"user"`
+}
+
+// ApiKey defines model for ApiKey.
+type ApiKey struct {
+	CreatedAt  time.Time  `json:"created_at"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	Id         int64      `json:"id"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	Name       string     `json:"name"`
+
+	// Prefix Начало ключа, чтобы отличать ключи в списке
+	Prefix    string        `json:"prefix"`
+	RevokedAt *time.Time    `json:"revoked_at,omitempty"`
+	Scopes    []ApiKeyScope `json:"scopes"`
+}
+
+// ApiKeyCreateRequest defines model for ApiKeyCreateRequest.
+type ApiKeyCreateRequest struct {
+	// ExpiresAt Без поля ключ бессрочный
+	ExpiresAt *time.Time    `json:"expires_at,omitempty"`
+	Name      string        `json:"name"`
+	Scopes    []ApiKeyScope `json:"scopes"`
+}
+
+// ApiKeyScope read — чтение, write — чтение и изменения, admin — всё, включая админские операции
+type ApiKeyScope string
+
+// AssignmentStrategy Как выбирать ревьюверов среди кандидатов (по умолчанию random)
+type AssignmentStrategy string
+
+// AuditPage defines model for AuditPage.
+type AuditPage struct {
+	// NextCursor Курсор следующей страницы; отсутствует на последней странице
+	NextCursor *string       `json:"next_cursor,omitempty"`
+	Records    []AuditRecord `json:"records"`
+}
+
+// AuditRecord defines model for AuditRecord.
+type AuditRecord struct {
+	// Actor subject личности; отсутствует, если авторизация отключена или операция интеграционная
+	Actor       *string        `json:"actor,omitempty"`
+	ActorKind   *PrincipalKind `json:"actor_kind,omitempty"`
+	ActorUserId *string        `json:"actor_user_id,omitempty"`
+	ClientIp    string         `json:"client_ip"`
+	Id          int64          `json:"id"`
+	Method      string         `json:"method"`
+	OccurredAt  time.Time      `json:"occurred_at"`
+	OperationId string         `json:"operation_id"`
+	Path        string         `json:"path"`
+
+	// Payload Сводка запроса: параметры и тело, длинные строки и списки укорочены, поля с паролями, секретами и токенами скрыты, текстовое тело заменено длиной
+	Payload map[string]interface{} `json:"payload"`
+
+	// Result denied — отказ аутентификации или авторизации (401/403), failure — прочие ответы 4xx/5xx
+	Result AuditResult `json:"result"`
+
+	// Status HTTP-статус ответа
+	Status int `json:"status"`
+}
+
+// AuditResult denied — отказ аутентификации или авторизации (401/403), failure — прочие ответы 4xx/5xx
+type AuditResult string
+
+// AuthoredPullRequest defines model for AuthoredPullRequest.
+type AuthoredPullRequest struct {
+	PullRequest PullRequest `json:"pull_request"`
+
+	// Reviewers Назначенные ревьюверы и их активность
+	Reviewers []ReviewerState `json:"reviewers"`
+}
+
+// BusFactorHint Автор команды, чьи PR за период ревьюил только один человек
+type BusFactorHint struct {
+	AuthorId     string `json:"author_id"`
+	PullRequests int64  `json:"pull_requests"`
+	ReviewerId   string `json:"reviewer_id"`
+	TeamName     string `json:"team_name"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Error struct {
@@ -56,6 +323,62 @@ type ErrorResponse struct {
 // ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
 type ErrorResponseErrorCode string
 
+// ExternalAccount defines model for ExternalAccount.
+type ExternalAccount struct {
+	// Login Имя пользователя во внешней системе
+	Login string `json:"login"`
+
+	// Provider Внешняя система, в которой живёт учётная запись
+	Provider ExternalAccountProvider `json:"provider"`
+	UserId   string                  `json:"user_id"`
+}
+
+// ExternalAccountProvider Внешняя система, в которой живёт учётная запись
+type ExternalAccountProvider string
+
+// GitLabMergeRequestEvent Подмножество полей события Merge Request Hook, которые использует сервис
+type GitLabMergeRequestEvent struct {
+	Changes *struct {
+		Draft *struct {
+			Current  *bool `json:"current,omitempty"`
+			Previous *bool `json:"previous,omitempty"`
+		} `json:"draft,omitempty"`
+	} `json:"changes,omitempty"`
+	ObjectAttributes struct {
+		Action         *string `json:"action,omitempty"`
+		Draft          *bool   `json:"draft,omitempty"`
+		Iid            int64   `json:"iid"`
+		Title          string  `json:"title"`
+		WorkInProgress *bool   `json:"work_in_progress,omitempty"`
+	} `json:"object_attributes"`
+	ObjectKind string `json:"object_kind"`
+	Project    struct {
+		PathWithNamespace string `json:"path_with_namespace"`
+	} `json:"project"`
+	User struct {
+		Username string `json:"username"`
+	} `json:"user"`
+}
+
+// ImportError defines model for ImportError.
+type ImportError struct {
+	Field *string `json:"field,omitempty"`
+
+	// Line Номер строки документа (с единицы)
+	Line    int    `json:"line"`
+	Message string `json:"message"`
+}
+
+// ImportResult defines model for ImportResult.
+type ImportResult struct {
+	// Applied Изменения записаны в базу
+	Applied         bool          `json:"applied"`
+	DryRun          bool          `json:"dry_run"`
+	Errors          []ImportError `json:"errors"`
+	MembersUpserted int           `json:"members_upserted"`
+	TeamsCreated    int           `json:"teams_created"`
+}
+
 // MassDeactivateRequest defines model for MassDeactivateRequest.
 type MassDeactivateRequest struct {
 	// TeamName Имя команды, в которой нужно деактивировать пользователей
@@ -77,16 +400,68 @@ type MassDeactivateResult struct {
 	ReassignedCount int `json:"reassigned_count"`
 }
 
+// MemberFairness defines model for MemberFairness.
+type MemberFairness struct {
+	AssignedCount int64 `json:"assigned_count"`
+
+	// EqualShare Доля при равномерном распределении (1 / member_count)
+	EqualShare float64 `json:"equal_share"`
+
+	// Load over/under — ratio отклоняется от 1 больше чем на tolerance
+	Load MemberFairnessLoad `json:"load"`
+
+	// Ratio share / equal_share
+	Ratio float64 `json:"ratio"`
+
+	// Share Доля назначений команды, доставшаяся участнику
+	Share  float64 `json:"share"`
+	UserId string  `json:"user_id"`
+}
+
+// MemberFairnessLoad over/under — ratio отклоняется от 1 больше чем на tolerance
+type MemberFairnessLoad string
+
+// MoveTeamResult defines model for MoveTeamResult.
+type MoveTeamResult struct {
+	// OpenReviews Открытые ревью пользователя на момент перевода
+	OpenReviews      []PullRequestShort        `json:"open_reviews"`
+	PreviousTeamName string                    `json:"previous_team_name"`
+	Reassignment     *ReviewReassignmentResult `json:"reassignment,omitempty"`
+	User             User                      `json:"user"`
+}
+
+// Principal defines model for Principal.
+type Principal struct {
+	Kind      PrincipalKind `json:"kind"`
+	LeadTeams []string      `json:"lead_teams"`
+
+	// Role admin — всё; team_lead — управление своими командами; member — остальные
+	Role   Role          `json:"role"`
+	Scopes []ApiKeyScope `json:"scopes"`
+
+	// Subject sub из JWT, префикс API-ключа или admin-token
+	Subject string `json:"subject"`
+
+	// UserId Пользователь сервиса; есть только у JWT
+	UserId *string `json:"user_id,omitempty"`
+}
+
+// PrincipalKind defines model for PrincipalKind.
+type PrincipalKind string
+
 // PullRequest defines model for PullRequest.
 type PullRequest struct {
 	// AssignedReviewers user_id назначенных ревьюверов (0..2)
-	AssignedReviewers []string          `json:"assigned_reviewers"`
-	AuthorId          string            `json:"author_id"`
-	CreatedAt         *time.Time        `json:"createdAt"`
-	MergedAt          *time.Time        `json:"mergedAt"`
-	PullRequestId     string            `json:"pull_request_id"`
-	PullRequestName   string            `json:"pull_request_name"`
-	Status            PullRequestStatus `json:"status"`
+	AssignedReviewers []string   `json:"assigned_reviewers"`
+	AuthorId          string     `json:"author_id"`
+	CreatedAt         *time.Time `json:"createdAt"`
+	MergedAt          *time.Time `json:"mergedAt"`
+	PullRequestId     string     `json:"pull_request_id"`
+	PullRequestName   string     `json:"pull_request_name"`
+
+	// Repository Репозиторий, к которому относится PR
+	Repository *string           `json:"repository"`
+	Status     PullRequestStatus `json:"status"`
 }
 
 // PullRequestStatus defines model for PullRequest.Status.
@@ -103,768 +478,7135 @@ type PullRequestShort struct {
 // PullRequestShortStatus defines model for PullRequestShort.Status.
 type PullRequestShortStatus string
 
-// ReviewerStat defines model for ReviewerStat.
-type ReviewerStat struct {
-	AssignedCount int64  `json:"assigned_count"`
-	UserId        string `json:"user_id"`
-}
+// Repository defines model for Repository.
+type Repository struct {
+	// AssignmentStrategy Как выбирать ревьюверов среди кандидатов (по умолчанию random)
+	AssignmentStrategy *AssignmentStrategy `json:"assignment_strategy,omitempty"`
+	RepositoryId       string              `json:"repository_id"`
 
-// Team defines model for Team.
-type Team struct {
-	Members  []TeamMember `json:"members"`
-	TeamName string       `json:"team_name"`
-}
+	// ReviewerCount Сколько ревьюверов назначать (по умолчанию 2)
+	ReviewerCount *int `json:"reviewer_count"`
 
-// TeamMember defines model for TeamMember.
-type TeamMember struct {
-	IsActive bool   `json:"is_active"`
-	UserId   string `json:"user_id"`
-	Username string `json:"username"`
-}
+	// ReviewerSource Из какой команды брать ревьюверов — автора PR или владельца репозитория
+	ReviewerSource RepositoryReviewerSource `json:"reviewer_source"`
 
-// User defines model for User.
-type User struct {
-	IsActive bool   `json:"is_active"`
+	// TeamName Команда-владелец репозитория
 	TeamName string `json:"team_name"`
-	UserId   string `json:"user_id"`
-	Username string `json:"username"`
 }
 
-// TeamNameQuery defines model for TeamNameQuery.
-type TeamNameQuery = string
-
-// UserIdQuery defines model for UserIdQuery.
-type UserIdQuery = string
+// RepositoryReviewerSource Из какой команды брать ревьюверов — автора PR или владельца репозитория
+type RepositoryReviewerSource string
 
-// PostPullRequestCreateJSONBody defines parameters for PostPullRequestCreate.
-type PostPullRequestCreateJSONBody struct {
-	AuthorId        string `json:"author_id"`
-	PullRequestId   string `json:"pull_request_id"`
-	PullRequestName string `json:"pull_request_name"`
+// ReviewCounts Назначения ревью; за 30 дней — на PR, созданные за последние 30 дней
+type ReviewCounts struct {
+	AllTime    int64 `json:"all_time"`
+	Last30Days int64 `json:"last_30_days"`
 }
 
-// PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
-type PostPullRequestMergeJSONBody struct {
-	PullRequestId string `json:"pull_request_id"`
+// ReviewGraph defines model for ReviewGraph.
+type ReviewGraph struct {
+	BusFactor []BusFactorHint   `json:"bus_factor"`
+	Edges     []ReviewGraphEdge `json:"edges"`
 }
 
-// PostPullRequestReassignJSONBody defines parameters for PostPullRequestReassign.
-type PostPullRequestReassignJSONBody struct {
-	OldUserId     string `json:"old_user_id"`
-	PullRequestId string `json:"pull_request_id"`
+// ReviewGraphEdge defines model for ReviewGraphEdge.
+type ReviewGraphEdge struct {
+	AuthorId   string `json:"author_id"`
+	ReviewerId string `json:"reviewer_id"`
+
+	// Weight Число PR автора, на которые назначался ревьювер
+	Weight int64 `json:"weight"`
 }
 
-// GetTeamGetParams defines parameters for GetTeamGet.
-type GetTeamGetParams struct {
-	// TeamName Уникальное имя команды
-	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+// ReviewReassignmentResult defines model for ReviewReassignmentResult.
+type ReviewReassignmentResult struct {
+	// NotReassignedCount Количество открытых ревью, для которых не нашлось замены
+	NotReassignedCount int `json:"not_reassigned_count"`
+
+	// ReassignedCount Количество открытых ревью, переданных другому ревьюверу
+	ReassignedCount int `json:"reassigned_count"`
 }
 
-// GetUsersGetReviewParams defines parameters for GetUsersGetReview.
-type GetUsersGetReviewParams struct {
-	// UserId Идентификатор пользователя
-	UserId UserIdQuery `form:"user_id" json:"user_id"`
+// ReviewerStat defines model for ReviewerStat.
+type ReviewerStat struct {
+	AssignedCount int64 `json:"assigned_count"`
+
+	// TeamName Команда ревьювера при group_by=team
+	TeamName *string `json:"team_name,omitempty"`
+	UserId   string  `json:"user_id"`
+
+	// WeekStart Понедельник недели создания PR при group_by=week
+	WeekStart *openapi_types.Date `json:"week_start,omitempty"`
 }
 
-// PostUsersSetIsActiveJSONBody defines parameters for PostUsersSetIsActive.
-type PostUsersSetIsActiveJSONBody struct {
+// ReviewerState defines model for ReviewerState.
+type ReviewerState struct {
 	IsActive bool   `json:"is_active"`
 	UserId   string `json:"user_id"`
+	Username string `json:"username"`
 }
 
-// PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
-type PostPullRequestCreateJSONRequestBody PostPullRequestCreateJSONBody
+// Role admin — всё; team_lead — управление своими командами; member — остальные
+type Role string
 
-// PostPullRequestMergeJSONRequestBody defines body for PostPullRequestMerge for application/json ContentType.
-type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
+// ScheduledActivation defines model for ScheduledActivation.
+type ScheduledActivation struct {
+	AppliedAt   *time.Time `json:"applied_at,omitempty"`
+	Comment     *string    `json:"comment,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	EffectiveAt time.Time  `json:"effective_at"`
 
-// PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
-type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
+	// FailureReason Почему изменение не применилось (для статуса FAILED)
+	FailureReason *string `json:"failure_reason,omitempty"`
+	Id            int64   `json:"id"`
 
-// PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
-type PostTeamAddJSONRequestBody = Team
+	// IsActive Значение is_active, которое получит пользователь
+	IsActive bool                      `json:"is_active"`
+	Status   ScheduledActivationStatus `json:"status"`
+	UserId   string                    `json:"user_id"`
+}
 
-// PostTeamMassDeactivateJSONRequestBody defines body for PostTeamMassDeactivate for application/json ContentType.
-type PostTeamMassDeactivateJSONRequestBody = MassDeactivateRequest
+// ScheduledActivationStatus defines model for ScheduledActivation.Status.
+type ScheduledActivationStatus string
 
-// PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
-type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
+// ScimError defines model for ScimError.
+type ScimError struct {
+	Detail   *string  `json:"detail,omitempty"`
+	Schemas  []string `json:"schemas"`
+	ScimType *string  `json:"scimType,omitempty"`
+	Status   string   `json:"status"`
+}
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
-	// (POST /pullRequest/create)
-	PostPullRequestCreate(w http.ResponseWriter, r *http.Request)
-	// Пометить PR как MERGED (идемпотентная операция)
-	// (POST /pullRequest/merge)
-	PostPullRequestMerge(w http.ResponseWriter, r *http.Request)
-	// Переназначить конкретного ревьювера на другого из его команды
-	// (POST /pullRequest/reassign)
-	PostPullRequestReassign(w http.ResponseWriter, r *http.Request)
-	// Получить количество назначений ревью по пользователям
-	// (GET /stats/reviewerAssignments)
-	GetStatsReviewerAssignments(w http.ResponseWriter, r *http.Request)
-	// Создать команду с участниками (создаёт/обновляет пользователей)
-	// (POST /team/add)
-	PostTeamAdd(w http.ResponseWriter, r *http.Request)
-	// Получить команду с участниками
-	// (GET /team/get)
-	GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams)
-	// Массово деактивировать пользователей команды и безопасно переназначить открытые PR
-	// (POST /team/massDeactivate)
-	PostTeamMassDeactivate(w http.ResponseWriter, r *http.Request)
-	// Получить PR'ы, где пользователь назначен ревьювером
-	// (GET /users/getReview)
-	GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams)
-	// Установить флаг активности пользователя
-	// (POST /users/setIsActive)
-	PostUsersSetIsActive(w http.ResponseWriter, r *http.Request)
+// ScimGroup Группа SCIM; id и displayName совпадают с именем команды
+type ScimGroup struct {
+	DisplayName string           `json:"displayName"`
+	Id          *string          `json:"id,omitempty"`
+	Members     *[]ScimMemberRef `json:"members,omitempty"`
+	Meta        *ScimMeta        `json:"meta,omitempty"`
+	Schemas     []string         `json:"schemas"`
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+// ScimGroupList defines model for ScimGroupList.
+type ScimGroupList struct {
+	Resources    []ScimGroup `json:"Resources"`
+	ItemsPerPage int         `json:"itemsPerPage"`
+	Schemas      []string    `json:"schemas"`
+	StartIndex   int         `json:"startIndex"`
+	TotalResults int         `json:"totalResults"`
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+// ScimMemberRef defines model for ScimMemberRef.
+type ScimMemberRef struct {
+	Display *string `json:"display,omitempty"`
 
-// PostPullRequestCreate operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
+	// Value user_id участника или имя команды
+	Value string `json:"value"`
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestCreate(w, r)
-	}))
+// ScimMeta defines model for ScimMeta.
+type ScimMeta struct {
+	Location     *string              `json:"location,omitempty"`
+	ResourceType ScimMetaResourceType `json:"resourceType"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// ScimMetaResourceType defines model for ScimMeta.ResourceType.
+type ScimMetaResourceType string
 
-	handler.ServeHTTP(w, r)
+// ScimPatchOperation defines model for ScimPatchOperation.
+type ScimPatchOperation struct {
+	// Op add, remove или replace (без учёта регистра)
+	Op    string       `json:"op"`
+	Path  *string      `json:"path,omitempty"`
+	Value *interface{} `json:"value,omitempty"`
 }
 
-// PostPullRequestMerge operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestMerge(w, r)
-	}))
+// ScimPatchRequest defines model for ScimPatchRequest.
+type ScimPatchRequest struct {
+	Operations []ScimPatchOperation `json:"Operations"`
+	Schemas    []string             `json:"schemas"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// ScimUser Пользователь SCIM; id совпадает с user_id, active — с is_active
+type ScimUser struct {
+	Active *bool `json:"active,omitempty"`
+
+	// ExternalId При создании становится user_id; без него user_id равен userName
+	ExternalId *string          `json:"externalId,omitempty"`
+	Groups     *[]ScimMemberRef `json:"groups,omitempty"`
+	Id         *string          `json:"id,omitempty"`
+	Meta       *ScimMeta        `json:"meta,omitempty"`
+	Schemas    []string         `json:"schemas"`
+	UserName   string           `json:"userName"`
+}
 
-	handler.ServeHTTP(w, r)
+// ScimUserList defines model for ScimUserList.
+type ScimUserList struct {
+	Resources    []ScimUser `json:"Resources"`
+	ItemsPerPage int        `json:"itemsPerPage"`
+	Schemas      []string   `json:"schemas"`
+	StartIndex   int        `json:"startIndex"`
+	TotalResults int        `json:"totalResults"`
 }
 
-// PostPullRequestReassign operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
+// Team defines model for Team.
+type Team struct {
+	Members []TeamMember `json:"members"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestReassign(w, r)
-	}))
+	// ParentTeamName Родительское подразделение
+	ParentTeamName *string `json:"parent_team_name,omitempty"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Policy Настройки назначения ревьюверов команды; незаданные поля наследуются от родительской команды
+	Policy   *TeamPolicy `json:"policy,omitempty"`
+	TeamName string      `json:"team_name"`
+}
 
-	handler.ServeHTTP(w, r)
+// TeamDeleteResult defines model for TeamDeleteResult.
+type TeamDeleteResult struct {
+	// DetachedCount Сколько участников осталось без команды
+	DetachedCount      int    `json:"detached_count"`
+	NotReassignedCount int    `json:"not_reassigned_count"`
+	ReassignedCount    int    `json:"reassigned_count"`
+	TeamName           string `json:"team_name"`
 }
 
-// GetStatsReviewerAssignments operation middleware
-func (siw *ServerInterfaceWrapper) GetStatsReviewerAssignments(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetStatsReviewerAssignments(w, r)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// TeamFairness defines model for TeamFairness.
+type TeamFairness struct {
+	// Gini Коэффициент Джини (0 — поровну, ближе к 1 — всё у одного)
+	Gini        float64          `json:"gini"`
+	Max         int64            `json:"max"`
+	Mean        float64          `json:"mean"`
+	MemberCount int              `json:"member_count"`
+	Members     []MemberFairness `json:"members"`
+	Min         int64            `json:"min"`
+
+	// Stddev Стандартное отклонение по генеральной совокупности
+	Stddev           float64 `json:"stddev"`
+	TeamName         string  `json:"team_name"`
+	TotalAssignments int64   `json:"total_assignments"`
+}
 
-	handler.ServeHTTP(w, r)
+// TeamLead defines model for TeamLead.
+type TeamLead struct {
+	GrantedAt time.Time `json:"granted_at"`
+	TeamName  string    `json:"team_name"`
+	UserId    string    `json:"user_id"`
 }
 
-// PostTeamAdd operation middleware
-func (siw *ServerInterfaceWrapper) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+// TeamLeadRequest defines model for TeamLeadRequest.
+type TeamLeadRequest struct {
+	TeamName string `json:"team_name"`
+	UserId   string `json:"user_id"`
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostTeamAdd(w, r)
-	}))
+// TeamListPage defines model for TeamListPage.
+type TeamListPage struct {
+	// NextCursor Курсор следующей страницы; отсутствует на последней странице
+	NextCursor *string       `json:"next_cursor,omitempty"`
+	Teams      []TeamSummary `json:"teams"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// TeamMember defines model for TeamMember.
+type TeamMember struct {
+	IsActive bool `json:"is_active"`
 
-	handler.ServeHTTP(w, r)
+	// IsPrimary Команда является основной для участника (к ней относятся его PR)
+	IsPrimary *bool  `json:"is_primary,omitempty"`
+	UserId    string `json:"user_id"`
+	Username  string `json:"username"`
 }
 
-// GetTeamGet operation middleware
-func (siw *ServerInterfaceWrapper) GetTeamGet(w http.ResponseWriter, r *http.Request) {
+// TeamPolicy Настройки назначения ревьюверов команды; незаданные поля наследуются от родительской команды
+type TeamPolicy struct {
+	// AssignmentStrategy Как выбирать ревьюверов среди кандидатов (по умолчанию random)
+	AssignmentStrategy *AssignmentStrategy `json:"assignment_strategy,omitempty"`
 
-	var err error
+	// ReviewSlaHours Ожидаемый срок ревью в часах
+	ReviewSlaHours *int `json:"review_sla_hours,omitempty"`
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTeamGetParams
+	// ReviewerCount Сколько ревьюверов назначать (по умолчанию 2)
+	ReviewerCount *int `json:"reviewer_count,omitempty"`
 
-	// ------------- Required query parameter "team_name" -------------
+	// SiblingFallback Добирать недостающих кандидатов из соседних и родительских команд (по умолчанию false)
+	SiblingFallback *bool `json:"sibling_fallback,omitempty"`
+}
 
-	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+// TeamSettings defines model for TeamSettings.
+type TeamSettings struct {
+	Comment *string `json:"comment,omitempty"`
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
-		return
-	}
+	// Effective Настройки назначения ревьюверов команды; незаданные поля наследуются от родительской команды
+	Effective TeamPolicy `json:"effective"`
 
-	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
-		return
-	}
+	// Settings Настройки назначения ревьюверов команды; незаданные поля наследуются от родительской команды
+	Settings  TeamPolicy `json:"settings"`
+	TeamName  string     `json:"team_name"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTeamGet(w, r, params)
-	}))
+	// Version Номер версии настроек; 0 — настройки команды ещё не сохранялись
+	Version int `json:"version"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// TeamSettingsRollbackRequest defines model for TeamSettingsRollbackRequest.
+type TeamSettingsRollbackRequest struct {
+	Comment         *string `json:"comment,omitempty"`
+	ExpectedVersion *int    `json:"expected_version,omitempty"`
+	TeamName        string  `json:"team_name"`
 
-	handler.ServeHTTP(w, r)
+	// Version Версия, настройки которой станут действующими
+	Version int `json:"version"`
 }
 
-// PostTeamMassDeactivate operation middleware
-func (siw *ServerInterfaceWrapper) PostTeamMassDeactivate(w http.ResponseWriter, r *http.Request) {
+// TeamSettingsUpdateRequest defines model for TeamSettingsUpdateRequest.
+type TeamSettingsUpdateRequest struct {
+	Comment *string `json:"comment,omitempty"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostTeamMassDeactivate(w, r)
-	}))
+	// ExpectedVersion Текущая версия, на которую рассчитано изменение; при расхождении — 409 VERSION_CONFLICT
+	ExpectedVersion *int `json:"expected_version,omitempty"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Settings Настройки назначения ревьюверов команды; незаданные поля наследуются от родительской команды
+	Settings TeamPolicy `json:"settings"`
+	TeamName string     `json:"team_name"`
+}
 
-	handler.ServeHTTP(w, r)
+// TeamSettingsVersion defines model for TeamSettingsVersion.
+type TeamSettingsVersion struct {
+	Comment   *string   `json:"comment,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// Settings Настройки назначения ревьюверов команды; незаданные поля наследуются от родительской команды
+	Settings TeamPolicy `json:"settings"`
+	TeamName string     `json:"team_name"`
+	Version  int        `json:"version"`
 }
 
-// GetUsersGetReview operation middleware
-func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *http.Request) {
+// TeamSummary defines model for TeamSummary.
+type TeamSummary struct {
+	ActiveMemberCount int       `json:"active_member_count"`
+	CreatedAt         time.Time `json:"created_at"`
 
-	var err error
+	// MemberCount Участники команды, включая тех, для кого она дополнительная
+	MemberCount int `json:"member_count"`
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetUsersGetReviewParams
+	// OpenPrCount Открытые PR, авторы которых состоят в команде как в основной
+	OpenPrCount    int     `json:"open_pr_count"`
+	ParentTeamName *string `json:"parent_team_name,omitempty"`
+	TeamName       string  `json:"team_name"`
+}
 
-	// ------------- Required query parameter "user_id" -------------
+// TeamTreeNode defines model for TeamTreeNode.
+type TeamTreeNode struct {
+	Children []TeamTreeNode `json:"children"`
 
-	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+	// EffectivePolicy Настройки назначения ревьюверов команды; незаданные поля наследуются от родительской команды
+	EffectivePolicy TeamPolicy `json:"effective_policy"`
+	ParentTeamName  *string    `json:"parent_team_name,omitempty"`
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
-		return
-	}
+	// Policy Настройки назначения ревьюверов команды; незаданные поля наследуются от родительской команды
+	Policy   TeamPolicy `json:"policy"`
+	TeamName string     `json:"team_name"`
+}
 
-	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
-		return
-	}
+// TeamUpdateRequest defines model for TeamUpdateRequest.
+type TeamUpdateRequest struct {
+	AddMembers *[]TeamMember `json:"add_members,omitempty"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUsersGetReview(w, r, params)
-	}))
+	// AllowSecondaryTeam Добавить команду дополнительной для добавляемых участников из другой команды
+	AllowSecondaryTeam *bool `json:"allow_secondary_team,omitempty"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// AllowTeamMove Сделать команду основной для добавляемых участников из другой команды
+	AllowTeamMove *bool `json:"allow_team_move,omitempty"`
 
-	handler.ServeHTTP(w, r)
+	// Policy Настройки назначения ревьюверов команды; незаданные поля наследуются от родительской команды
+	Policy *TeamPolicy `json:"policy,omitempty"`
+
+	// RemoveMembers user_id участников, которых нужно исключить из команды; их открытые ревью на PR команды переназначаются на оставшихся участников
+	RemoveMembers *[]string `json:"remove_members,omitempty"`
+	TeamName      string    `json:"team_name"`
 }
 
-// PostUsersSetIsActive operation middleware
-func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
+// TurnaroundStat defines model for TurnaroundStat.
+type TurnaroundStat struct {
+	// Key Имя команды, user_id автора или ревьювера — в зависимости от group_by
+	Key           string  `json:"key"`
+	MeanSeconds   float64 `json:"mean_seconds"`
+	MedianSeconds float64 `json:"median_seconds"`
+	MergedCount   int64   `json:"merged_count"`
+	P90Seconds    float64 `json:"p90_seconds"`
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostUsersSetIsActive(w, r)
-	}))
+// User defines model for User.
+type User struct {
+	IsActive bool `json:"is_active"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// TeamName Основная команда пользователя
+	TeamName string `json:"team_name"`
 
-	handler.ServeHTTP(w, r)
+	// Teams Все команды пользователя (возвращается справочником пользователей)
+	Teams    *[]string `json:"teams,omitempty"`
+	UserId   string    `json:"user_id"`
+	Username string    `json:"username"`
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
+// UserAvailability defines model for UserAvailability.
+type UserAvailability struct {
+	IsActive   bool                 `json:"is_active"`
+	NextChange *ScheduledActivation `json:"next_change,omitempty"`
 }
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+// UserDashboard defines model for UserDashboard.
+type UserDashboard struct {
+	// Authored Открытые PR пользователя, от самых старых
+	Authored     []AuthoredPullRequest `json:"authored"`
+	Availability UserAvailability      `json:"availability"`
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	// OpenReviews Открытые PR, где пользователь ревьювер, от самых старых
+	OpenReviews []PullRequest `json:"open_reviews"`
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
+	// ReviewCounts Назначения ревью; за 30 дней — на PR, созданные за последние 30 дней
+	ReviewCounts ReviewCounts `json:"review_counts"`
+	User         User         `json:"user"`
 }
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+// UserListPage defines model for UserListPage.
+type UserListPage struct {
+	// NextCursor Курсор следующей страницы; отсутствует на последней странице
+	NextCursor *string `json:"next_cursor,omitempty"`
+	Users      []User  `json:"users"`
 }
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+// WebhookResult defines model for WebhookResult.
+type WebhookResult struct {
+	PullRequestId *string `json:"pull_request_id,omitempty"`
 
-type RequiredParamError struct {
-	ParamName string
+	// Reason Причина, по которой событие было пропущено
+	Reason *string             `json:"reason,omitempty"`
+	Status WebhookResultStatus `json:"status"`
 }
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+// WebhookResultStatus defines model for WebhookResult.Status.
+type WebhookResultStatus string
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
+// AllowSecondaryTeamQuery defines model for AllowSecondaryTeamQuery.
+type AllowSecondaryTeamQuery = bool
+
+// AllowTeamMoveQuery defines model for AllowTeamMoveQuery.
+type AllowTeamMoveQuery = bool
+
+// ExportFormatQuery defines model for ExportFormatQuery.
+type ExportFormatQuery string
+
+// RepositoryIdQuery defines model for RepositoryIdQuery.
+type RepositoryIdQuery = string
+
+// ScimCountQuery defines model for ScimCountQuery.
+type ScimCountQuery = int
+
+// ScimFilterQuery defines model for ScimFilterQuery.
+type ScimFilterQuery = string
+
+// ScimIdPath defines model for ScimIdPath.
+type ScimIdPath = string
+
+// ScimStartIndexQuery defines model for ScimStartIndexQuery.
+type ScimStartIndexQuery = int
+
+// TeamNameQuery defines model for TeamNameQuery.
+type TeamNameQuery = string
+
+// UserIdQuery defines model for UserIdQuery.
+type UserIdQuery = string
+
+// GetAdminApiKeysParams defines parameters for GetAdminApiKeys.
+type GetAdminApiKeysParams struct {
+	IncludeRevoked *bool `form:"include_revoked,omitempty" json:"include_revoked,omitempty"`
 }
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+// PostAdminApiKeysRevokeJSONBody defines parameters for PostAdminApiKeysRevoke.
+type PostAdminApiKeysRevokeJSONBody struct {
+	Id int64 `json:"id"`
 }
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
+// GetAdminAuditParams defines parameters for GetAdminAudit.
+type GetAdminAuditParams struct {
+	// Actor subject или user_id вызывающего
+	Actor       *string      `form:"actor,omitempty" json:"actor,omitempty"`
+	OperationId *string      `form:"operation_id,omitempty" json:"operation_id,omitempty"`
+	Result      *AuditResult `form:"result,omitempty" json:"result,omitempty"`
+
+	// From Начало интервала (включительно)
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец интервала (не включительно)
+	To    *time.Time `form:"to,omitempty" json:"to,omitempty"`
+	Limit *int       `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor next_cursor из предыдущего ответа; передаётся с теми же фильтрами
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
+// PostAdminImportTextBody defines parameters for PostAdminImport.
+type PostAdminImportTextBody = string
+
+// PostAdminImportParams defines parameters for PostAdminImport.
+type PostAdminImportParams struct {
+	// Format Формат документа в теле запроса
+	Format PostAdminImportParamsFormat `form:"format" json:"format"`
+
+	// DryRun Только проверить документ
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
 }
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+// PostAdminImportParamsFormat defines parameters for PostAdminImport.
+type PostAdminImportParamsFormat string
+
+// PostIntegrationsGitlabWebhookParams defines parameters for PostIntegrationsGitlabWebhook.
+type PostIntegrationsGitlabWebhookParams struct {
+	// XGitlabToken Секрет вебхука (GITLAB_WEBHOOK_TOKEN)
+	XGitlabToken *string `json:"X-Gitlab-Token,omitempty"`
+	XGitlabEvent *string `json:"X-Gitlab-Event,omitempty"`
 }
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
+// PostPullRequestCreateJSONBody defines parameters for PostPullRequestCreate.
+type PostPullRequestCreateJSONBody struct {
+	AuthorId        string `json:"author_id"`
+	PullRequestId   string `json:"pull_request_id"`
+	PullRequestName string `json:"pull_request_name"`
+
+	// Repository Репозиторий PR; его политика определяет число и источник ревьюверов
+	Repository *string `json:"repository,omitempty"`
 }
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
+// PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
+type PostPullRequestMergeJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
 }
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+// PostPullRequestReassignJSONBody defines parameters for PostPullRequestReassign.
+type PostPullRequestReassignJSONBody struct {
+	OldUserId     string `json:"old_user_id"`
+	PullRequestId string `json:"pull_request_id"`
 }
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{})
+// GetRepositoryGetParams defines parameters for GetRepositoryGet.
+type GetRepositoryGetParams struct {
+	// RepositoryId Идентификатор репозитория (например, backend/api)
+	RepositoryId RepositoryIdQuery `form:"repository_id" json:"repository_id"`
 }
 
-// ServeMux is an abstraction of http.ServeMux.
-type ServeMux interface {
-	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
-	ServeHTTP(w http.ResponseWriter, r *http.Request)
+// GetScimV2GroupsParams defines parameters for GetScimV2Groups.
+type GetScimV2GroupsParams struct {
+	// Filter Выражения вида `attr eq value`, объединённые через `and`
+	Filter     *ScimFilterQuery     `form:"filter,omitempty" json:"filter,omitempty"`
+	StartIndex *ScimStartIndexQuery `form:"startIndex,omitempty" json:"startIndex,omitempty"`
+	Count      *ScimCountQuery      `form:"count,omitempty" json:"count,omitempty"`
 }
 
-type StdHTTPServerOptions struct {
-	BaseURL          string
-	BaseRouter       ServeMux
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+// GetScimV2UsersParams defines parameters for GetScimV2Users.
+type GetScimV2UsersParams struct {
+	// Filter Выражения вида `attr eq value`, объединённые через `and`
+	Filter     *ScimFilterQuery     `form:"filter,omitempty" json:"filter,omitempty"`
+	StartIndex *ScimStartIndexQuery `form:"startIndex,omitempty" json:"startIndex,omitempty"`
+	Count      *ScimCountQuery      `form:"count,omitempty" json:"count,omitempty"`
 }
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{
-		BaseRouter: m,
-	})
+// GetStatsFairnessParams defines parameters for GetStatsFairness.
+type GetStatsFairnessParams struct {
+	// Team Команда; отчёт строится по ней и всем вложенным командам. Без параметра — по всем командам
+	Team *string    `form:"team,omitempty" json:"team,omitempty"`
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+	To   *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Tolerance Допустимое отклонение ratio от 1, после которого участник помечается как over/under
+	Tolerance *float64 `form:"tolerance,omitempty" json:"tolerance,omitempty"`
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: m,
-	})
+// GetStatsReviewGraphParams defines parameters for GetStatsReviewGraph.
+type GetStatsReviewGraphParams struct {
+	// Team Только PR авторов из команды и вложенных в неё команд
+	Team *string    `form:"team,omitempty" json:"team,omitempty"`
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+	To   *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Format Без параметра формат выбирается по заголовку Accept (text/vnd.graphviz), иначе json
+	Format *GetStatsReviewGraphParamsFormat `form:"format,omitempty" json:"format,omitempty"`
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
-	m := options.BaseRouter
+// GetStatsReviewGraphParamsFormat defines parameters for GetStatsReviewGraph.
+type GetStatsReviewGraphParamsFormat string
 
-	if m == nil {
-		m = http.NewServeMux()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
+// GetStatsReviewerAssignmentsParams defines parameters for GetStatsReviewerAssignments.
+type GetStatsReviewerAssignmentsParams struct {
+	// Repository Учитывать только PR указанного репозитория
+	Repository *string `form:"repository,omitempty" json:"repository,omitempty"`
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+	// Team Учитывать только ревьюверов команды и всех вложенных в неё команд
+	Team *string `form:"team,omitempty" json:"team,omitempty"`
 
-	m.HandleFunc("POST "+options.BaseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
-	m.HandleFunc("POST "+options.BaseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
-	m.HandleFunc("POST "+options.BaseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
-	m.HandleFunc("GET "+options.BaseURL+"/stats/reviewerAssignments", wrapper.GetStatsReviewerAssignments)
-	m.HandleFunc("POST "+options.BaseURL+"/team/add", wrapper.PostTeamAdd)
-	m.HandleFunc("GET "+options.BaseURL+"/team/get", wrapper.GetTeamGet)
-	m.HandleFunc("POST "+options.BaseURL+"/team/massDeactivate", wrapper.PostTeamMassDeactivate)
-	m.HandleFunc("GET "+options.BaseURL+"/users/getReview", wrapper.GetUsersGetReview)
-	m.HandleFunc("POST "+options.BaseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
+	// From Учитывать PR, созданные не раньше этого момента
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
 
-	return m
-}
+	// To Учитывать PR, созданные раньше этого момента
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
 
-type PostPullRequestCreateRequestObject struct {
-	Body *PostPullRequestCreateJSONRequestBody
-}
+	// Status Учитывать только PR в указанном статусе
+	Status *GetStatsReviewerAssignmentsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
 
-type PostPullRequestCreateResponseObject interface {
-	VisitPostPullRequestCreateResponse(w http.ResponseWriter) error
-}
+	// GroupBy team — отдельная строка на каждую команду ревьювера (с фильтром team — только команды из поддерева); week — на каждую неделю создания PR (с понедельника, UTC)
+	GroupBy *GetStatsReviewerAssignmentsParamsGroupBy `form:"group_by,omitempty" json:"group_by,omitempty"`
 
-type PostPullRequestCreate201JSONResponse struct {
-	Pr *PullRequest `json:"pr,omitempty"`
+	// Format Формат ответа: json, csv или ndjson (по объекту на строку). Без параметра формат выбирается по заголовку Accept (text/csv, application/x-ndjson), иначе json
+	Format *GetStatsReviewerAssignmentsParamsFormat `form:"format,omitempty" json:"format,omitempty"`
 }
 
-func (response PostPullRequestCreate201JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+// GetStatsReviewerAssignmentsParamsStatus defines parameters for GetStatsReviewerAssignments.
+type GetStatsReviewerAssignmentsParamsStatus string
 
-	return json.NewEncoder(w).Encode(response)
-}
+// GetStatsReviewerAssignmentsParamsGroupBy defines parameters for GetStatsReviewerAssignments.
+type GetStatsReviewerAssignmentsParamsGroupBy string
 
-type PostPullRequestCreate404JSONResponse ErrorResponse
+// GetStatsReviewerAssignmentsParamsFormat defines parameters for GetStatsReviewerAssignments.
+type GetStatsReviewerAssignmentsParamsFormat string
 
-func (response PostPullRequestCreate404JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// GetStatsTurnaroundParams defines parameters for GetStatsTurnaround.
+type GetStatsTurnaroundParams struct {
+	GroupBy GetStatsTurnaroundParamsGroupBy `form:"group_by" json:"group_by"`
+	From    *time.Time                      `form:"from,omitempty" json:"from,omitempty"`
+	To      *time.Time                      `form:"to,omitempty" json:"to,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
+	// Format Формат ответа: json, csv или ndjson (по объекту на строку). Без параметра формат выбирается по заголовку Accept (text/csv, application/x-ndjson), иначе json
+	Format *GetStatsTurnaroundParamsFormat `form:"format,omitempty" json:"format,omitempty"`
 }
 
-type PostPullRequestCreate409JSONResponse ErrorResponse
+// GetStatsTurnaroundParamsGroupBy defines parameters for GetStatsTurnaround.
+type GetStatsTurnaroundParamsGroupBy string
 
-func (response PostPullRequestCreate409JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+// GetStatsTurnaroundParamsFormat defines parameters for GetStatsTurnaround.
+type GetStatsTurnaroundParamsFormat string
 
-	return json.NewEncoder(w).Encode(response)
-}
+// DeleteTeamParams defines parameters for DeleteTeam.
+type DeleteTeamParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery          `form:"team_name" json:"team_name"`
+	Policy   DeleteTeamParamsPolicy `form:"policy" json:"policy"`
 
-type PostPullRequestMergeRequestObject struct {
-	Body *PostPullRequestMergeJSONRequestBody
+	// TargetTeam Команда, которой передаются ревью (обязательна при policy=reassign)
+	TargetTeam *string `form:"target_team,omitempty" json:"target_team,omitempty"`
 }
 
-type PostPullRequestMergeResponseObject interface {
-	VisitPostPullRequestMergeResponse(w http.ResponseWriter) error
+// DeleteTeamParamsPolicy defines parameters for DeleteTeam.
+type DeleteTeamParamsPolicy string
+
+// PostTeamAddParams defines parameters for PostTeamAdd.
+type PostTeamAddParams struct {
+	// AllowTeamMove Сделать команду основной для участников, уже состоящих в другой команде (прежняя основная команда покидается)
+	AllowTeamMove *AllowTeamMoveQuery `form:"allow_team_move,omitempty" json:"allow_team_move,omitempty"`
+
+	// AllowSecondaryTeam Добавить команду дополнительной для участников, уже состоящих в другой команде
+	AllowSecondaryTeam *AllowSecondaryTeamQuery `form:"allow_secondary_team,omitempty" json:"allow_secondary_team,omitempty"`
 }
 
-type PostPullRequestMerge200JSONResponse struct {
-	Pr *PullRequest `json:"pr,omitempty"`
+// GetTeamGetParams defines parameters for GetTeamGet.
+type GetTeamGetParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
 }
 
-func (response PostPullRequestMerge200JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+// GetTeamLeadsParams defines parameters for GetTeamLeads.
+type GetTeamLeadsParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
 }
 
-type PostPullRequestMerge404JSONResponse ErrorResponse
+// GetTeamListParams defines parameters for GetTeamList.
+type GetTeamListParams struct {
+	// Prefix Начало имени команды
+	Prefix *string `form:"prefix,omitempty" json:"prefix,omitempty"`
+	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
 
-func (response PostPullRequestMerge404JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	// Cursor next_cursor из предыдущего ответа
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+// PostTeamRenameJSONBody defines parameters for PostTeamRename.
+type PostTeamRenameJSONBody struct {
+	NewTeamName string `json:"new_team_name"`
+	TeamName    string `json:"team_name"`
 }
 
-type PostPullRequestReassignRequestObject struct {
-	Body *PostPullRequestReassignJSONRequestBody
+// PostTeamSetParentJSONBody defines parameters for PostTeamSetParent.
+type PostTeamSetParentJSONBody struct {
+	ParentTeamName *string `json:"parent_team_name,omitempty"`
+	TeamName       string  `json:"team_name"`
 }
 
-type PostPullRequestReassignResponseObject interface {
-	VisitPostPullRequestReassignResponse(w http.ResponseWriter) error
+// GetTeamSettingsParams defines parameters for GetTeamSettings.
+type GetTeamSettingsParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+
+	// Version Версия из истории; без неё возвращается текущая
+	Version *int `form:"version,omitempty" json:"version,omitempty"`
 }
 
-type PostPullRequestReassign200JSONResponse struct {
-	Pr PullRequest `json:"pr"`
+// GetTeamSettingsHistoryParams defines parameters for GetTeamSettingsHistory.
+type GetTeamSettingsHistoryParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
 
-	// ReplacedBy user_id нового ревьювера
-	ReplacedBy string `json:"replaced_by"`
+// GetTeamTreeParams defines parameters for GetTeamTree.
+type GetTeamTreeParams struct {
+	// TeamName Корень поддерева; без него возвращаются все корневые подразделения
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
 }
 
-func (response PostPullRequestReassign200JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PostUsersAnonymizeJSONBody defines parameters for PostUsersAnonymize.
+type PostUsersAnonymizeJSONBody struct {
+	// Reason Основание (например, номер заявки)
+	Reason *string `json:"reason,omitempty"`
+	UserId string  `json:"user_id"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+// GetUsersDashboardParams defines parameters for GetUsersDashboard.
+type GetUsersDashboardParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
 }
 
-type PostPullRequestReassign404JSONResponse ErrorResponse
+// GetUsersGetParams defines parameters for GetUsersGet.
+type GetUsersGetParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
 
-func (response PostPullRequestReassign404JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// GetUsersGetReviewParams defines parameters for GetUsersGetReview.
+type GetUsersGetReviewParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
 
-	return json.NewEncoder(w).Encode(response)
+	// Format Формат ответа: json, csv или ndjson (по объекту на строку). Без параметра формат выбирается по заголовку Accept (text/csv, application/x-ndjson), иначе json
+	Format *GetUsersGetReviewParamsFormat `form:"format,omitempty" json:"format,omitempty"`
 }
 
-type PostPullRequestReassign409JSONResponse ErrorResponse
+// GetUsersGetReviewParamsFormat defines parameters for GetUsersGetReview.
+type GetUsersGetReviewParamsFormat string
 
-func (response PostPullRequestReassign409JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+// GetUsersListParams defines parameters for GetUsersList.
+type GetUsersListParams struct {
+	// TeamName Только участники команды (основной или дополнительной)
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+	IsActive *bool   `form:"is_active,omitempty" json:"is_active,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Q Подстрока имени пользователя (без учёта регистра)
+	Q     *string                  `form:"q,omitempty" json:"q,omitempty"`
+	Sort  *GetUsersListParamsSort  `form:"sort,omitempty" json:"sort,omitempty"`
+	Order *GetUsersListParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+	Limit *int                     `form:"limit,omitempty" json:"limit,omitempty"`
 
-type GetStatsReviewerAssignmentsRequestObject struct {
-}
+	// Cursor next_cursor из предыдущего ответа; передаётся с теми же фильтрами и сортировкой
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
 
-type GetStatsReviewerAssignmentsResponseObject interface {
-	VisitGetStatsReviewerAssignmentsResponse(w http.ResponseWriter) error
+	// Format Формат ответа: json, csv или ndjson (по объекту на строку). Без параметра формат выбирается по заголовку Accept (text/csv, application/x-ndjson), иначе json
+	Format *GetUsersListParamsFormat `form:"format,omitempty" json:"format,omitempty"`
 }
 
-type GetStatsReviewerAssignments200JSONResponse struct {
-	Stats []ReviewerStat `json:"stats"`
+// GetUsersListParamsSort defines parameters for GetUsersList.
+type GetUsersListParamsSort string
+
+// GetUsersListParamsOrder defines parameters for GetUsersList.
+type GetUsersListParamsOrder string
+
+// GetUsersListParamsFormat defines parameters for GetUsersList.
+type GetUsersListParamsFormat string
+
+// PostUsersMoveTeamJSONBody defines parameters for PostUsersMoveTeam.
+type PostUsersMoveTeamJSONBody struct {
+	ReassignReviews *bool `json:"reassign_reviews,omitempty"`
+
+	// TeamName Команда, в которую переводится пользователь
+	TeamName string `json:"team_name"`
+	UserId   string `json:"user_id"`
 }
 
-func (response GetStatsReviewerAssignments200JSONResponse) VisitGetStatsReviewerAssignmentsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PostUsersScheduleActivationJSONBody defines parameters for PostUsersScheduleActivation.
+type PostUsersScheduleActivationJSONBody struct {
+	Comment *string `json:"comment,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
+	// EffectiveAt Момент применения; должен быть в будущем
+	EffectiveAt time.Time `json:"effective_at"`
+	IsActive    bool      `json:"is_active"`
+	UserId      string    `json:"user_id"`
 }
 
-type PostTeamAddRequestObject struct {
-	Body *PostTeamAddJSONRequestBody
+// GetUsersScheduledActivationsParams defines parameters for GetUsersScheduledActivations.
+type GetUsersScheduledActivationsParams struct {
+	UserId *string `form:"user_id,omitempty" json:"user_id,omitempty"`
+
+	// Status Без параметра возвращаются только ожидающие изменения
+	Status *GetUsersScheduledActivationsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
 }
 
-type PostTeamAddResponseObject interface {
-	VisitPostTeamAddResponse(w http.ResponseWriter) error
+// GetUsersScheduledActivationsParamsStatus defines parameters for GetUsersScheduledActivations.
+type GetUsersScheduledActivationsParamsStatus string
+
+// PostUsersScheduledActivationsCancelJSONBody defines parameters for PostUsersScheduledActivationsCancel.
+type PostUsersScheduledActivationsCancelJSONBody struct {
+	Id int64 `json:"id"`
 }
 
-type PostTeamAdd201JSONResponse struct {
-	Team *Team `json:"team,omitempty"`
+// PostUsersSetIsActiveJSONBody defines parameters for PostUsersSetIsActive.
+type PostUsersSetIsActiveJSONBody struct {
+	IsActive bool   `json:"is_active"`
+	UserId   string `json:"user_id"`
 }
 
-func (response PostTeamAdd201JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+// PostAdminApiKeysJSONRequestBody defines body for PostAdminApiKeys for application/json ContentType.
+type PostAdminApiKeysJSONRequestBody = ApiKeyCreateRequest
 
-	return json.NewEncoder(w).Encode(response)
-}
+// PostAdminApiKeysRevokeJSONRequestBody defines body for PostAdminApiKeysRevoke for application/json ContentType.
+type PostAdminApiKeysRevokeJSONRequestBody PostAdminApiKeysRevokeJSONBody
 
-type PostTeamAdd400JSONResponse ErrorResponse
+// PostAdminImportTextRequestBody defines body for PostAdminImport for text/plain ContentType.
+type PostAdminImportTextRequestBody = PostAdminImportTextBody
 
-func (response PostTeamAdd400JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// PostIntegrationsGitlabWebhookJSONRequestBody defines body for PostIntegrationsGitlabWebhook for application/json ContentType.
+type PostIntegrationsGitlabWebhookJSONRequestBody = GitLabMergeRequestEvent
 
-	return json.NewEncoder(w).Encode(response)
-}
+// PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
+type PostPullRequestCreateJSONRequestBody PostPullRequestCreateJSONBody
 
-type GetTeamGetRequestObject struct {
-	Params GetTeamGetParams
-}
+// PostPullRequestMergeJSONRequestBody defines body for PostPullRequestMerge for application/json ContentType.
+type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
 
-type GetTeamGetResponseObject interface {
-	VisitGetTeamGetResponse(w http.ResponseWriter) error
-}
+// PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
+type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
 
-type GetTeamGet200JSONResponse Team
+// PostRepositoryUpsertJSONRequestBody defines body for PostRepositoryUpsert for application/json ContentType.
+type PostRepositoryUpsertJSONRequestBody = Repository
 
-func (response GetTeamGet200JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PostScimV2GroupsApplicationScimPlusJSONRequestBody defines body for PostScimV2Groups for application/scim+json ContentType.
+type PostScimV2GroupsApplicationScimPlusJSONRequestBody = ScimGroup
 
-	return json.NewEncoder(w).Encode(response)
-}
+// PatchScimV2GroupsIdApplicationScimPlusJSONRequestBody defines body for PatchScimV2GroupsId for application/scim+json ContentType.
+type PatchScimV2GroupsIdApplicationScimPlusJSONRequestBody = ScimPatchRequest
 
-type GetTeamGet404JSONResponse ErrorResponse
+// PostScimV2UsersApplicationScimPlusJSONRequestBody defines body for PostScimV2Users for application/scim+json ContentType.
+type PostScimV2UsersApplicationScimPlusJSONRequestBody = ScimUser
 
-func (response GetTeamGet404JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// PatchScimV2UsersIdApplicationScimPlusJSONRequestBody defines body for PatchScimV2UsersId for application/scim+json ContentType.
+type PatchScimV2UsersIdApplicationScimPlusJSONRequestBody = ScimPatchRequest
 
-	return json.NewEncoder(w).Encode(response)
-}
+// PutScimV2UsersIdApplicationScimPlusJSONRequestBody defines body for PutScimV2UsersId for application/scim+json ContentType.
+type PutScimV2UsersIdApplicationScimPlusJSONRequestBody = ScimUser
 
-type PostTeamMassDeactivateRequestObject struct {
-	Body *PostTeamMassDeactivateJSONRequestBody
-}
+// PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
+type PostTeamAddJSONRequestBody = Team
 
-type PostTeamMassDeactivateResponseObject interface {
-	VisitPostTeamMassDeactivateResponse(w http.ResponseWriter) error
-}
+// PostTeamLeadsAddJSONRequestBody defines body for PostTeamLeadsAdd for application/json ContentType.
+type PostTeamLeadsAddJSONRequestBody = TeamLeadRequest
 
-type PostTeamMassDeactivate200JSONResponse struct {
-	Result *MassDeactivateResult `json:"result,omitempty"`
-}
+// PostTeamLeadsRemoveJSONRequestBody defines body for PostTeamLeadsRemove for application/json ContentType.
+type PostTeamLeadsRemoveJSONRequestBody = TeamLeadRequest
 
-func (response PostTeamMassDeactivate200JSONResponse) VisitPostTeamMassDeactivateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PostTeamMassDeactivateJSONRequestBody defines body for PostTeamMassDeactivate for application/json ContentType.
+type PostTeamMassDeactivateJSONRequestBody = MassDeactivateRequest
 
-	return json.NewEncoder(w).Encode(response)
-}
+// PostTeamRenameJSONRequestBody defines body for PostTeamRename for application/json ContentType.
+type PostTeamRenameJSONRequestBody PostTeamRenameJSONBody
 
-type PostTeamMassDeactivate401JSONResponse ErrorResponse
+// PostTeamSetParentJSONRequestBody defines body for PostTeamSetParent for application/json ContentType.
+type PostTeamSetParentJSONRequestBody PostTeamSetParentJSONBody
 
-func (response PostTeamMassDeactivate401JSONResponse) VisitPostTeamMassDeactivateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// PutTeamSettingsJSONRequestBody defines body for PutTeamSettings for application/json ContentType.
+type PutTeamSettingsJSONRequestBody = TeamSettingsUpdateRequest
 
-	return json.NewEncoder(w).Encode(response)
-}
+// PostTeamSettingsRollbackJSONRequestBody defines body for PostTeamSettingsRollback for application/json ContentType.
+type PostTeamSettingsRollbackJSONRequestBody = TeamSettingsRollbackRequest
 
-type PostTeamMassDeactivate404JSONResponse ErrorResponse
+// PatchTeamUpdateJSONRequestBody defines body for PatchTeamUpdate for application/json ContentType.
+type PatchTeamUpdateJSONRequestBody = TeamUpdateRequest
 
-func (response PostTeamMassDeactivate404JSONResponse) VisitPostTeamMassDeactivateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// PostUsersAnonymizeJSONRequestBody defines body for PostUsersAnonymize for application/json ContentType.
+type PostUsersAnonymizeJSONRequestBody PostUsersAnonymizeJSONBody
 
-	return json.NewEncoder(w).Encode(response)
-}
+// PostUsersLinkExternalAccountJSONRequestBody defines body for PostUsersLinkExternalAccount for application/json ContentType.
+type PostUsersLinkExternalAccountJSONRequestBody = ExternalAccount
 
-type GetUsersGetReviewRequestObject struct {
-	Params GetUsersGetReviewParams
-}
+// PostUsersMoveTeamJSONRequestBody defines body for PostUsersMoveTeam for application/json ContentType.
+type PostUsersMoveTeamJSONRequestBody PostUsersMoveTeamJSONBody
 
-type GetUsersGetReviewResponseObject interface {
-	VisitGetUsersGetReviewResponse(w http.ResponseWriter) error
+// PostUsersScheduleActivationJSONRequestBody defines body for PostUsersScheduleActivation for application/json ContentType.
+type PostUsersScheduleActivationJSONRequestBody PostUsersScheduleActivationJSONBody
+
+// PostUsersScheduledActivationsCancelJSONRequestBody defines body for PostUsersScheduledActivationsCancel for application/json ContentType.
+type PostUsersScheduledActivationsCancelJSONRequestBody PostUsersScheduledActivationsCancelJSONBody
+
+// PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
+type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Список API-ключей
+	// (GET /admin/apiKeys)
+	GetAdminApiKeys(w http.ResponseWriter, r *http.Request, params GetAdminApiKeysParams)
+	// Выпустить API-ключ
+	// (POST /admin/apiKeys)
+	PostAdminApiKeys(w http.ResponseWriter, r *http.Request)
+	// Отозвать API-ключ
+	// (POST /admin/apiKeys/revoke)
+	PostAdminApiKeysRevoke(w http.ResponseWriter, r *http.Request)
+	// Журнал изменяющих запросов (от новых к старым)
+	// (GET /admin/audit)
+	GetAdminAudit(w http.ResponseWriter, r *http.Request, params GetAdminAuditParams)
+	// Массовый импорт команд и участников из CSV или YAML
+	// (POST /admin/import)
+	PostAdminImport(w http.ResponseWriter, r *http.Request, params PostAdminImportParams)
+	// Кто выполняет запрос
+	// (GET /auth/whoami)
+	GetAuthWhoami(w http.ResponseWriter, r *http.Request)
+	// Принять событие Merge Request Hook из GitLab
+	// (POST /integrations/gitlab/webhook)
+	PostIntegrationsGitlabWebhook(w http.ResponseWriter, r *http.Request, params PostIntegrationsGitlabWebhookParams)
+	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+	// (POST /pullRequest/create)
+	PostPullRequestCreate(w http.ResponseWriter, r *http.Request)
+	// Пометить PR как MERGED (идемпотентная операция)
+	// (POST /pullRequest/merge)
+	PostPullRequestMerge(w http.ResponseWriter, r *http.Request)
+	// Переназначить конкретного ревьювера на другого из его команды
+	// (POST /pullRequest/reassign)
+	PostPullRequestReassign(w http.ResponseWriter, r *http.Request)
+	// Получить репозиторий и его политику
+	// (GET /repository/get)
+	GetRepositoryGet(w http.ResponseWriter, r *http.Request, params GetRepositoryGetParams)
+	// Создать или обновить репозиторий и его политику назначения
+	// (POST /repository/upsert)
+	PostRepositoryUpsert(w http.ResponseWriter, r *http.Request)
+	// Список групп SCIM (команд)
+	// (GET /scim/v2/Groups)
+	GetScimV2Groups(w http.ResponseWriter, r *http.Request, params GetScimV2GroupsParams)
+	// Создать группу SCIM (команду)
+	// (POST /scim/v2/Groups)
+	PostScimV2Groups(w http.ResponseWriter, r *http.Request)
+	// Удалить группу SCIM
+	// (DELETE /scim/v2/Groups/{id})
+	DeleteScimV2GroupsId(w http.ResponseWriter, r *http.Request, id ScimIdPath)
+	// Получить группу SCIM
+	// (GET /scim/v2/Groups/{id})
+	GetScimV2GroupsId(w http.ResponseWriter, r *http.Request, id ScimIdPath)
+	// Частично изменить группу SCIM
+	// (PATCH /scim/v2/Groups/{id})
+	PatchScimV2GroupsId(w http.ResponseWriter, r *http.Request, id ScimIdPath)
+	// Список пользователей SCIM
+	// (GET /scim/v2/Users)
+	GetScimV2Users(w http.ResponseWriter, r *http.Request, params GetScimV2UsersParams)
+	// Создать пользователя SCIM
+	// (POST /scim/v2/Users)
+	PostScimV2Users(w http.ResponseWriter, r *http.Request)
+	// Удалить пользователя SCIM
+	// (DELETE /scim/v2/Users/{id})
+	DeleteScimV2UsersId(w http.ResponseWriter, r *http.Request, id ScimIdPath)
+	// Получить пользователя SCIM
+	// (GET /scim/v2/Users/{id})
+	GetScimV2UsersId(w http.ResponseWriter, r *http.Request, id ScimIdPath)
+	// Частично изменить пользователя SCIM
+	// (PATCH /scim/v2/Users/{id})
+	PatchScimV2UsersId(w http.ResponseWriter, r *http.Request, id ScimIdPath)
+	// Заменить атрибуты пользователя SCIM
+	// (PUT /scim/v2/Users/{id})
+	PutScimV2UsersId(w http.ResponseWriter, r *http.Request, id ScimIdPath)
+	// Равномерность распределения ревью внутри команд
+	// (GET /stats/fairness)
+	GetStatsFairness(w http.ResponseWriter, r *http.Request, params GetStatsFairnessParams)
+	// Граф «автор → ревьювер» с весами
+	// (GET /stats/reviewGraph)
+	GetStatsReviewGraph(w http.ResponseWriter, r *http.Request, params GetStatsReviewGraphParams)
+	// Получить количество назначений ревью по пользователям
+	// (GET /stats/reviewerAssignments)
+	GetStatsReviewerAssignments(w http.ResponseWriter, r *http.Request, params GetStatsReviewerAssignmentsParams)
+	// Время от создания до мержа PR (медиана, p90, среднее)
+	// (GET /stats/turnaround)
+	GetStatsTurnaround(w http.ResponseWriter, r *http.Request, params GetStatsTurnaroundParams)
+	// Удалить команду
+	// (DELETE /team)
+	DeleteTeam(w http.ResponseWriter, r *http.Request, params DeleteTeamParams)
+	// Создать команду с участниками (создаёт/обновляет пользователей)
+	// (POST /team/add)
+	PostTeamAdd(w http.ResponseWriter, r *http.Request, params PostTeamAddParams)
+	// Получить команду с участниками
+	// (GET /team/get)
+	GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams)
+	// Руководители команды
+	// (GET /team/leads)
+	GetTeamLeads(w http.ResponseWriter, r *http.Request, params GetTeamLeadsParams)
+	// Назначить руководителя команды (админская)
+	// (POST /team/leads/add)
+	PostTeamLeadsAdd(w http.ResponseWriter, r *http.Request)
+	// Снять руководителя команды (админская)
+	// (POST /team/leads/remove)
+	PostTeamLeadsRemove(w http.ResponseWriter, r *http.Request)
+	// Список команд со счётчиками участников и открытых PR
+	// (GET /team/list)
+	GetTeamList(w http.ResponseWriter, r *http.Request, params GetTeamListParams)
+	// Массово деактивировать пользователей команды и безопасно переназначить открытые PR
+	// (POST /team/massDeactivate)
+	PostTeamMassDeactivate(w http.ResponseWriter, r *http.Request)
+	// Переименовать команду (каскадно обновляет users.team_name и team_members)
+	// (POST /team/rename)
+	PostTeamRename(w http.ResponseWriter, r *http.Request)
+	// Вложить команду в родительское подразделение
+	// (POST /team/setParent)
+	PostTeamSetParent(w http.ResponseWriter, r *http.Request)
+	// Собственные и действующие настройки команды
+	// (GET /team/settings)
+	GetTeamSettings(w http.ResponseWriter, r *http.Request, params GetTeamSettingsParams)
+	// Сохранить новую версию настроек команды
+	// (PUT /team/settings)
+	PutTeamSettings(w http.ResponseWriter, r *http.Request)
+	// История версий настроек команды (новые первыми)
+	// (GET /team/settings/history)
+	GetTeamSettingsHistory(w http.ResponseWriter, r *http.Request, params GetTeamSettingsHistoryParams)
+	// Вернуть настройки команды к одной из прошлых версий
+	// (POST /team/settings/rollback)
+	PostTeamSettingsRollback(w http.ResponseWriter, r *http.Request)
+	// Дерево подразделений с собственными и действующими настройками
+	// (GET /team/tree)
+	GetTeamTree(w http.ResponseWriter, r *http.Request, params GetTeamTreeParams)
+	// Добавить и исключить участников команды, заменить её настройки
+	// (PATCH /team/update)
+	PatchTeamUpdate(w http.ResponseWriter, r *http.Request)
+	// Обезличить пользователя
+	// (POST /users/anonymize)
+	PostUsersAnonymize(w http.ResponseWriter, r *http.Request)
+	// Сводка ревьювера одним запросом
+	// (GET /users/dashboard)
+	GetUsersDashboard(w http.ResponseWriter, r *http.Request, params GetUsersDashboardParams)
+	// Получить пользователя вместе со списком его команд
+	// (GET /users/get)
+	GetUsersGet(w http.ResponseWriter, r *http.Request, params GetUsersGetParams)
+	// Получить PR'ы, где пользователь назначен ревьювером
+	// (GET /users/getReview)
+	GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams)
+	// Привязать учётную запись GitLab/GitHub к пользователю сервиса
+	// (POST /users/linkExternalAccount)
+	PostUsersLinkExternalAccount(w http.ResponseWriter, r *http.Request)
+	// Справочник пользователей с фильтрами и постраничной выдачей
+	// (GET /users/list)
+	GetUsersList(w http.ResponseWriter, r *http.Request, params GetUsersListParams)
+	// Перевести пользователя в другую команду
+	// (POST /users/moveTeam)
+	PostUsersMoveTeam(w http.ResponseWriter, r *http.Request)
+	// Запланировать активацию или деактивацию пользователя
+	// (POST /users/scheduleActivation)
+	PostUsersScheduleActivation(w http.ResponseWriter, r *http.Request)
+	// Запланированные изменения активности (по effective_at)
+	// (GET /users/scheduledActivations)
+	GetUsersScheduledActivations(w http.ResponseWriter, r *http.Request, params GetUsersScheduledActivationsParams)
+	// Отменить запланированное изменение
+	// (POST /users/scheduledActivations/cancel)
+	PostUsersScheduledActivationsCancel(w http.ResponseWriter, r *http.Request)
+	// Установить флаг активности пользователя
+	// (POST /users/setIsActive)
+	PostUsersSetIsActive(w http.ResponseWriter, r *http.Request)
 }
 
-type GetUsersGetReview200JSONResponse struct {
-	PullRequests []PullRequestShort `json:"pull_requests"`
-	UserId       string             `json:"user_id"`
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
 }
 
-func (response GetUsersGetReview200JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+type MiddlewareFunc func(http.Handler) http.Handler
 
-	return json.NewEncoder(w).Encode(response)
+// GetAdminApiKeys operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminApiKeys(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminApiKeysParams
+
+	// ------------- Optional query parameter "include_revoked" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_revoked", r.URL.Query(), &params.IncludeRevoked)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_revoked", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminApiKeys(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type PostUsersSetIsActiveRequestObject struct {
-	Body *PostUsersSetIsActiveJSONRequestBody
+// PostAdminApiKeys operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminApiKeys(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAdminApiKeys(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type PostUsersSetIsActiveResponseObject interface {
-	VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error
+// PostAdminApiKeysRevoke operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminApiKeysRevoke(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAdminApiKeysRevoke(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type PostUsersSetIsActive200JSONResponse struct {
-	User *User `json:"user,omitempty"`
+// GetAdminAudit operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminAudit(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminAuditParams
+
+	// ------------- Optional query parameter "actor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actor", r.URL.Query(), &params.Actor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "operation_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "operation_id", r.URL.Query(), &params.OperationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "operation_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "result" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "result", r.URL.Query(), &params.Result)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "result", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminAudit(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAdminImport operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminImport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostAdminImportParams
+
+	// ------------- Required query parameter "format" -------------
+
+	if paramValue := r.URL.Query().Get("format"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "format"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAdminImport(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAuthWhoami operation middleware
+func (siw *ServerInterfaceWrapper) GetAuthWhoami(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAuthWhoami(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostIntegrationsGitlabWebhook operation middleware
+func (siw *ServerInterfaceWrapper) PostIntegrationsGitlabWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostIntegrationsGitlabWebhookParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Gitlab-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Gitlab-Token")]; found {
+		var XGitlabToken string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Gitlab-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Gitlab-Token", valueList[0], &XGitlabToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Gitlab-Token", Err: err})
+			return
+		}
+
+		params.XGitlabToken = &XGitlabToken
+
+	}
+
+	// ------------- Optional header parameter "X-Gitlab-Event" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Gitlab-Event")]; found {
+		var XGitlabEvent string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Gitlab-Event", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Gitlab-Event", valueList[0], &XGitlabEvent, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Gitlab-Event", Err: err})
+			return
+		}
+
+		params.XGitlabEvent = &XGitlabEvent
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostIntegrationsGitlabWebhook(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestCreate operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestCreate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestMerge operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestMerge(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestReassign operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestReassign(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRepositoryGet operation middleware
+func (siw *ServerInterfaceWrapper) GetRepositoryGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRepositoryGetParams
+
+	// ------------- Required query parameter "repository_id" -------------
+
+	if paramValue := r.URL.Query().Get("repository_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "repository_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "repository_id", r.URL.Query(), &params.RepositoryId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRepositoryGet(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostRepositoryUpsert operation middleware
+func (siw *ServerInterfaceWrapper) PostRepositoryUpsert(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostRepositoryUpsert(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetScimV2Groups operation middleware
+func (siw *ServerInterfaceWrapper) GetScimV2Groups(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetScimV2GroupsParams
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "startIndex" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "startIndex", r.URL.Query(), &params.StartIndex)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "startIndex", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "count", r.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "count", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetScimV2Groups(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostScimV2Groups operation middleware
+func (siw *ServerInterfaceWrapper) PostScimV2Groups(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostScimV2Groups(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteScimV2GroupsId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteScimV2GroupsId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ScimIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteScimV2GroupsId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetScimV2GroupsId operation middleware
+func (siw *ServerInterfaceWrapper) GetScimV2GroupsId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ScimIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetScimV2GroupsId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchScimV2GroupsId operation middleware
+func (siw *ServerInterfaceWrapper) PatchScimV2GroupsId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ScimIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchScimV2GroupsId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetScimV2Users operation middleware
+func (siw *ServerInterfaceWrapper) GetScimV2Users(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetScimV2UsersParams
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "startIndex" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "startIndex", r.URL.Query(), &params.StartIndex)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "startIndex", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "count", r.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "count", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetScimV2Users(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostScimV2Users operation middleware
+func (siw *ServerInterfaceWrapper) PostScimV2Users(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostScimV2Users(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteScimV2UsersId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteScimV2UsersId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ScimIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteScimV2UsersId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetScimV2UsersId operation middleware
+func (siw *ServerInterfaceWrapper) GetScimV2UsersId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ScimIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetScimV2UsersId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchScimV2UsersId operation middleware
+func (siw *ServerInterfaceWrapper) PatchScimV2UsersId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ScimIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchScimV2UsersId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutScimV2UsersId operation middleware
+func (siw *ServerInterfaceWrapper) PutScimV2UsersId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ScimIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutScimV2UsersId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatsFairness operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsFairness(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsFairnessParams
+
+	// ------------- Optional query parameter "team" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team", r.URL.Query(), &params.Team)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tolerance" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tolerance", r.URL.Query(), &params.Tolerance)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tolerance", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatsFairness(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatsReviewGraph operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsReviewGraph(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsReviewGraphParams
+
+	// ------------- Optional query parameter "team" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team", r.URL.Query(), &params.Team)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatsReviewGraph(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatsReviewerAssignments operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsReviewerAssignments(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsReviewerAssignmentsParams
+
+	// ------------- Optional query parameter "repository" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "repository", r.URL.Query(), &params.Repository)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "team" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team", r.URL.Query(), &params.Team)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "group_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "group_by", r.URL.Query(), &params.GroupBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "group_by", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatsReviewerAssignments(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatsTurnaround operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsTurnaround(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsTurnaroundParams
+
+	// ------------- Required query parameter "group_by" -------------
+
+	if paramValue := r.URL.Query().Get("group_by"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "group_by"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "group_by", r.URL.Query(), &params.GroupBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "group_by", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatsTurnaround(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteTeam operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTeam(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTeamParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "policy" -------------
+
+	if paramValue := r.URL.Query().Get("policy"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "policy"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "policy", r.URL.Query(), &params.Policy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "policy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "target_team" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "target_team", r.URL.Query(), &params.TargetTeam)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "target_team", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTeam(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamAddParams
+
+	// ------------- Optional query parameter "allow_team_move" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "allow_team_move", r.URL.Query(), &params.AllowTeamMove)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "allow_team_move", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "allow_secondary_team" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "allow_secondary_team", r.URL.Query(), &params.AllowSecondaryTeam)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "allow_secondary_team", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamAdd(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamGet operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamGetParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamGet(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamLeads operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamLeads(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamLeadsParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamLeads(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamLeadsAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamLeadsAdd(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamLeadsAdd(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamLeadsRemove operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamLeadsRemove(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamLeadsRemove(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamList operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamListParams
+
+	// ------------- Optional query parameter "prefix" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "prefix", r.URL.Query(), &params.Prefix)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "prefix", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamList(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamMassDeactivate operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamMassDeactivate(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamMassDeactivate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamRename operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamRename(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamRename(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamSetParent operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSetParent(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamSetParent(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamSettings operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamSettings(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamSettingsParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "version" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "version", r.URL.Query(), &params.Version)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamSettings(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutTeamSettings operation middleware
+func (siw *ServerInterfaceWrapper) PutTeamSettings(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutTeamSettings(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamSettingsHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamSettingsHistory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamSettingsHistoryParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamSettingsHistory(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamSettingsRollback operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSettingsRollback(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamSettingsRollback(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamTree operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamTree(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamTreeParams
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamTree(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchTeamUpdate operation middleware
+func (siw *ServerInterfaceWrapper) PatchTeamUpdate(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchTeamUpdate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersAnonymize operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersAnonymize(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersAnonymize(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUsersDashboard operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersDashboard(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersDashboardParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersDashboard(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUsersGet operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersGetParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersGet(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUsersGetReview operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersGetReviewParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersGetReview(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersLinkExternalAccount operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersLinkExternalAccount(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersLinkExternalAccount(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUsersList operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersListParams
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "is_active" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "is_active", r.URL.Query(), &params.IsActive)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "is_active", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", r.URL.Query(), &params.Order)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersList(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersMoveTeam operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersMoveTeam(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersMoveTeam(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersScheduleActivation operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersScheduleActivation(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersScheduleActivation(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUsersScheduledActivations operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersScheduledActivations(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersScheduledActivationsParams
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersScheduledActivations(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersScheduledActivationsCancel operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersScheduledActivationsCancel(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersScheduledActivationsCancel(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersSetIsActive operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersSetIsActive(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{})
+}
+
+// ServeMux is an abstraction of http.ServeMux.
+type ServeMux interface {
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+}
+
+type StdHTTPServerOptions struct {
+	BaseURL          string
+	BaseRouter       ServeMux
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseRouter: m,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: m,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
+	m := options.BaseRouter
+
+	if m == nil {
+		m = http.NewServeMux()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	m.HandleFunc("GET "+options.BaseURL+"/admin/apiKeys", wrapper.GetAdminApiKeys)
+	m.HandleFunc("POST "+options.BaseURL+"/admin/apiKeys", wrapper.PostAdminApiKeys)
+	m.HandleFunc("POST "+options.BaseURL+"/admin/apiKeys/revoke", wrapper.PostAdminApiKeysRevoke)
+	m.HandleFunc("GET "+options.BaseURL+"/admin/audit", wrapper.GetAdminAudit)
+	m.HandleFunc("POST "+options.BaseURL+"/admin/import", wrapper.PostAdminImport)
+	m.HandleFunc("GET "+options.BaseURL+"/auth/whoami", wrapper.GetAuthWhoami)
+	m.HandleFunc("POST "+options.BaseURL+"/integrations/gitlab/webhook", wrapper.PostIntegrationsGitlabWebhook)
+	m.HandleFunc("POST "+options.BaseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
+	m.HandleFunc("POST "+options.BaseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
+	m.HandleFunc("POST "+options.BaseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
+	m.HandleFunc("GET "+options.BaseURL+"/repository/get", wrapper.GetRepositoryGet)
+	m.HandleFunc("POST "+options.BaseURL+"/repository/upsert", wrapper.PostRepositoryUpsert)
+	m.HandleFunc("GET "+options.BaseURL+"/scim/v2/Groups", wrapper.GetScimV2Groups)
+	m.HandleFunc("POST "+options.BaseURL+"/scim/v2/Groups", wrapper.PostScimV2Groups)
+	m.HandleFunc("DELETE "+options.BaseURL+"/scim/v2/Groups/{id}", wrapper.DeleteScimV2GroupsId)
+	m.HandleFunc("GET "+options.BaseURL+"/scim/v2/Groups/{id}", wrapper.GetScimV2GroupsId)
+	m.HandleFunc("PATCH "+options.BaseURL+"/scim/v2/Groups/{id}", wrapper.PatchScimV2GroupsId)
+	m.HandleFunc("GET "+options.BaseURL+"/scim/v2/Users", wrapper.GetScimV2Users)
+	m.HandleFunc("POST "+options.BaseURL+"/scim/v2/Users", wrapper.PostScimV2Users)
+	m.HandleFunc("DELETE "+options.BaseURL+"/scim/v2/Users/{id}", wrapper.DeleteScimV2UsersId)
+	m.HandleFunc("GET "+options.BaseURL+"/scim/v2/Users/{id}", wrapper.GetScimV2UsersId)
+	m.HandleFunc("PATCH "+options.BaseURL+"/scim/v2/Users/{id}", wrapper.PatchScimV2UsersId)
+	m.HandleFunc("PUT "+options.BaseURL+"/scim/v2/Users/{id}", wrapper.PutScimV2UsersId)
+	m.HandleFunc("GET "+options.BaseURL+"/stats/fairness", wrapper.GetStatsFairness)
+	m.HandleFunc("GET "+options.BaseURL+"/stats/reviewGraph", wrapper.GetStatsReviewGraph)
+	m.HandleFunc("GET "+options.BaseURL+"/stats/reviewerAssignments", wrapper.GetStatsReviewerAssignments)
+	m.HandleFunc("GET "+options.BaseURL+"/stats/turnaround", wrapper.GetStatsTurnaround)
+	m.HandleFunc("DELETE "+options.BaseURL+"/team", wrapper.DeleteTeam)
+	m.HandleFunc("POST "+options.BaseURL+"/team/add", wrapper.PostTeamAdd)
+	m.HandleFunc("GET "+options.BaseURL+"/team/get", wrapper.GetTeamGet)
+	m.HandleFunc("GET "+options.BaseURL+"/team/leads", wrapper.GetTeamLeads)
+	m.HandleFunc("POST "+options.BaseURL+"/team/leads/add", wrapper.PostTeamLeadsAdd)
+	m.HandleFunc("POST "+options.BaseURL+"/team/leads/remove", wrapper.PostTeamLeadsRemove)
+	m.HandleFunc("GET "+options.BaseURL+"/team/list", wrapper.GetTeamList)
+	m.HandleFunc("POST "+options.BaseURL+"/team/massDeactivate", wrapper.PostTeamMassDeactivate)
+	m.HandleFunc("POST "+options.BaseURL+"/team/rename", wrapper.PostTeamRename)
+	m.HandleFunc("POST "+options.BaseURL+"/team/setParent", wrapper.PostTeamSetParent)
+	m.HandleFunc("GET "+options.BaseURL+"/team/settings", wrapper.GetTeamSettings)
+	m.HandleFunc("PUT "+options.BaseURL+"/team/settings", wrapper.PutTeamSettings)
+	m.HandleFunc("GET "+options.BaseURL+"/team/settings/history", wrapper.GetTeamSettingsHistory)
+	m.HandleFunc("POST "+options.BaseURL+"/team/settings/rollback", wrapper.PostTeamSettingsRollback)
+	m.HandleFunc("GET "+options.BaseURL+"/team/tree", wrapper.GetTeamTree)
+	m.HandleFunc("PATCH "+options.BaseURL+"/team/update", wrapper.PatchTeamUpdate)
+	m.HandleFunc("POST "+options.BaseURL+"/users/anonymize", wrapper.PostUsersAnonymize)
+	m.HandleFunc("GET "+options.BaseURL+"/users/dashboard", wrapper.GetUsersDashboard)
+	m.HandleFunc("GET "+options.BaseURL+"/users/get", wrapper.GetUsersGet)
+	m.HandleFunc("GET "+options.BaseURL+"/users/getReview", wrapper.GetUsersGetReview)
+	m.HandleFunc("POST "+options.BaseURL+"/users/linkExternalAccount", wrapper.PostUsersLinkExternalAccount)
+	m.HandleFunc("GET "+options.BaseURL+"/users/list", wrapper.GetUsersList)
+	m.HandleFunc("POST "+options.BaseURL+"/users/moveTeam", wrapper.PostUsersMoveTeam)
+	m.HandleFunc("POST "+options.BaseURL+"/users/scheduleActivation", wrapper.PostUsersScheduleActivation)
+	m.HandleFunc("GET "+options.BaseURL+"/users/scheduledActivations", wrapper.GetUsersScheduledActivations)
+	m.HandleFunc("POST "+options.BaseURL+"/users/scheduledActivations/cancel", wrapper.PostUsersScheduledActivationsCancel)
+	m.HandleFunc("POST "+options.BaseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
+
+	return m
+}
+
+type GetAdminApiKeysRequestObject struct {
+	Params GetAdminApiKeysParams
+}
+
+type GetAdminApiKeysResponseObject interface {
+	VisitGetAdminApiKeysResponse(w http.ResponseWriter) error
+}
+
+type GetAdminApiKeys200JSONResponse struct {
+	Keys []ApiKey `json:"keys"`
+}
+
+func (response GetAdminApiKeys200JSONResponse) VisitGetAdminApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminApiKeys401JSONResponse ErrorResponse
+
+func (response GetAdminApiKeys401JSONResponse) VisitGetAdminApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminApiKeys403JSONResponse ErrorResponse
+
+func (response GetAdminApiKeys403JSONResponse) VisitGetAdminApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminApiKeysRequestObject struct {
+	Body *PostAdminApiKeysJSONRequestBody
+}
+
+type PostAdminApiKeysResponseObject interface {
+	VisitPostAdminApiKeysResponse(w http.ResponseWriter) error
+}
+
+type PostAdminApiKeys201JSONResponse struct {
+	Key ApiKey `json:"key"`
+
+	// Secret Передаётся в заголовке Authorization: Bearer <secret>
+	Secret string `json:"secret"`
+}
+
+func (response PostAdminApiKeys201JSONResponse) VisitPostAdminApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminApiKeys400JSONResponse ErrorResponse
+
+func (response PostAdminApiKeys400JSONResponse) VisitPostAdminApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminApiKeys401JSONResponse ErrorResponse
+
+func (response PostAdminApiKeys401JSONResponse) VisitPostAdminApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminApiKeys403JSONResponse ErrorResponse
+
+func (response PostAdminApiKeys403JSONResponse) VisitPostAdminApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminApiKeysRevokeRequestObject struct {
+	Body *PostAdminApiKeysRevokeJSONRequestBody
+}
+
+type PostAdminApiKeysRevokeResponseObject interface {
+	VisitPostAdminApiKeysRevokeResponse(w http.ResponseWriter) error
+}
+
+type PostAdminApiKeysRevoke200JSONResponse struct {
+	Key ApiKey `json:"key"`
+}
+
+func (response PostAdminApiKeysRevoke200JSONResponse) VisitPostAdminApiKeysRevokeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminApiKeysRevoke400JSONResponse ErrorResponse
+
+func (response PostAdminApiKeysRevoke400JSONResponse) VisitPostAdminApiKeysRevokeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminApiKeysRevoke401JSONResponse ErrorResponse
+
+func (response PostAdminApiKeysRevoke401JSONResponse) VisitPostAdminApiKeysRevokeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminApiKeysRevoke403JSONResponse ErrorResponse
+
+func (response PostAdminApiKeysRevoke403JSONResponse) VisitPostAdminApiKeysRevokeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminApiKeysRevoke404JSONResponse ErrorResponse
+
+func (response PostAdminApiKeysRevoke404JSONResponse) VisitPostAdminApiKeysRevokeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminAuditRequestObject struct {
+	Params GetAdminAuditParams
+}
+
+type GetAdminAuditResponseObject interface {
+	VisitGetAdminAuditResponse(w http.ResponseWriter) error
+}
+
+type GetAdminAudit200JSONResponse AuditPage
+
+func (response GetAdminAudit200JSONResponse) VisitGetAdminAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminAudit400JSONResponse ErrorResponse
+
+func (response GetAdminAudit400JSONResponse) VisitGetAdminAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminAudit401JSONResponse ErrorResponse
+
+func (response GetAdminAudit401JSONResponse) VisitGetAdminAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminAudit403JSONResponse ErrorResponse
+
+func (response GetAdminAudit403JSONResponse) VisitGetAdminAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminImportRequestObject struct {
+	Params PostAdminImportParams
+	Body   *PostAdminImportTextRequestBody
+}
+
+type PostAdminImportResponseObject interface {
+	VisitPostAdminImportResponse(w http.ResponseWriter) error
+}
+
+type PostAdminImport200JSONResponse ImportResult
+
+func (response PostAdminImport200JSONResponse) VisitPostAdminImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminImport400JSONResponse ErrorResponse
+
+func (response PostAdminImport400JSONResponse) VisitPostAdminImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminImport401JSONResponse ErrorResponse
+
+func (response PostAdminImport401JSONResponse) VisitPostAdminImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminImport403JSONResponse ErrorResponse
+
+func (response PostAdminImport403JSONResponse) VisitPostAdminImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminImport422JSONResponse ImportResult
+
+func (response PostAdminImport422JSONResponse) VisitPostAdminImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuthWhoamiRequestObject struct {
+}
+
+type GetAuthWhoamiResponseObject interface {
+	VisitGetAuthWhoamiResponse(w http.ResponseWriter) error
+}
+
+type GetAuthWhoami200JSONResponse Principal
+
+func (response GetAuthWhoami200JSONResponse) VisitGetAuthWhoamiResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuthWhoami401JSONResponse ErrorResponse
+
+func (response GetAuthWhoami401JSONResponse) VisitGetAuthWhoamiResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostIntegrationsGitlabWebhookRequestObject struct {
+	Params PostIntegrationsGitlabWebhookParams
+	Body   *PostIntegrationsGitlabWebhookJSONRequestBody
+}
+
+type PostIntegrationsGitlabWebhookResponseObject interface {
+	VisitPostIntegrationsGitlabWebhookResponse(w http.ResponseWriter) error
+}
+
+type PostIntegrationsGitlabWebhook200JSONResponse WebhookResult
+
+func (response PostIntegrationsGitlabWebhook200JSONResponse) VisitPostIntegrationsGitlabWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostIntegrationsGitlabWebhook400JSONResponse ErrorResponse
+
+func (response PostIntegrationsGitlabWebhook400JSONResponse) VisitPostIntegrationsGitlabWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostIntegrationsGitlabWebhook401JSONResponse ErrorResponse
+
+func (response PostIntegrationsGitlabWebhook401JSONResponse) VisitPostIntegrationsGitlabWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostIntegrationsGitlabWebhook404JSONResponse ErrorResponse
+
+func (response PostIntegrationsGitlabWebhook404JSONResponse) VisitPostIntegrationsGitlabWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreateRequestObject struct {
+	Body *PostPullRequestCreateJSONRequestBody
+}
+
+type PostPullRequestCreateResponseObject interface {
+	VisitPostPullRequestCreateResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestCreate201JSONResponse struct {
+	Pr *PullRequest `json:"pr,omitempty"`
+}
+
+func (response PostPullRequestCreate201JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate401JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate401JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate404JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate404JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate409JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate409JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMergeRequestObject struct {
+	Body *PostPullRequestMergeJSONRequestBody
+}
+
+type PostPullRequestMergeResponseObject interface {
+	VisitPostPullRequestMergeResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestMerge200JSONResponse struct {
+	Pr *PullRequest `json:"pr,omitempty"`
+}
+
+func (response PostPullRequestMerge200JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge401JSONResponse ErrorResponse
+
+func (response PostPullRequestMerge401JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge404JSONResponse ErrorResponse
+
+func (response PostPullRequestMerge404JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassignRequestObject struct {
+	Body *PostPullRequestReassignJSONRequestBody
+}
+
+type PostPullRequestReassignResponseObject interface {
+	VisitPostPullRequestReassignResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestReassign200JSONResponse struct {
+	Pr PullRequest `json:"pr"`
+
+	// ReplacedBy user_id нового ревьювера
+	ReplacedBy string `json:"replaced_by"`
+}
+
+func (response PostPullRequestReassign200JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign401JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign401JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign403JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign403JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign404JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign404JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign409JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign409JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRepositoryGetRequestObject struct {
+	Params GetRepositoryGetParams
+}
+
+type GetRepositoryGetResponseObject interface {
+	VisitGetRepositoryGetResponse(w http.ResponseWriter) error
+}
+
+type GetRepositoryGet200JSONResponse Repository
+
+func (response GetRepositoryGet200JSONResponse) VisitGetRepositoryGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRepositoryGet401JSONResponse ErrorResponse
+
+func (response GetRepositoryGet401JSONResponse) VisitGetRepositoryGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRepositoryGet404JSONResponse ErrorResponse
+
+func (response GetRepositoryGet404JSONResponse) VisitGetRepositoryGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRepositoryUpsertRequestObject struct {
+	Body *PostRepositoryUpsertJSONRequestBody
+}
+
+type PostRepositoryUpsertResponseObject interface {
+	VisitPostRepositoryUpsertResponse(w http.ResponseWriter) error
+}
+
+type PostRepositoryUpsert200JSONResponse struct {
+	Repository Repository `json:"repository"`
+}
+
+func (response PostRepositoryUpsert200JSONResponse) VisitPostRepositoryUpsertResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRepositoryUpsert400JSONResponse ErrorResponse
+
+func (response PostRepositoryUpsert400JSONResponse) VisitPostRepositoryUpsertResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRepositoryUpsert401JSONResponse ErrorResponse
+
+func (response PostRepositoryUpsert401JSONResponse) VisitPostRepositoryUpsertResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRepositoryUpsert403JSONResponse ErrorResponse
+
+func (response PostRepositoryUpsert403JSONResponse) VisitPostRepositoryUpsertResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRepositoryUpsert404JSONResponse ErrorResponse
+
+func (response PostRepositoryUpsert404JSONResponse) VisitPostRepositoryUpsertResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetScimV2GroupsRequestObject struct {
+	Params GetScimV2GroupsParams
+}
+
+type GetScimV2GroupsResponseObject interface {
+	VisitGetScimV2GroupsResponse(w http.ResponseWriter) error
+}
+
+type GetScimV2Groups200ApplicationScimPlusJSONResponse ScimGroupList
+
+func (response GetScimV2Groups200ApplicationScimPlusJSONResponse) VisitGetScimV2GroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetScimV2Groups400ApplicationScimPlusJSONResponse ScimError
+
+func (response GetScimV2Groups400ApplicationScimPlusJSONResponse) VisitGetScimV2GroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetScimV2Groups401ApplicationScimPlusJSONResponse ScimError
+
+func (response GetScimV2Groups401ApplicationScimPlusJSONResponse) VisitGetScimV2GroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetScimV2Groups404ApplicationScimPlusJSONResponse ScimError
+
+func (response GetScimV2Groups404ApplicationScimPlusJSONResponse) VisitGetScimV2GroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostScimV2GroupsRequestObject struct {
+	Body *PostScimV2GroupsApplicationScimPlusJSONRequestBody
+}
+
+type PostScimV2GroupsResponseObject interface {
+	VisitPostScimV2GroupsResponse(w http.ResponseWriter) error
+}
+
+type PostScimV2Groups201ApplicationScimPlusJSONResponse ScimGroup
+
+func (response PostScimV2Groups201ApplicationScimPlusJSONResponse) VisitPostScimV2GroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostScimV2Groups400ApplicationScimPlusJSONResponse ScimError
+
+func (response PostScimV2Groups400ApplicationScimPlusJSONResponse) VisitPostScimV2GroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostScimV2Groups401ApplicationScimPlusJSONResponse ScimError
+
+func (response PostScimV2Groups401ApplicationScimPlusJSONResponse) VisitPostScimV2GroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostScimV2Groups404ApplicationScimPlusJSONResponse ScimError
+
+func (response PostScimV2Groups404ApplicationScimPlusJSONResponse) VisitPostScimV2GroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostScimV2Groups409ApplicationScimPlusJSONResponse ScimError
+
+func (response PostScimV2Groups409ApplicationScimPlusJSONResponse) VisitPostScimV2GroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteScimV2GroupsIdRequestObject struct {
+	Id ScimIdPath `json:"id"`
+}
+
+type DeleteScimV2GroupsIdResponseObject interface {
+	VisitDeleteScimV2GroupsIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteScimV2GroupsId204Response struct {
+}
+
+func (response DeleteScimV2GroupsId204Response) VisitDeleteScimV2GroupsIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteScimV2GroupsId401ApplicationScimPlusJSONResponse ScimError
+
+func (response DeleteScimV2GroupsId401ApplicationScimPlusJSONResponse) VisitDeleteScimV2GroupsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteScimV2GroupsId404ApplicationScimPlusJSONResponse ScimError
+
+func (response DeleteScimV2GroupsId404ApplicationScimPlusJSONResponse) VisitDeleteScimV2GroupsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteScimV2GroupsId409ApplicationScimPlusJSONResponse ScimError
+
+func (response DeleteScimV2GroupsId409ApplicationScimPlusJSONResponse) VisitDeleteScimV2GroupsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetScimV2GroupsIdRequestObject struct {
+	Id ScimIdPath `json:"id"`
+}
+
+type GetScimV2GroupsIdResponseObject interface {
+	VisitGetScimV2GroupsIdResponse(w http.ResponseWriter) error
+}
+
+type GetScimV2GroupsId200ApplicationScimPlusJSONResponse ScimGroup
+
+func (response GetScimV2GroupsId200ApplicationScimPlusJSONResponse) VisitGetScimV2GroupsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetScimV2GroupsId401ApplicationScimPlusJSONResponse ScimError
+
+func (response GetScimV2GroupsId401ApplicationScimPlusJSONResponse) VisitGetScimV2GroupsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetScimV2GroupsId404ApplicationScimPlusJSONResponse ScimError
+
+func (response GetScimV2GroupsId404ApplicationScimPlusJSONResponse) VisitGetScimV2GroupsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchScimV2GroupsIdRequestObject struct {
+	Id   ScimIdPath `json:"id"`
+	Body *PatchScimV2GroupsIdApplicationScimPlusJSONRequestBody
+}
+
+type PatchScimV2GroupsIdResponseObject interface {
+	VisitPatchScimV2GroupsIdResponse(w http.ResponseWriter) error
+}
+
+type PatchScimV2GroupsId200ApplicationScimPlusJSONResponse ScimGroup
+
+func (response PatchScimV2GroupsId200ApplicationScimPlusJSONResponse) VisitPatchScimV2GroupsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchScimV2GroupsId400ApplicationScimPlusJSONResponse ScimError
+
+func (response PatchScimV2GroupsId400ApplicationScimPlusJSONResponse) VisitPatchScimV2GroupsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchScimV2GroupsId401ApplicationScimPlusJSONResponse ScimError
+
+func (response PatchScimV2GroupsId401ApplicationScimPlusJSONResponse) VisitPatchScimV2GroupsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchScimV2GroupsId404ApplicationScimPlusJSONResponse ScimError
+
+func (response PatchScimV2GroupsId404ApplicationScimPlusJSONResponse) VisitPatchScimV2GroupsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchScimV2GroupsId409ApplicationScimPlusJSONResponse ScimError
+
+func (response PatchScimV2GroupsId409ApplicationScimPlusJSONResponse) VisitPatchScimV2GroupsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetScimV2UsersRequestObject struct {
+	Params GetScimV2UsersParams
+}
+
+type GetScimV2UsersResponseObject interface {
+	VisitGetScimV2UsersResponse(w http.ResponseWriter) error
+}
+
+type GetScimV2Users200ApplicationScimPlusJSONResponse ScimUserList
+
+func (response GetScimV2Users200ApplicationScimPlusJSONResponse) VisitGetScimV2UsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetScimV2Users400ApplicationScimPlusJSONResponse ScimError
+
+func (response GetScimV2Users400ApplicationScimPlusJSONResponse) VisitGetScimV2UsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetScimV2Users401ApplicationScimPlusJSONResponse ScimError
+
+func (response GetScimV2Users401ApplicationScimPlusJSONResponse) VisitGetScimV2UsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetScimV2Users404ApplicationScimPlusJSONResponse ScimError
+
+func (response GetScimV2Users404ApplicationScimPlusJSONResponse) VisitGetScimV2UsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostScimV2UsersRequestObject struct {
+	Body *PostScimV2UsersApplicationScimPlusJSONRequestBody
+}
+
+type PostScimV2UsersResponseObject interface {
+	VisitPostScimV2UsersResponse(w http.ResponseWriter) error
+}
+
+type PostScimV2Users201ApplicationScimPlusJSONResponse ScimUser
+
+func (response PostScimV2Users201ApplicationScimPlusJSONResponse) VisitPostScimV2UsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostScimV2Users400ApplicationScimPlusJSONResponse ScimError
+
+func (response PostScimV2Users400ApplicationScimPlusJSONResponse) VisitPostScimV2UsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostScimV2Users401ApplicationScimPlusJSONResponse ScimError
+
+func (response PostScimV2Users401ApplicationScimPlusJSONResponse) VisitPostScimV2UsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostScimV2Users404ApplicationScimPlusJSONResponse ScimError
+
+func (response PostScimV2Users404ApplicationScimPlusJSONResponse) VisitPostScimV2UsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostScimV2Users409ApplicationScimPlusJSONResponse ScimError
+
+func (response PostScimV2Users409ApplicationScimPlusJSONResponse) VisitPostScimV2UsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteScimV2UsersIdRequestObject struct {
+	Id ScimIdPath `json:"id"`
+}
+
+type DeleteScimV2UsersIdResponseObject interface {
+	VisitDeleteScimV2UsersIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteScimV2UsersId204Response struct {
+}
+
+func (response DeleteScimV2UsersId204Response) VisitDeleteScimV2UsersIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteScimV2UsersId401ApplicationScimPlusJSONResponse ScimError
+
+func (response DeleteScimV2UsersId401ApplicationScimPlusJSONResponse) VisitDeleteScimV2UsersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteScimV2UsersId404ApplicationScimPlusJSONResponse ScimError
+
+func (response DeleteScimV2UsersId404ApplicationScimPlusJSONResponse) VisitDeleteScimV2UsersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetScimV2UsersIdRequestObject struct {
+	Id ScimIdPath `json:"id"`
+}
+
+type GetScimV2UsersIdResponseObject interface {
+	VisitGetScimV2UsersIdResponse(w http.ResponseWriter) error
+}
+
+type GetScimV2UsersId200ApplicationScimPlusJSONResponse ScimUser
+
+func (response GetScimV2UsersId200ApplicationScimPlusJSONResponse) VisitGetScimV2UsersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetScimV2UsersId401ApplicationScimPlusJSONResponse ScimError
+
+func (response GetScimV2UsersId401ApplicationScimPlusJSONResponse) VisitGetScimV2UsersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetScimV2UsersId404ApplicationScimPlusJSONResponse ScimError
+
+func (response GetScimV2UsersId404ApplicationScimPlusJSONResponse) VisitGetScimV2UsersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchScimV2UsersIdRequestObject struct {
+	Id   ScimIdPath `json:"id"`
+	Body *PatchScimV2UsersIdApplicationScimPlusJSONRequestBody
+}
+
+type PatchScimV2UsersIdResponseObject interface {
+	VisitPatchScimV2UsersIdResponse(w http.ResponseWriter) error
+}
+
+type PatchScimV2UsersId200ApplicationScimPlusJSONResponse ScimUser
+
+func (response PatchScimV2UsersId200ApplicationScimPlusJSONResponse) VisitPatchScimV2UsersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchScimV2UsersId400ApplicationScimPlusJSONResponse ScimError
+
+func (response PatchScimV2UsersId400ApplicationScimPlusJSONResponse) VisitPatchScimV2UsersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchScimV2UsersId401ApplicationScimPlusJSONResponse ScimError
+
+func (response PatchScimV2UsersId401ApplicationScimPlusJSONResponse) VisitPatchScimV2UsersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchScimV2UsersId404ApplicationScimPlusJSONResponse ScimError
+
+func (response PatchScimV2UsersId404ApplicationScimPlusJSONResponse) VisitPatchScimV2UsersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchScimV2UsersId409ApplicationScimPlusJSONResponse ScimError
+
+func (response PatchScimV2UsersId409ApplicationScimPlusJSONResponse) VisitPatchScimV2UsersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutScimV2UsersIdRequestObject struct {
+	Id   ScimIdPath `json:"id"`
+	Body *PutScimV2UsersIdApplicationScimPlusJSONRequestBody
+}
+
+type PutScimV2UsersIdResponseObject interface {
+	VisitPutScimV2UsersIdResponse(w http.ResponseWriter) error
+}
+
+type PutScimV2UsersId200ApplicationScimPlusJSONResponse ScimUser
+
+func (response PutScimV2UsersId200ApplicationScimPlusJSONResponse) VisitPutScimV2UsersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutScimV2UsersId400ApplicationScimPlusJSONResponse ScimError
+
+func (response PutScimV2UsersId400ApplicationScimPlusJSONResponse) VisitPutScimV2UsersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutScimV2UsersId401ApplicationScimPlusJSONResponse ScimError
+
+func (response PutScimV2UsersId401ApplicationScimPlusJSONResponse) VisitPutScimV2UsersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutScimV2UsersId404ApplicationScimPlusJSONResponse ScimError
+
+func (response PutScimV2UsersId404ApplicationScimPlusJSONResponse) VisitPutScimV2UsersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutScimV2UsersId409ApplicationScimPlusJSONResponse ScimError
+
+func (response PutScimV2UsersId409ApplicationScimPlusJSONResponse) VisitPutScimV2UsersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsFairnessRequestObject struct {
+	Params GetStatsFairnessParams
+}
+
+type GetStatsFairnessResponseObject interface {
+	VisitGetStatsFairnessResponse(w http.ResponseWriter) error
+}
+
+type GetStatsFairness200JSONResponse struct {
+	Teams []TeamFairness `json:"teams"`
+}
+
+func (response GetStatsFairness200JSONResponse) VisitGetStatsFairnessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsFairness400JSONResponse ErrorResponse
+
+func (response GetStatsFairness400JSONResponse) VisitGetStatsFairnessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsFairness401JSONResponse ErrorResponse
+
+func (response GetStatsFairness401JSONResponse) VisitGetStatsFairnessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsFairness404JSONResponse ErrorResponse
+
+func (response GetStatsFairness404JSONResponse) VisitGetStatsFairnessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsReviewGraphRequestObject struct {
+	Params GetStatsReviewGraphParams
+}
+
+type GetStatsReviewGraphResponseObject interface {
+	VisitGetStatsReviewGraphResponse(w http.ResponseWriter) error
+}
+
+type GetStatsReviewGraph200JSONResponse ReviewGraph
+
+func (response GetStatsReviewGraph200JSONResponse) VisitGetStatsReviewGraphResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsReviewGraph200TextvndGraphvizResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetStatsReviewGraph200TextvndGraphvizResponse) VisitGetStatsReviewGraphResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/vnd.graphviz")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetStatsReviewGraph400JSONResponse ErrorResponse
+
+func (response GetStatsReviewGraph400JSONResponse) VisitGetStatsReviewGraphResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsReviewGraph401JSONResponse ErrorResponse
+
+func (response GetStatsReviewGraph401JSONResponse) VisitGetStatsReviewGraphResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsReviewGraph404JSONResponse ErrorResponse
+
+func (response GetStatsReviewGraph404JSONResponse) VisitGetStatsReviewGraphResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsReviewerAssignmentsRequestObject struct {
+	Params GetStatsReviewerAssignmentsParams
+}
+
+type GetStatsReviewerAssignmentsResponseObject interface {
+	VisitGetStatsReviewerAssignmentsResponse(w http.ResponseWriter) error
+}
+
+type GetStatsReviewerAssignments200JSONResponse struct {
+	Stats []ReviewerStat `json:"stats"`
+}
+
+func (response GetStatsReviewerAssignments200JSONResponse) VisitGetStatsReviewerAssignmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsReviewerAssignments200ApplicationxNdjsonResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetStatsReviewerAssignments200ApplicationxNdjsonResponse) VisitGetStatsReviewerAssignmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetStatsReviewerAssignments200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetStatsReviewerAssignments200TextcsvResponse) VisitGetStatsReviewerAssignmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetStatsReviewerAssignments400JSONResponse ErrorResponse
+
+func (response GetStatsReviewerAssignments400JSONResponse) VisitGetStatsReviewerAssignmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsReviewerAssignments401JSONResponse ErrorResponse
+
+func (response GetStatsReviewerAssignments401JSONResponse) VisitGetStatsReviewerAssignmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsReviewerAssignments404JSONResponse ErrorResponse
+
+func (response GetStatsReviewerAssignments404JSONResponse) VisitGetStatsReviewerAssignmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsTurnaroundRequestObject struct {
+	Params GetStatsTurnaroundParams
+}
+
+type GetStatsTurnaroundResponseObject interface {
+	VisitGetStatsTurnaroundResponse(w http.ResponseWriter) error
+}
+
+type GetStatsTurnaround200JSONResponse struct {
+	Stats []TurnaroundStat `json:"stats"`
+}
+
+func (response GetStatsTurnaround200JSONResponse) VisitGetStatsTurnaroundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsTurnaround200ApplicationxNdjsonResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetStatsTurnaround200ApplicationxNdjsonResponse) VisitGetStatsTurnaroundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetStatsTurnaround200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetStatsTurnaround200TextcsvResponse) VisitGetStatsTurnaroundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetStatsTurnaround400JSONResponse ErrorResponse
+
+func (response GetStatsTurnaround400JSONResponse) VisitGetStatsTurnaroundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsTurnaround401JSONResponse ErrorResponse
+
+func (response GetStatsTurnaround401JSONResponse) VisitGetStatsTurnaroundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTeamRequestObject struct {
+	Params DeleteTeamParams
+}
+
+type DeleteTeamResponseObject interface {
+	VisitDeleteTeamResponse(w http.ResponseWriter) error
+}
+
+type DeleteTeam200JSONResponse struct {
+	Result TeamDeleteResult `json:"result"`
+}
+
+func (response DeleteTeam200JSONResponse) VisitDeleteTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTeam400JSONResponse ErrorResponse
+
+func (response DeleteTeam400JSONResponse) VisitDeleteTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTeam401JSONResponse ErrorResponse
+
+func (response DeleteTeam401JSONResponse) VisitDeleteTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTeam403JSONResponse ErrorResponse
+
+func (response DeleteTeam403JSONResponse) VisitDeleteTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTeam404JSONResponse ErrorResponse
+
+func (response DeleteTeam404JSONResponse) VisitDeleteTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTeam409JSONResponse ErrorResponse
+
+func (response DeleteTeam409JSONResponse) VisitDeleteTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAddRequestObject struct {
+	Params PostTeamAddParams
+	Body   *PostTeamAddJSONRequestBody
+}
+
+type PostTeamAddResponseObject interface {
+	VisitPostTeamAddResponse(w http.ResponseWriter) error
+}
+
+type PostTeamAdd201JSONResponse struct {
+	Team *Team `json:"team,omitempty"`
+}
+
+func (response PostTeamAdd201JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAdd400JSONResponse ErrorResponse
+
+func (response PostTeamAdd400JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAdd401JSONResponse ErrorResponse
+
+func (response PostTeamAdd401JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAdd404JSONResponse ErrorResponse
+
+func (response PostTeamAdd404JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAdd409JSONResponse ErrorResponse
+
+func (response PostTeamAdd409JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGetRequestObject struct {
+	Params GetTeamGetParams
+}
+
+type GetTeamGetResponseObject interface {
+	VisitGetTeamGetResponse(w http.ResponseWriter) error
+}
+
+type GetTeamGet200JSONResponse Team
+
+func (response GetTeamGet200JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGet401JSONResponse ErrorResponse
+
+func (response GetTeamGet401JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGet404JSONResponse ErrorResponse
+
+func (response GetTeamGet404JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamLeadsRequestObject struct {
+	Params GetTeamLeadsParams
+}
+
+type GetTeamLeadsResponseObject interface {
+	VisitGetTeamLeadsResponse(w http.ResponseWriter) error
+}
+
+type GetTeamLeads200JSONResponse struct {
+	Leads []TeamLead `json:"leads"`
+}
+
+func (response GetTeamLeads200JSONResponse) VisitGetTeamLeadsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamLeads401JSONResponse ErrorResponse
+
+func (response GetTeamLeads401JSONResponse) VisitGetTeamLeadsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamLeads404JSONResponse ErrorResponse
+
+func (response GetTeamLeads404JSONResponse) VisitGetTeamLeadsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamLeadsAddRequestObject struct {
+	Body *PostTeamLeadsAddJSONRequestBody
+}
+
+type PostTeamLeadsAddResponseObject interface {
+	VisitPostTeamLeadsAddResponse(w http.ResponseWriter) error
+}
+
+type PostTeamLeadsAdd200JSONResponse struct {
+	Lead TeamLead `json:"lead"`
+}
+
+func (response PostTeamLeadsAdd200JSONResponse) VisitPostTeamLeadsAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamLeadsAdd401JSONResponse ErrorResponse
+
+func (response PostTeamLeadsAdd401JSONResponse) VisitPostTeamLeadsAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamLeadsAdd403JSONResponse ErrorResponse
+
+func (response PostTeamLeadsAdd403JSONResponse) VisitPostTeamLeadsAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamLeadsAdd404JSONResponse ErrorResponse
+
+func (response PostTeamLeadsAdd404JSONResponse) VisitPostTeamLeadsAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamLeadsRemoveRequestObject struct {
+	Body *PostTeamLeadsRemoveJSONRequestBody
+}
+
+type PostTeamLeadsRemoveResponseObject interface {
+	VisitPostTeamLeadsRemoveResponse(w http.ResponseWriter) error
+}
+
+type PostTeamLeadsRemove200JSONResponse struct {
+	Lead TeamLead `json:"lead"`
+}
+
+func (response PostTeamLeadsRemove200JSONResponse) VisitPostTeamLeadsRemoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamLeadsRemove401JSONResponse ErrorResponse
+
+func (response PostTeamLeadsRemove401JSONResponse) VisitPostTeamLeadsRemoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamLeadsRemove403JSONResponse ErrorResponse
+
+func (response PostTeamLeadsRemove403JSONResponse) VisitPostTeamLeadsRemoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamLeadsRemove404JSONResponse ErrorResponse
+
+func (response PostTeamLeadsRemove404JSONResponse) VisitPostTeamLeadsRemoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamListRequestObject struct {
+	Params GetTeamListParams
+}
+
+type GetTeamListResponseObject interface {
+	VisitGetTeamListResponse(w http.ResponseWriter) error
+}
+
+type GetTeamList200JSONResponse TeamListPage
+
+func (response GetTeamList200JSONResponse) VisitGetTeamListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamList400JSONResponse ErrorResponse
+
+func (response GetTeamList400JSONResponse) VisitGetTeamListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamList401JSONResponse ErrorResponse
+
+func (response GetTeamList401JSONResponse) VisitGetTeamListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamMassDeactivateRequestObject struct {
+	Body *PostTeamMassDeactivateJSONRequestBody
+}
+
+type PostTeamMassDeactivateResponseObject interface {
+	VisitPostTeamMassDeactivateResponse(w http.ResponseWriter) error
+}
+
+type PostTeamMassDeactivate200JSONResponse struct {
+	Result *MassDeactivateResult `json:"result,omitempty"`
+}
+
+func (response PostTeamMassDeactivate200JSONResponse) VisitPostTeamMassDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamMassDeactivate401JSONResponse ErrorResponse
+
+func (response PostTeamMassDeactivate401JSONResponse) VisitPostTeamMassDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamMassDeactivate403JSONResponse ErrorResponse
+
+func (response PostTeamMassDeactivate403JSONResponse) VisitPostTeamMassDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamMassDeactivate404JSONResponse ErrorResponse
+
+func (response PostTeamMassDeactivate404JSONResponse) VisitPostTeamMassDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRenameRequestObject struct {
+	Body *PostTeamRenameJSONRequestBody
+}
+
+type PostTeamRenameResponseObject interface {
+	VisitPostTeamRenameResponse(w http.ResponseWriter) error
+}
+
+type PostTeamRename200JSONResponse struct {
+	Team Team `json:"team"`
+}
+
+func (response PostTeamRename200JSONResponse) VisitPostTeamRenameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRename400JSONResponse ErrorResponse
+
+func (response PostTeamRename400JSONResponse) VisitPostTeamRenameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRename401JSONResponse ErrorResponse
+
+func (response PostTeamRename401JSONResponse) VisitPostTeamRenameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRename403JSONResponse ErrorResponse
+
+func (response PostTeamRename403JSONResponse) VisitPostTeamRenameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRename404JSONResponse ErrorResponse
+
+func (response PostTeamRename404JSONResponse) VisitPostTeamRenameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetParentRequestObject struct {
+	Body *PostTeamSetParentJSONRequestBody
+}
+
+type PostTeamSetParentResponseObject interface {
+	VisitPostTeamSetParentResponse(w http.ResponseWriter) error
+}
+
+type PostTeamSetParent200JSONResponse struct {
+	Team Team `json:"team"`
+}
+
+func (response PostTeamSetParent200JSONResponse) VisitPostTeamSetParentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetParent400JSONResponse ErrorResponse
+
+func (response PostTeamSetParent400JSONResponse) VisitPostTeamSetParentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetParent401JSONResponse ErrorResponse
+
+func (response PostTeamSetParent401JSONResponse) VisitPostTeamSetParentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetParent403JSONResponse ErrorResponse
+
+func (response PostTeamSetParent403JSONResponse) VisitPostTeamSetParentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetParent404JSONResponse ErrorResponse
+
+func (response PostTeamSetParent404JSONResponse) VisitPostTeamSetParentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamSettingsRequestObject struct {
+	Params GetTeamSettingsParams
+}
+
+type GetTeamSettingsResponseObject interface {
+	VisitGetTeamSettingsResponse(w http.ResponseWriter) error
+}
+
+type GetTeamSettings200JSONResponse TeamSettings
+
+func (response GetTeamSettings200JSONResponse) VisitGetTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamSettings401JSONResponse ErrorResponse
+
+func (response GetTeamSettings401JSONResponse) VisitGetTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamSettings404JSONResponse ErrorResponse
+
+func (response GetTeamSettings404JSONResponse) VisitGetTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTeamSettingsRequestObject struct {
+	Body *PutTeamSettingsJSONRequestBody
+}
+
+type PutTeamSettingsResponseObject interface {
+	VisitPutTeamSettingsResponse(w http.ResponseWriter) error
+}
+
+type PutTeamSettings200JSONResponse TeamSettings
+
+func (response PutTeamSettings200JSONResponse) VisitPutTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTeamSettings400JSONResponse ErrorResponse
+
+func (response PutTeamSettings400JSONResponse) VisitPutTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTeamSettings401JSONResponse ErrorResponse
+
+func (response PutTeamSettings401JSONResponse) VisitPutTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTeamSettings403JSONResponse ErrorResponse
+
+func (response PutTeamSettings403JSONResponse) VisitPutTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTeamSettings404JSONResponse ErrorResponse
+
+func (response PutTeamSettings404JSONResponse) VisitPutTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTeamSettings409JSONResponse ErrorResponse
+
+func (response PutTeamSettings409JSONResponse) VisitPutTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamSettingsHistoryRequestObject struct {
+	Params GetTeamSettingsHistoryParams
+}
+
+type GetTeamSettingsHistoryResponseObject interface {
+	VisitGetTeamSettingsHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetTeamSettingsHistory200JSONResponse struct {
+	Versions []TeamSettingsVersion `json:"versions"`
+}
+
+func (response GetTeamSettingsHistory200JSONResponse) VisitGetTeamSettingsHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamSettingsHistory401JSONResponse ErrorResponse
+
+func (response GetTeamSettingsHistory401JSONResponse) VisitGetTeamSettingsHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamSettingsHistory404JSONResponse ErrorResponse
+
+func (response GetTeamSettingsHistory404JSONResponse) VisitGetTeamSettingsHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSettingsRollbackRequestObject struct {
+	Body *PostTeamSettingsRollbackJSONRequestBody
+}
+
+type PostTeamSettingsRollbackResponseObject interface {
+	VisitPostTeamSettingsRollbackResponse(w http.ResponseWriter) error
+}
+
+type PostTeamSettingsRollback200JSONResponse TeamSettings
+
+func (response PostTeamSettingsRollback200JSONResponse) VisitPostTeamSettingsRollbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSettingsRollback400JSONResponse ErrorResponse
+
+func (response PostTeamSettingsRollback400JSONResponse) VisitPostTeamSettingsRollbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSettingsRollback401JSONResponse ErrorResponse
+
+func (response PostTeamSettingsRollback401JSONResponse) VisitPostTeamSettingsRollbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSettingsRollback403JSONResponse ErrorResponse
+
+func (response PostTeamSettingsRollback403JSONResponse) VisitPostTeamSettingsRollbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSettingsRollback404JSONResponse ErrorResponse
+
+func (response PostTeamSettingsRollback404JSONResponse) VisitPostTeamSettingsRollbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSettingsRollback409JSONResponse ErrorResponse
+
+func (response PostTeamSettingsRollback409JSONResponse) VisitPostTeamSettingsRollbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamTreeRequestObject struct {
+	Params GetTeamTreeParams
+}
+
+type GetTeamTreeResponseObject interface {
+	VisitGetTeamTreeResponse(w http.ResponseWriter) error
+}
+
+type GetTeamTree200JSONResponse struct {
+	Teams []TeamTreeNode `json:"teams"`
+}
+
+func (response GetTeamTree200JSONResponse) VisitGetTeamTreeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamTree401JSONResponse ErrorResponse
+
+func (response GetTeamTree401JSONResponse) VisitGetTeamTreeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamTree404JSONResponse ErrorResponse
+
+func (response GetTeamTree404JSONResponse) VisitGetTeamTreeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTeamUpdateRequestObject struct {
+	Body *PatchTeamUpdateJSONRequestBody
+}
+
+type PatchTeamUpdateResponseObject interface {
+	VisitPatchTeamUpdateResponse(w http.ResponseWriter) error
+}
+
+type PatchTeamUpdate200JSONResponse struct {
+	Reassignment ReviewReassignmentResult `json:"reassignment"`
+	Team         Team                     `json:"team"`
+}
+
+func (response PatchTeamUpdate200JSONResponse) VisitPatchTeamUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTeamUpdate400JSONResponse ErrorResponse
+
+func (response PatchTeamUpdate400JSONResponse) VisitPatchTeamUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTeamUpdate401JSONResponse ErrorResponse
+
+func (response PatchTeamUpdate401JSONResponse) VisitPatchTeamUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTeamUpdate403JSONResponse ErrorResponse
+
+func (response PatchTeamUpdate403JSONResponse) VisitPatchTeamUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTeamUpdate404JSONResponse ErrorResponse
+
+func (response PatchTeamUpdate404JSONResponse) VisitPatchTeamUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTeamUpdate409JSONResponse ErrorResponse
+
+func (response PatchTeamUpdate409JSONResponse) VisitPatchTeamUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAnonymizeRequestObject struct {
+	Body *PostUsersAnonymizeJSONRequestBody
+}
+
+type PostUsersAnonymizeResponseObject interface {
+	VisitPostUsersAnonymizeResponse(w http.ResponseWriter) error
+}
+
+type PostUsersAnonymize200JSONResponse AnonymizeResult
+
+func (response PostUsersAnonymize200JSONResponse) VisitPostUsersAnonymizeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAnonymize400JSONResponse ErrorResponse
+
+func (response PostUsersAnonymize400JSONResponse) VisitPostUsersAnonymizeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAnonymize401JSONResponse ErrorResponse
+
+func (response PostUsersAnonymize401JSONResponse) VisitPostUsersAnonymizeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAnonymize403JSONResponse ErrorResponse
+
+func (response PostUsersAnonymize403JSONResponse) VisitPostUsersAnonymizeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAnonymize404JSONResponse ErrorResponse
+
+func (response PostUsersAnonymize404JSONResponse) VisitPostUsersAnonymizeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersDashboardRequestObject struct {
+	Params GetUsersDashboardParams
+}
+
+type GetUsersDashboardResponseObject interface {
+	VisitGetUsersDashboardResponse(w http.ResponseWriter) error
+}
+
+type GetUsersDashboard200JSONResponse UserDashboard
+
+func (response GetUsersDashboard200JSONResponse) VisitGetUsersDashboardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersDashboard401JSONResponse ErrorResponse
+
+func (response GetUsersDashboard401JSONResponse) VisitGetUsersDashboardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersDashboard404JSONResponse ErrorResponse
+
+func (response GetUsersDashboard404JSONResponse) VisitGetUsersDashboardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetRequestObject struct {
+	Params GetUsersGetParams
+}
+
+type GetUsersGetResponseObject interface {
+	VisitGetUsersGetResponse(w http.ResponseWriter) error
+}
+
+type GetUsersGet200JSONResponse struct {
+	User User `json:"user"`
+}
+
+func (response GetUsersGet200JSONResponse) VisitGetUsersGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGet401JSONResponse ErrorResponse
+
+func (response GetUsersGet401JSONResponse) VisitGetUsersGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGet404JSONResponse ErrorResponse
+
+func (response GetUsersGet404JSONResponse) VisitGetUsersGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetReviewRequestObject struct {
+	Params GetUsersGetReviewParams
+}
+
+type GetUsersGetReviewResponseObject interface {
+	VisitGetUsersGetReviewResponse(w http.ResponseWriter) error
+}
+
+type GetUsersGetReview200JSONResponse struct {
+	PullRequests []PullRequestShort `json:"pull_requests"`
+	UserId       string             `json:"user_id"`
+}
+
+func (response GetUsersGetReview200JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetReview200ApplicationxNdjsonResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetUsersGetReview200ApplicationxNdjsonResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetUsersGetReview200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetUsersGetReview200TextcsvResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetUsersGetReview400JSONResponse ErrorResponse
+
+func (response GetUsersGetReview400JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetReview401JSONResponse ErrorResponse
+
+func (response GetUsersGetReview401JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersLinkExternalAccountRequestObject struct {
+	Body *PostUsersLinkExternalAccountJSONRequestBody
+}
+
+type PostUsersLinkExternalAccountResponseObject interface {
+	VisitPostUsersLinkExternalAccountResponse(w http.ResponseWriter) error
+}
+
+type PostUsersLinkExternalAccount200JSONResponse struct {
+	Account ExternalAccount `json:"account"`
+}
+
+func (response PostUsersLinkExternalAccount200JSONResponse) VisitPostUsersLinkExternalAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersLinkExternalAccount401JSONResponse ErrorResponse
+
+func (response PostUsersLinkExternalAccount401JSONResponse) VisitPostUsersLinkExternalAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersLinkExternalAccount403JSONResponse ErrorResponse
+
+func (response PostUsersLinkExternalAccount403JSONResponse) VisitPostUsersLinkExternalAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersLinkExternalAccount404JSONResponse ErrorResponse
+
+func (response PostUsersLinkExternalAccount404JSONResponse) VisitPostUsersLinkExternalAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersLinkExternalAccount409JSONResponse ErrorResponse
+
+func (response PostUsersLinkExternalAccount409JSONResponse) VisitPostUsersLinkExternalAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersListRequestObject struct {
+	Params GetUsersListParams
+}
+
+type GetUsersListResponseObject interface {
+	VisitGetUsersListResponse(w http.ResponseWriter) error
+}
+
+type GetUsersList200JSONResponse UserListPage
+
+func (response GetUsersList200JSONResponse) VisitGetUsersListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersList200ApplicationxNdjsonResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetUsersList200ApplicationxNdjsonResponse) VisitGetUsersListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetUsersList200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetUsersList200TextcsvResponse) VisitGetUsersListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetUsersList400JSONResponse ErrorResponse
+
+func (response GetUsersList400JSONResponse) VisitGetUsersListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersList401JSONResponse ErrorResponse
+
+func (response GetUsersList401JSONResponse) VisitGetUsersListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersMoveTeamRequestObject struct {
+	Body *PostUsersMoveTeamJSONRequestBody
+}
+
+type PostUsersMoveTeamResponseObject interface {
+	VisitPostUsersMoveTeamResponse(w http.ResponseWriter) error
+}
+
+type PostUsersMoveTeam200JSONResponse struct {
+	Result MoveTeamResult `json:"result"`
+}
+
+func (response PostUsersMoveTeam200JSONResponse) VisitPostUsersMoveTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersMoveTeam400JSONResponse ErrorResponse
+
+func (response PostUsersMoveTeam400JSONResponse) VisitPostUsersMoveTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersMoveTeam401JSONResponse ErrorResponse
+
+func (response PostUsersMoveTeam401JSONResponse) VisitPostUsersMoveTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersMoveTeam403JSONResponse ErrorResponse
+
+func (response PostUsersMoveTeam403JSONResponse) VisitPostUsersMoveTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersMoveTeam404JSONResponse ErrorResponse
+
+func (response PostUsersMoveTeam404JSONResponse) VisitPostUsersMoveTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersScheduleActivationRequestObject struct {
+	Body *PostUsersScheduleActivationJSONRequestBody
+}
+
+type PostUsersScheduleActivationResponseObject interface {
+	VisitPostUsersScheduleActivationResponse(w http.ResponseWriter) error
+}
+
+type PostUsersScheduleActivation201JSONResponse struct {
+	Schedule ScheduledActivation `json:"schedule"`
+}
+
+func (response PostUsersScheduleActivation201JSONResponse) VisitPostUsersScheduleActivationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersScheduleActivation400JSONResponse ErrorResponse
+
+func (response PostUsersScheduleActivation400JSONResponse) VisitPostUsersScheduleActivationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersScheduleActivation401JSONResponse ErrorResponse
+
+func (response PostUsersScheduleActivation401JSONResponse) VisitPostUsersScheduleActivationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersScheduleActivation403JSONResponse ErrorResponse
+
+func (response PostUsersScheduleActivation403JSONResponse) VisitPostUsersScheduleActivationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersScheduleActivation404JSONResponse ErrorResponse
+
+func (response PostUsersScheduleActivation404JSONResponse) VisitPostUsersScheduleActivationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersScheduledActivationsRequestObject struct {
+	Params GetUsersScheduledActivationsParams
+}
+
+type GetUsersScheduledActivationsResponseObject interface {
+	VisitGetUsersScheduledActivationsResponse(w http.ResponseWriter) error
+}
+
+type GetUsersScheduledActivations200JSONResponse struct {
+	Schedules []ScheduledActivation `json:"schedules"`
+}
+
+func (response GetUsersScheduledActivations200JSONResponse) VisitGetUsersScheduledActivationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersScheduledActivations401JSONResponse ErrorResponse
+
+func (response GetUsersScheduledActivations401JSONResponse) VisitGetUsersScheduledActivationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersScheduledActivationsCancelRequestObject struct {
+	Body *PostUsersScheduledActivationsCancelJSONRequestBody
+}
+
+type PostUsersScheduledActivationsCancelResponseObject interface {
+	VisitPostUsersScheduledActivationsCancelResponse(w http.ResponseWriter) error
+}
+
+type PostUsersScheduledActivationsCancel200JSONResponse struct {
+	Schedule ScheduledActivation `json:"schedule"`
+}
+
+func (response PostUsersScheduledActivationsCancel200JSONResponse) VisitPostUsersScheduledActivationsCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersScheduledActivationsCancel400JSONResponse ErrorResponse
+
+func (response PostUsersScheduledActivationsCancel400JSONResponse) VisitPostUsersScheduledActivationsCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersScheduledActivationsCancel401JSONResponse ErrorResponse
+
+func (response PostUsersScheduledActivationsCancel401JSONResponse) VisitPostUsersScheduledActivationsCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersScheduledActivationsCancel403JSONResponse ErrorResponse
+
+func (response PostUsersScheduledActivationsCancel403JSONResponse) VisitPostUsersScheduledActivationsCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersScheduledActivationsCancel404JSONResponse ErrorResponse
+
+func (response PostUsersScheduledActivationsCancel404JSONResponse) VisitPostUsersScheduledActivationsCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersScheduledActivationsCancel409JSONResponse ErrorResponse
+
+func (response PostUsersScheduledActivationsCancel409JSONResponse) VisitPostUsersScheduledActivationsCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActiveRequestObject struct {
+	Body *PostUsersSetIsActiveJSONRequestBody
+}
+
+type PostUsersSetIsActiveResponseObject interface {
+	VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetIsActive200JSONResponse struct {
+	User *User `json:"user,omitempty"`
+}
+
+func (response PostUsersSetIsActive200JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActive401JSONResponse ErrorResponse
+
+func (response PostUsersSetIsActive401JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActive403JSONResponse ErrorResponse
+
+func (response PostUsersSetIsActive403JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActive404JSONResponse ErrorResponse
+
+func (response PostUsersSetIsActive404JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Список API-ключей
+	// (GET /admin/apiKeys)
+	GetAdminApiKeys(ctx context.Context, request GetAdminApiKeysRequestObject) (GetAdminApiKeysResponseObject, error)
+	// Выпустить API-ключ
+	// (POST /admin/apiKeys)
+	PostAdminApiKeys(ctx context.Context, request PostAdminApiKeysRequestObject) (PostAdminApiKeysResponseObject, error)
+	// Отозвать API-ключ
+	// (POST /admin/apiKeys/revoke)
+	PostAdminApiKeysRevoke(ctx context.Context, request PostAdminApiKeysRevokeRequestObject) (PostAdminApiKeysRevokeResponseObject, error)
+	// Журнал изменяющих запросов (от новых к старым)
+	// (GET /admin/audit)
+	GetAdminAudit(ctx context.Context, request GetAdminAuditRequestObject) (GetAdminAuditResponseObject, error)
+	// Массовый импорт команд и участников из CSV или YAML
+	// (POST /admin/import)
+	PostAdminImport(ctx context.Context, request PostAdminImportRequestObject) (PostAdminImportResponseObject, error)
+	// Кто выполняет запрос
+	// (GET /auth/whoami)
+	GetAuthWhoami(ctx context.Context, request GetAuthWhoamiRequestObject) (GetAuthWhoamiResponseObject, error)
+	// Принять событие Merge Request Hook из GitLab
+	// (POST /integrations/gitlab/webhook)
+	PostIntegrationsGitlabWebhook(ctx context.Context, request PostIntegrationsGitlabWebhookRequestObject) (PostIntegrationsGitlabWebhookResponseObject, error)
+	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+	// (POST /pullRequest/create)
+	PostPullRequestCreate(ctx context.Context, request PostPullRequestCreateRequestObject) (PostPullRequestCreateResponseObject, error)
+	// Пометить PR как MERGED (идемпотентная операция)
+	// (POST /pullRequest/merge)
+	PostPullRequestMerge(ctx context.Context, request PostPullRequestMergeRequestObject) (PostPullRequestMergeResponseObject, error)
+	// Переназначить конкретного ревьювера на другого из его команды
+	// (POST /pullRequest/reassign)
+	PostPullRequestReassign(ctx context.Context, request PostPullRequestReassignRequestObject) (PostPullRequestReassignResponseObject, error)
+	// Получить репозиторий и его политику
+	// (GET /repository/get)
+	GetRepositoryGet(ctx context.Context, request GetRepositoryGetRequestObject) (GetRepositoryGetResponseObject, error)
+	// Создать или обновить репозиторий и его политику назначения
+	// (POST /repository/upsert)
+	PostRepositoryUpsert(ctx context.Context, request PostRepositoryUpsertRequestObject) (PostRepositoryUpsertResponseObject, error)
+	// Список групп SCIM (команд)
+	// (GET /scim/v2/Groups)
+	GetScimV2Groups(ctx context.Context, request GetScimV2GroupsRequestObject) (GetScimV2GroupsResponseObject, error)
+	// Создать группу SCIM (команду)
+	// (POST /scim/v2/Groups)
+	PostScimV2Groups(ctx context.Context, request PostScimV2GroupsRequestObject) (PostScimV2GroupsResponseObject, error)
+	// Удалить группу SCIM
+	// (DELETE /scim/v2/Groups/{id})
+	DeleteScimV2GroupsId(ctx context.Context, request DeleteScimV2GroupsIdRequestObject) (DeleteScimV2GroupsIdResponseObject, error)
+	// Получить группу SCIM
+	// (GET /scim/v2/Groups/{id})
+	GetScimV2GroupsId(ctx context.Context, request GetScimV2GroupsIdRequestObject) (GetScimV2GroupsIdResponseObject, error)
+	// Частично изменить группу SCIM
+	// (PATCH /scim/v2/Groups/{id})
+	PatchScimV2GroupsId(ctx context.Context, request PatchScimV2GroupsIdRequestObject) (PatchScimV2GroupsIdResponseObject, error)
+	// Список пользователей SCIM
+	// (GET /scim/v2/Users)
+	GetScimV2Users(ctx context.Context, request GetScimV2UsersRequestObject) (GetScimV2UsersResponseObject, error)
+	// Создать пользователя SCIM
+	// (POST /scim/v2/Users)
+	PostScimV2Users(ctx context.Context, request PostScimV2UsersRequestObject) (PostScimV2UsersResponseObject, error)
+	// Удалить пользователя SCIM
+	// (DELETE /scim/v2/Users/{id})
+	DeleteScimV2UsersId(ctx context.Context, request DeleteScimV2UsersIdRequestObject) (DeleteScimV2UsersIdResponseObject, error)
+	// Получить пользователя SCIM
+	// (GET /scim/v2/Users/{id})
+	GetScimV2UsersId(ctx context.Context, request GetScimV2UsersIdRequestObject) (GetScimV2UsersIdResponseObject, error)
+	// Частично изменить пользователя SCIM
+	// (PATCH /scim/v2/Users/{id})
+	PatchScimV2UsersId(ctx context.Context, request PatchScimV2UsersIdRequestObject) (PatchScimV2UsersIdResponseObject, error)
+	// Заменить атрибуты пользователя SCIM
+	// (PUT /scim/v2/Users/{id})
+	PutScimV2UsersId(ctx context.Context, request PutScimV2UsersIdRequestObject) (PutScimV2UsersIdResponseObject, error)
+	// Равномерность распределения ревью внутри команд
+	// (GET /stats/fairness)
+	GetStatsFairness(ctx context.Context, request GetStatsFairnessRequestObject) (GetStatsFairnessResponseObject, error)
+	// Граф «автор → ревьювер» с весами
+	// (GET /stats/reviewGraph)
+	GetStatsReviewGraph(ctx context.Context, request GetStatsReviewGraphRequestObject) (GetStatsReviewGraphResponseObject, error)
+	// Получить количество назначений ревью по пользователям
+	// (GET /stats/reviewerAssignments)
+	GetStatsReviewerAssignments(ctx context.Context, request GetStatsReviewerAssignmentsRequestObject) (GetStatsReviewerAssignmentsResponseObject, error)
+	// Время от создания до мержа PR (медиана, p90, среднее)
+	// (GET /stats/turnaround)
+	GetStatsTurnaround(ctx context.Context, request GetStatsTurnaroundRequestObject) (GetStatsTurnaroundResponseObject, error)
+	// Удалить команду
+	// (DELETE /team)
+	DeleteTeam(ctx context.Context, request DeleteTeamRequestObject) (DeleteTeamResponseObject, error)
+	// Создать команду с участниками (создаёт/обновляет пользователей)
+	// (POST /team/add)
+	PostTeamAdd(ctx context.Context, request PostTeamAddRequestObject) (PostTeamAddResponseObject, error)
+	// Получить команду с участниками
+	// (GET /team/get)
+	GetTeamGet(ctx context.Context, request GetTeamGetRequestObject) (GetTeamGetResponseObject, error)
+	// Руководители команды
+	// (GET /team/leads)
+	GetTeamLeads(ctx context.Context, request GetTeamLeadsRequestObject) (GetTeamLeadsResponseObject, error)
+	// Назначить руководителя команды (админская)
+	// (POST /team/leads/add)
+	PostTeamLeadsAdd(ctx context.Context, request PostTeamLeadsAddRequestObject) (PostTeamLeadsAddResponseObject, error)
+	// Снять руководителя команды (админская)
+	// (POST /team/leads/remove)
+	PostTeamLeadsRemove(ctx context.Context, request PostTeamLeadsRemoveRequestObject) (PostTeamLeadsRemoveResponseObject, error)
+	// Список команд со счётчиками участников и открытых PR
+	// (GET /team/list)
+	GetTeamList(ctx context.Context, request GetTeamListRequestObject) (GetTeamListResponseObject, error)
+	// Массово деактивировать пользователей команды и безопасно переназначить открытые PR
+	// (POST /team/massDeactivate)
+	PostTeamMassDeactivate(ctx context.Context, request PostTeamMassDeactivateRequestObject) (PostTeamMassDeactivateResponseObject, error)
+	// Переименовать команду (каскадно обновляет users.team_name и team_members)
+	// (POST /team/rename)
+	PostTeamRename(ctx context.Context, request PostTeamRenameRequestObject) (PostTeamRenameResponseObject, error)
+	// Вложить команду в родительское подразделение
+	// (POST /team/setParent)
+	PostTeamSetParent(ctx context.Context, request PostTeamSetParentRequestObject) (PostTeamSetParentResponseObject, error)
+	// Собственные и действующие настройки команды
+	// (GET /team/settings)
+	GetTeamSettings(ctx context.Context, request GetTeamSettingsRequestObject) (GetTeamSettingsResponseObject, error)
+	// Сохранить новую версию настроек команды
+	// (PUT /team/settings)
+	PutTeamSettings(ctx context.Context, request PutTeamSettingsRequestObject) (PutTeamSettingsResponseObject, error)
+	// История версий настроек команды (новые первыми)
+	// (GET /team/settings/history)
+	GetTeamSettingsHistory(ctx context.Context, request GetTeamSettingsHistoryRequestObject) (GetTeamSettingsHistoryResponseObject, error)
+	// Вернуть настройки команды к одной из прошлых версий
+	// (POST /team/settings/rollback)
+	PostTeamSettingsRollback(ctx context.Context, request PostTeamSettingsRollbackRequestObject) (PostTeamSettingsRollbackResponseObject, error)
+	// Дерево подразделений с собственными и действующими настройками
+	// (GET /team/tree)
+	GetTeamTree(ctx context.Context, request GetTeamTreeRequestObject) (GetTeamTreeResponseObject, error)
+	// Добавить и исключить участников команды, заменить её настройки
+	// (PATCH /team/update)
+	PatchTeamUpdate(ctx context.Context, request PatchTeamUpdateRequestObject) (PatchTeamUpdateResponseObject, error)
+	// Обезличить пользователя
+	// (POST /users/anonymize)
+	PostUsersAnonymize(ctx context.Context, request PostUsersAnonymizeRequestObject) (PostUsersAnonymizeResponseObject, error)
+	// Сводка ревьювера одним запросом
+	// (GET /users/dashboard)
+	GetUsersDashboard(ctx context.Context, request GetUsersDashboardRequestObject) (GetUsersDashboardResponseObject, error)
+	// Получить пользователя вместе со списком его команд
+	// (GET /users/get)
+	GetUsersGet(ctx context.Context, request GetUsersGetRequestObject) (GetUsersGetResponseObject, error)
+	// Получить PR'ы, где пользователь назначен ревьювером
+	// (GET /users/getReview)
+	GetUsersGetReview(ctx context.Context, request GetUsersGetReviewRequestObject) (GetUsersGetReviewResponseObject, error)
+	// Привязать учётную запись GitLab/GitHub к пользователю сервиса
+	// (POST /users/linkExternalAccount)
+	PostUsersLinkExternalAccount(ctx context.Context, request PostUsersLinkExternalAccountRequestObject) (PostUsersLinkExternalAccountResponseObject, error)
+	// Справочник пользователей с фильтрами и постраничной выдачей
+	// (GET /users/list)
+	GetUsersList(ctx context.Context, request GetUsersListRequestObject) (GetUsersListResponseObject, error)
+	// Перевести пользователя в другую команду
+	// (POST /users/moveTeam)
+	PostUsersMoveTeam(ctx context.Context, request PostUsersMoveTeamRequestObject) (PostUsersMoveTeamResponseObject, error)
+	// Запланировать активацию или деактивацию пользователя
+	// (POST /users/scheduleActivation)
+	PostUsersScheduleActivation(ctx context.Context, request PostUsersScheduleActivationRequestObject) (PostUsersScheduleActivationResponseObject, error)
+	// Запланированные изменения активности (по effective_at)
+	// (GET /users/scheduledActivations)
+	GetUsersScheduledActivations(ctx context.Context, request GetUsersScheduledActivationsRequestObject) (GetUsersScheduledActivationsResponseObject, error)
+	// Отменить запланированное изменение
+	// (POST /users/scheduledActivations/cancel)
+	PostUsersScheduledActivationsCancel(ctx context.Context, request PostUsersScheduledActivationsCancelRequestObject) (PostUsersScheduledActivationsCancelResponseObject, error)
+	// Установить флаг активности пользователя
+	// (POST /users/setIsActive)
+	PostUsersSetIsActive(ctx context.Context, request PostUsersSetIsActiveRequestObject) (PostUsersSetIsActiveResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// GetAdminApiKeys operation middleware
+func (sh *strictHandler) GetAdminApiKeys(w http.ResponseWriter, r *http.Request, params GetAdminApiKeysParams) {
+	var request GetAdminApiKeysRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminApiKeys(ctx, request.(GetAdminApiKeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminApiKeys")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAdminApiKeysResponseObject); ok {
+		if err := validResponse.VisitGetAdminApiKeysResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAdminApiKeys operation middleware
+func (sh *strictHandler) PostAdminApiKeys(w http.ResponseWriter, r *http.Request) {
+	var request PostAdminApiKeysRequestObject
+
+	var body PostAdminApiKeysJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminApiKeys(ctx, request.(PostAdminApiKeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminApiKeys")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAdminApiKeysResponseObject); ok {
+		if err := validResponse.VisitPostAdminApiKeysResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAdminApiKeysRevoke operation middleware
+func (sh *strictHandler) PostAdminApiKeysRevoke(w http.ResponseWriter, r *http.Request) {
+	var request PostAdminApiKeysRevokeRequestObject
+
+	var body PostAdminApiKeysRevokeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminApiKeysRevoke(ctx, request.(PostAdminApiKeysRevokeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminApiKeysRevoke")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAdminApiKeysRevokeResponseObject); ok {
+		if err := validResponse.VisitPostAdminApiKeysRevokeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAdminAudit operation middleware
+func (sh *strictHandler) GetAdminAudit(w http.ResponseWriter, r *http.Request, params GetAdminAuditParams) {
+	var request GetAdminAuditRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminAudit(ctx, request.(GetAdminAuditRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminAudit")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAdminAuditResponseObject); ok {
+		if err := validResponse.VisitGetAdminAuditResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAdminImport operation middleware
+func (sh *strictHandler) PostAdminImport(w http.ResponseWriter, r *http.Request, params PostAdminImportParams) {
+	var request PostAdminImportRequestObject
+
+	request.Params = params
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't read body: %w", err))
+		return
+	}
+	body := PostAdminImportTextRequestBody(data)
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminImport(ctx, request.(PostAdminImportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminImport")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAdminImportResponseObject); ok {
+		if err := validResponse.VisitPostAdminImportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAuthWhoami operation middleware
+func (sh *strictHandler) GetAuthWhoami(w http.ResponseWriter, r *http.Request) {
+	var request GetAuthWhoamiRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAuthWhoami(ctx, request.(GetAuthWhoamiRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAuthWhoami")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAuthWhoamiResponseObject); ok {
+		if err := validResponse.VisitGetAuthWhoamiResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostIntegrationsGitlabWebhook operation middleware
+func (sh *strictHandler) PostIntegrationsGitlabWebhook(w http.ResponseWriter, r *http.Request, params PostIntegrationsGitlabWebhookParams) {
+	var request PostIntegrationsGitlabWebhookRequestObject
+
+	request.Params = params
+
+	var body PostIntegrationsGitlabWebhookJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostIntegrationsGitlabWebhook(ctx, request.(PostIntegrationsGitlabWebhookRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostIntegrationsGitlabWebhook")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostIntegrationsGitlabWebhookResponseObject); ok {
+		if err := validResponse.VisitPostIntegrationsGitlabWebhookResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestCreate operation middleware
+func (sh *strictHandler) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
+	var request PostPullRequestCreateRequestObject
+
+	var body PostPullRequestCreateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestCreate(ctx, request.(PostPullRequestCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestCreate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestCreateResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestCreateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestMerge operation middleware
+func (sh *strictHandler) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
+	var request PostPullRequestMergeRequestObject
+
+	var body PostPullRequestMergeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestMerge(ctx, request.(PostPullRequestMergeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestMerge")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestMergeResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestMergeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestReassign operation middleware
+func (sh *strictHandler) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
+	var request PostPullRequestReassignRequestObject
+
+	var body PostPullRequestReassignJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestReassign(ctx, request.(PostPullRequestReassignRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestReassign")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestReassignResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestReassignResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetRepositoryGet operation middleware
+func (sh *strictHandler) GetRepositoryGet(w http.ResponseWriter, r *http.Request, params GetRepositoryGetParams) {
+	var request GetRepositoryGetRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRepositoryGet(ctx, request.(GetRepositoryGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRepositoryGet")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRepositoryGetResponseObject); ok {
+		if err := validResponse.VisitGetRepositoryGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostRepositoryUpsert operation middleware
+func (sh *strictHandler) PostRepositoryUpsert(w http.ResponseWriter, r *http.Request) {
+	var request PostRepositoryUpsertRequestObject
+
+	var body PostRepositoryUpsertJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostRepositoryUpsert(ctx, request.(PostRepositoryUpsertRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostRepositoryUpsert")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostRepositoryUpsertResponseObject); ok {
+		if err := validResponse.VisitPostRepositoryUpsertResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetScimV2Groups operation middleware
+func (sh *strictHandler) GetScimV2Groups(w http.ResponseWriter, r *http.Request, params GetScimV2GroupsParams) {
+	var request GetScimV2GroupsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetScimV2Groups(ctx, request.(GetScimV2GroupsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetScimV2Groups")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetScimV2GroupsResponseObject); ok {
+		if err := validResponse.VisitGetScimV2GroupsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostScimV2Groups operation middleware
+func (sh *strictHandler) PostScimV2Groups(w http.ResponseWriter, r *http.Request) {
+	var request PostScimV2GroupsRequestObject
+
+	var body PostScimV2GroupsApplicationScimPlusJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostScimV2Groups(ctx, request.(PostScimV2GroupsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostScimV2Groups")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostScimV2GroupsResponseObject); ok {
+		if err := validResponse.VisitPostScimV2GroupsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteScimV2GroupsId operation middleware
+func (sh *strictHandler) DeleteScimV2GroupsId(w http.ResponseWriter, r *http.Request, id ScimIdPath) {
+	var request DeleteScimV2GroupsIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteScimV2GroupsId(ctx, request.(DeleteScimV2GroupsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteScimV2GroupsId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteScimV2GroupsIdResponseObject); ok {
+		if err := validResponse.VisitDeleteScimV2GroupsIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetScimV2GroupsId operation middleware
+func (sh *strictHandler) GetScimV2GroupsId(w http.ResponseWriter, r *http.Request, id ScimIdPath) {
+	var request GetScimV2GroupsIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetScimV2GroupsId(ctx, request.(GetScimV2GroupsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetScimV2GroupsId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetScimV2GroupsIdResponseObject); ok {
+		if err := validResponse.VisitGetScimV2GroupsIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchScimV2GroupsId operation middleware
+func (sh *strictHandler) PatchScimV2GroupsId(w http.ResponseWriter, r *http.Request, id ScimIdPath) {
+	var request PatchScimV2GroupsIdRequestObject
+
+	request.Id = id
+
+	var body PatchScimV2GroupsIdApplicationScimPlusJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchScimV2GroupsId(ctx, request.(PatchScimV2GroupsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchScimV2GroupsId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchScimV2GroupsIdResponseObject); ok {
+		if err := validResponse.VisitPatchScimV2GroupsIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetScimV2Users operation middleware
+func (sh *strictHandler) GetScimV2Users(w http.ResponseWriter, r *http.Request, params GetScimV2UsersParams) {
+	var request GetScimV2UsersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetScimV2Users(ctx, request.(GetScimV2UsersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetScimV2Users")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetScimV2UsersResponseObject); ok {
+		if err := validResponse.VisitGetScimV2UsersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostScimV2Users operation middleware
+func (sh *strictHandler) PostScimV2Users(w http.ResponseWriter, r *http.Request) {
+	var request PostScimV2UsersRequestObject
+
+	var body PostScimV2UsersApplicationScimPlusJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostScimV2Users(ctx, request.(PostScimV2UsersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostScimV2Users")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostScimV2UsersResponseObject); ok {
+		if err := validResponse.VisitPostScimV2UsersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteScimV2UsersId operation middleware
+func (sh *strictHandler) DeleteScimV2UsersId(w http.ResponseWriter, r *http.Request, id ScimIdPath) {
+	var request DeleteScimV2UsersIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteScimV2UsersId(ctx, request.(DeleteScimV2UsersIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteScimV2UsersId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteScimV2UsersIdResponseObject); ok {
+		if err := validResponse.VisitDeleteScimV2UsersIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetScimV2UsersId operation middleware
+func (sh *strictHandler) GetScimV2UsersId(w http.ResponseWriter, r *http.Request, id ScimIdPath) {
+	var request GetScimV2UsersIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetScimV2UsersId(ctx, request.(GetScimV2UsersIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetScimV2UsersId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetScimV2UsersIdResponseObject); ok {
+		if err := validResponse.VisitGetScimV2UsersIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchScimV2UsersId operation middleware
+func (sh *strictHandler) PatchScimV2UsersId(w http.ResponseWriter, r *http.Request, id ScimIdPath) {
+	var request PatchScimV2UsersIdRequestObject
+
+	request.Id = id
+
+	var body PatchScimV2UsersIdApplicationScimPlusJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchScimV2UsersId(ctx, request.(PatchScimV2UsersIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchScimV2UsersId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchScimV2UsersIdResponseObject); ok {
+		if err := validResponse.VisitPatchScimV2UsersIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutScimV2UsersId operation middleware
+func (sh *strictHandler) PutScimV2UsersId(w http.ResponseWriter, r *http.Request, id ScimIdPath) {
+	var request PutScimV2UsersIdRequestObject
+
+	request.Id = id
+
+	var body PutScimV2UsersIdApplicationScimPlusJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutScimV2UsersId(ctx, request.(PutScimV2UsersIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutScimV2UsersId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutScimV2UsersIdResponseObject); ok {
+		if err := validResponse.VisitPutScimV2UsersIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetStatsFairness operation middleware
+func (sh *strictHandler) GetStatsFairness(w http.ResponseWriter, r *http.Request, params GetStatsFairnessParams) {
+	var request GetStatsFairnessRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStatsFairness(ctx, request.(GetStatsFairnessRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStatsFairness")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetStatsFairnessResponseObject); ok {
+		if err := validResponse.VisitGetStatsFairnessResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetStatsReviewGraph operation middleware
+func (sh *strictHandler) GetStatsReviewGraph(w http.ResponseWriter, r *http.Request, params GetStatsReviewGraphParams) {
+	var request GetStatsReviewGraphRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStatsReviewGraph(ctx, request.(GetStatsReviewGraphRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStatsReviewGraph")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetStatsReviewGraphResponseObject); ok {
+		if err := validResponse.VisitGetStatsReviewGraphResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetStatsReviewerAssignments operation middleware
+func (sh *strictHandler) GetStatsReviewerAssignments(w http.ResponseWriter, r *http.Request, params GetStatsReviewerAssignmentsParams) {
+	var request GetStatsReviewerAssignmentsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStatsReviewerAssignments(ctx, request.(GetStatsReviewerAssignmentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStatsReviewerAssignments")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetStatsReviewerAssignmentsResponseObject); ok {
+		if err := validResponse.VisitGetStatsReviewerAssignmentsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetStatsTurnaround operation middleware
+func (sh *strictHandler) GetStatsTurnaround(w http.ResponseWriter, r *http.Request, params GetStatsTurnaroundParams) {
+	var request GetStatsTurnaroundRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStatsTurnaround(ctx, request.(GetStatsTurnaroundRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStatsTurnaround")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetStatsTurnaroundResponseObject); ok {
+		if err := validResponse.VisitGetStatsTurnaroundResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteTeam operation middleware
+func (sh *strictHandler) DeleteTeam(w http.ResponseWriter, r *http.Request, params DeleteTeamParams) {
+	var request DeleteTeamRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTeam(ctx, request.(DeleteTeamRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTeam")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteTeamResponseObject); ok {
+		if err := validResponse.VisitDeleteTeamResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTeamAdd operation middleware
+func (sh *strictHandler) PostTeamAdd(w http.ResponseWriter, r *http.Request, params PostTeamAddParams) {
+	var request PostTeamAddRequestObject
+
+	request.Params = params
+
+	var body PostTeamAddJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamAdd(ctx, request.(PostTeamAddRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamAdd")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTeamAddResponseObject); ok {
+		if err := validResponse.VisitPostTeamAddResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTeamGet operation middleware
+func (sh *strictHandler) GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams) {
+	var request GetTeamGetRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamGet(ctx, request.(GetTeamGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamGet")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTeamGetResponseObject); ok {
+		if err := validResponse.VisitGetTeamGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTeamLeads operation middleware
+func (sh *strictHandler) GetTeamLeads(w http.ResponseWriter, r *http.Request, params GetTeamLeadsParams) {
+	var request GetTeamLeadsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamLeads(ctx, request.(GetTeamLeadsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamLeads")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTeamLeadsResponseObject); ok {
+		if err := validResponse.VisitGetTeamLeadsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTeamLeadsAdd operation middleware
+func (sh *strictHandler) PostTeamLeadsAdd(w http.ResponseWriter, r *http.Request) {
+	var request PostTeamLeadsAddRequestObject
+
+	var body PostTeamLeadsAddJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamLeadsAdd(ctx, request.(PostTeamLeadsAddRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamLeadsAdd")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTeamLeadsAddResponseObject); ok {
+		if err := validResponse.VisitPostTeamLeadsAddResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTeamLeadsRemove operation middleware
+func (sh *strictHandler) PostTeamLeadsRemove(w http.ResponseWriter, r *http.Request) {
+	var request PostTeamLeadsRemoveRequestObject
+
+	var body PostTeamLeadsRemoveJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamLeadsRemove(ctx, request.(PostTeamLeadsRemoveRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamLeadsRemove")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTeamLeadsRemoveResponseObject); ok {
+		if err := validResponse.VisitPostTeamLeadsRemoveResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTeamList operation middleware
+func (sh *strictHandler) GetTeamList(w http.ResponseWriter, r *http.Request, params GetTeamListParams) {
+	var request GetTeamListRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamList(ctx, request.(GetTeamListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTeamListResponseObject); ok {
+		if err := validResponse.VisitGetTeamListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTeamMassDeactivate operation middleware
+func (sh *strictHandler) PostTeamMassDeactivate(w http.ResponseWriter, r *http.Request) {
+	var request PostTeamMassDeactivateRequestObject
+
+	var body PostTeamMassDeactivateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamMassDeactivate(ctx, request.(PostTeamMassDeactivateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamMassDeactivate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTeamMassDeactivateResponseObject); ok {
+		if err := validResponse.VisitPostTeamMassDeactivateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTeamRename operation middleware
+func (sh *strictHandler) PostTeamRename(w http.ResponseWriter, r *http.Request) {
+	var request PostTeamRenameRequestObject
+
+	var body PostTeamRenameJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamRename(ctx, request.(PostTeamRenameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamRename")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTeamRenameResponseObject); ok {
+		if err := validResponse.VisitPostTeamRenameResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTeamSetParent operation middleware
+func (sh *strictHandler) PostTeamSetParent(w http.ResponseWriter, r *http.Request) {
+	var request PostTeamSetParentRequestObject
+
+	var body PostTeamSetParentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamSetParent(ctx, request.(PostTeamSetParentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamSetParent")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTeamSetParentResponseObject); ok {
+		if err := validResponse.VisitPostTeamSetParentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTeamSettings operation middleware
+func (sh *strictHandler) GetTeamSettings(w http.ResponseWriter, r *http.Request, params GetTeamSettingsParams) {
+	var request GetTeamSettingsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamSettings(ctx, request.(GetTeamSettingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamSettings")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTeamSettingsResponseObject); ok {
+		if err := validResponse.VisitGetTeamSettingsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutTeamSettings operation middleware
+func (sh *strictHandler) PutTeamSettings(w http.ResponseWriter, r *http.Request) {
+	var request PutTeamSettingsRequestObject
+
+	var body PutTeamSettingsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutTeamSettings(ctx, request.(PutTeamSettingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutTeamSettings")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutTeamSettingsResponseObject); ok {
+		if err := validResponse.VisitPutTeamSettingsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response PostUsersSetIsActive200JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetTeamSettingsHistory operation middleware
+func (sh *strictHandler) GetTeamSettingsHistory(w http.ResponseWriter, r *http.Request, params GetTeamSettingsHistoryParams) {
+	var request GetTeamSettingsHistoryRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type PostUsersSetIsActive401JSONResponse ErrorResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamSettingsHistory(ctx, request.(GetTeamSettingsHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamSettingsHistory")
+	}
 
-func (response PostUsersSetIsActive401JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTeamSettingsHistoryResponseObject); ok {
+		if err := validResponse.VisitGetTeamSettingsHistoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostUsersSetIsActive404JSONResponse ErrorResponse
+// PostTeamSettingsRollback operation middleware
+func (sh *strictHandler) PostTeamSettingsRollback(w http.ResponseWriter, r *http.Request) {
+	var request PostTeamSettingsRollbackRequestObject
 
-func (response PostUsersSetIsActive404JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var body PostTeamSettingsRollbackJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamSettingsRollback(ctx, request.(PostTeamSettingsRollbackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamSettingsRollback")
+	}
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
-	// (POST /pullRequest/create)
-	PostPullRequestCreate(ctx context.Context, request PostPullRequestCreateRequestObject) (PostPullRequestCreateResponseObject, error)
-	// Пометить PR как MERGED (идемпотентная операция)
-	// (POST /pullRequest/merge)
-	PostPullRequestMerge(ctx context.Context, request PostPullRequestMergeRequestObject) (PostPullRequestMergeResponseObject, error)
-	// Переназначить конкретного ревьювера на другого из его команды
-	// (POST /pullRequest/reassign)
-	PostPullRequestReassign(ctx context.Context, request PostPullRequestReassignRequestObject) (PostPullRequestReassignResponseObject, error)
-	// Получить количество назначений ревью по пользователям
-	// (GET /stats/reviewerAssignments)
-	GetStatsReviewerAssignments(ctx context.Context, request GetStatsReviewerAssignmentsRequestObject) (GetStatsReviewerAssignmentsResponseObject, error)
-	// Создать команду с участниками (создаёт/обновляет пользователей)
-	// (POST /team/add)
-	PostTeamAdd(ctx context.Context, request PostTeamAddRequestObject) (PostTeamAddResponseObject, error)
-	// Получить команду с участниками
-	// (GET /team/get)
-	GetTeamGet(ctx context.Context, request GetTeamGetRequestObject) (GetTeamGetResponseObject, error)
-	// Массово деактивировать пользователей команды и безопасно переназначить открытые PR
-	// (POST /team/massDeactivate)
-	PostTeamMassDeactivate(ctx context.Context, request PostTeamMassDeactivateRequestObject) (PostTeamMassDeactivateResponseObject, error)
-	// Получить PR'ы, где пользователь назначен ревьювером
-	// (GET /users/getReview)
-	GetUsersGetReview(ctx context.Context, request GetUsersGetReviewRequestObject) (GetUsersGetReviewResponseObject, error)
-	// Установить флаг активности пользователя
-	// (POST /users/setIsActive)
-	PostUsersSetIsActive(ctx context.Context, request PostUsersSetIsActiveRequestObject) (PostUsersSetIsActiveResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTeamSettingsRollbackResponseObject); ok {
+		if err := validResponse.VisitPostTeamSettingsRollbackResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+// GetTeamTree operation middleware
+func (sh *strictHandler) GetTeamTree(w http.ResponseWriter, r *http.Request, params GetTeamTreeParams) {
+	var request GetTeamTreeRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	request.Params = params
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamTree(ctx, request.(GetTeamTreeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamTree")
+	}
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTeamTreeResponseObject); ok {
+		if err := validResponse.VisitGetTeamTreeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// PostPullRequestCreate operation middleware
-func (sh *strictHandler) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
-	var request PostPullRequestCreateRequestObject
+// PatchTeamUpdate operation middleware
+func (sh *strictHandler) PatchTeamUpdate(w http.ResponseWriter, r *http.Request) {
+	var request PatchTeamUpdateRequestObject
 
-	var body PostPullRequestCreateJSONRequestBody
+	var body PatchTeamUpdateJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -872,18 +7614,18 @@ func (sh *strictHandler) PostPullRequestCreate(w http.ResponseWriter, r *http.Re
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPullRequestCreate(ctx, request.(PostPullRequestCreateRequestObject))
+		return sh.ssi.PatchTeamUpdate(ctx, request.(PatchTeamUpdateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPullRequestCreate")
+		handler = middleware(handler, "PatchTeamUpdate")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostPullRequestCreateResponseObject); ok {
-		if err := validResponse.VisitPostPullRequestCreateResponse(w); err != nil {
+	} else if validResponse, ok := response.(PatchTeamUpdateResponseObject); ok {
+		if err := validResponse.VisitPatchTeamUpdateResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -891,11 +7633,11 @@ func (sh *strictHandler) PostPullRequestCreate(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// PostPullRequestMerge operation middleware
-func (sh *strictHandler) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
-	var request PostPullRequestMergeRequestObject
+// PostUsersAnonymize operation middleware
+func (sh *strictHandler) PostUsersAnonymize(w http.ResponseWriter, r *http.Request) {
+	var request PostUsersAnonymizeRequestObject
 
-	var body PostPullRequestMergeJSONRequestBody
+	var body PostUsersAnonymizeJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -903,18 +7645,18 @@ func (sh *strictHandler) PostPullRequestMerge(w http.ResponseWriter, r *http.Req
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPullRequestMerge(ctx, request.(PostPullRequestMergeRequestObject))
+		return sh.ssi.PostUsersAnonymize(ctx, request.(PostUsersAnonymizeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPullRequestMerge")
+		handler = middleware(handler, "PostUsersAnonymize")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostPullRequestMergeResponseObject); ok {
-		if err := validResponse.VisitPostPullRequestMergeResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostUsersAnonymizeResponseObject); ok {
+		if err := validResponse.VisitPostUsersAnonymizeResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -922,30 +7664,51 @@ func (sh *strictHandler) PostPullRequestMerge(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// PostPullRequestReassign operation middleware
-func (sh *strictHandler) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
-	var request PostPullRequestReassignRequestObject
+// GetUsersDashboard operation middleware
+func (sh *strictHandler) GetUsersDashboard(w http.ResponseWriter, r *http.Request, params GetUsersDashboardParams) {
+	var request GetUsersDashboardRequestObject
 
-	var body PostPullRequestReassignJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUsersDashboard(ctx, request.(GetUsersDashboardRequestObject))
 	}
-	request.Body = &body
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUsersDashboard")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetUsersDashboardResponseObject); ok {
+		if err := validResponse.VisitGetUsersDashboardResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetUsersGet operation middleware
+func (sh *strictHandler) GetUsersGet(w http.ResponseWriter, r *http.Request, params GetUsersGetParams) {
+	var request GetUsersGetRequestObject
+
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPullRequestReassign(ctx, request.(PostPullRequestReassignRequestObject))
+		return sh.ssi.GetUsersGet(ctx, request.(GetUsersGetRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPullRequestReassign")
+		handler = middleware(handler, "GetUsersGet")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostPullRequestReassignResponseObject); ok {
-		if err := validResponse.VisitPostPullRequestReassignResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetUsersGetResponseObject); ok {
+		if err := validResponse.VisitGetUsersGetResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -953,23 +7716,25 @@ func (sh *strictHandler) PostPullRequestReassign(w http.ResponseWriter, r *http.
 	}
 }
 
-// GetStatsReviewerAssignments operation middleware
-func (sh *strictHandler) GetStatsReviewerAssignments(w http.ResponseWriter, r *http.Request) {
-	var request GetStatsReviewerAssignmentsRequestObject
+// GetUsersGetReview operation middleware
+func (sh *strictHandler) GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams) {
+	var request GetUsersGetReviewRequestObject
+
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetStatsReviewerAssignments(ctx, request.(GetStatsReviewerAssignmentsRequestObject))
+		return sh.ssi.GetUsersGetReview(ctx, request.(GetUsersGetReviewRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetStatsReviewerAssignments")
+		handler = middleware(handler, "GetUsersGetReview")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetStatsReviewerAssignmentsResponseObject); ok {
-		if err := validResponse.VisitGetStatsReviewerAssignmentsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetUsersGetReviewResponseObject); ok {
+		if err := validResponse.VisitGetUsersGetReviewResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -977,11 +7742,11 @@ func (sh *strictHandler) GetStatsReviewerAssignments(w http.ResponseWriter, r *h
 	}
 }
 
-// PostTeamAdd operation middleware
-func (sh *strictHandler) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
-	var request PostTeamAddRequestObject
+// PostUsersLinkExternalAccount operation middleware
+func (sh *strictHandler) PostUsersLinkExternalAccount(w http.ResponseWriter, r *http.Request) {
+	var request PostUsersLinkExternalAccountRequestObject
 
-	var body PostTeamAddJSONRequestBody
+	var body PostUsersLinkExternalAccountJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -989,18 +7754,18 @@ func (sh *strictHandler) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostTeamAdd(ctx, request.(PostTeamAddRequestObject))
+		return sh.ssi.PostUsersLinkExternalAccount(ctx, request.(PostUsersLinkExternalAccountRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostTeamAdd")
+		handler = middleware(handler, "PostUsersLinkExternalAccount")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostTeamAddResponseObject); ok {
-		if err := validResponse.VisitPostTeamAddResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostUsersLinkExternalAccountResponseObject); ok {
+		if err := validResponse.VisitPostUsersLinkExternalAccountResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1008,25 +7773,25 @@ func (sh *strictHandler) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetTeamGet operation middleware
-func (sh *strictHandler) GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams) {
-	var request GetTeamGetRequestObject
+// GetUsersList operation middleware
+func (sh *strictHandler) GetUsersList(w http.ResponseWriter, r *http.Request, params GetUsersListParams) {
+	var request GetUsersListRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTeamGet(ctx, request.(GetTeamGetRequestObject))
+		return sh.ssi.GetUsersList(ctx, request.(GetUsersListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTeamGet")
+		handler = middleware(handler, "GetUsersList")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetTeamGetResponseObject); ok {
-		if err := validResponse.VisitGetTeamGetResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetUsersListResponseObject); ok {
+		if err := validResponse.VisitGetUsersListResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1034,11 +7799,11 @@ func (sh *strictHandler) GetTeamGet(w http.ResponseWriter, r *http.Request, para
 	}
 }
 
-// PostTeamMassDeactivate operation middleware
-func (sh *strictHandler) PostTeamMassDeactivate(w http.ResponseWriter, r *http.Request) {
-	var request PostTeamMassDeactivateRequestObject
+// PostUsersMoveTeam operation middleware
+func (sh *strictHandler) PostUsersMoveTeam(w http.ResponseWriter, r *http.Request) {
+	var request PostUsersMoveTeamRequestObject
 
-	var body PostTeamMassDeactivateJSONRequestBody
+	var body PostUsersMoveTeamJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1046,18 +7811,18 @@ func (sh *strictHandler) PostTeamMassDeactivate(w http.ResponseWriter, r *http.R
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostTeamMassDeactivate(ctx, request.(PostTeamMassDeactivateRequestObject))
+		return sh.ssi.PostUsersMoveTeam(ctx, request.(PostUsersMoveTeamRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostTeamMassDeactivate")
+		handler = middleware(handler, "PostUsersMoveTeam")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostTeamMassDeactivateResponseObject); ok {
-		if err := validResponse.VisitPostTeamMassDeactivateResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostUsersMoveTeamResponseObject); ok {
+		if err := validResponse.VisitPostUsersMoveTeamResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1065,25 +7830,87 @@ func (sh *strictHandler) PostTeamMassDeactivate(w http.ResponseWriter, r *http.R
 	}
 }
 
-// GetUsersGetReview operation middleware
-func (sh *strictHandler) GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams) {
-	var request GetUsersGetReviewRequestObject
+// PostUsersScheduleActivation operation middleware
+func (sh *strictHandler) PostUsersScheduleActivation(w http.ResponseWriter, r *http.Request) {
+	var request PostUsersScheduleActivationRequestObject
+
+	var body PostUsersScheduleActivationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersScheduleActivation(ctx, request.(PostUsersScheduleActivationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersScheduleActivation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostUsersScheduleActivationResponseObject); ok {
+		if err := validResponse.VisitPostUsersScheduleActivationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetUsersScheduledActivations operation middleware
+func (sh *strictHandler) GetUsersScheduledActivations(w http.ResponseWriter, r *http.Request, params GetUsersScheduledActivationsParams) {
+	var request GetUsersScheduledActivationsRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetUsersGetReview(ctx, request.(GetUsersGetReviewRequestObject))
+		return sh.ssi.GetUsersScheduledActivations(ctx, request.(GetUsersScheduledActivationsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetUsersGetReview")
+		handler = middleware(handler, "GetUsersScheduledActivations")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetUsersGetReviewResponseObject); ok {
-		if err := validResponse.VisitGetUsersGetReviewResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetUsersScheduledActivationsResponseObject); ok {
+		if err := validResponse.VisitGetUsersScheduledActivationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostUsersScheduledActivationsCancel operation middleware
+func (sh *strictHandler) PostUsersScheduledActivationsCancel(w http.ResponseWriter, r *http.Request) {
+	var request PostUsersScheduledActivationsCancelRequestObject
+
+	var body PostUsersScheduledActivationsCancelJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersScheduledActivationsCancel(ctx, request.(PostUsersScheduledActivationsCancelRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersScheduledActivationsCancel")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostUsersScheduledActivationsCancelResponseObject); ok {
+		if err := validResponse.VisitPostUsersScheduledActivationsCancelResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1125,50 +7952,266 @@ func (sh *strictHandler) PostUsersSetIsActive(w http.ResponseWriter, r *http.Req
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9xa624bxxV+lcG0QGyAFinZDlD+U2zFFQrLKiUDRQ1BGHHH0ibkLr0XN4JBwJLSJqmM",
-	"qPlXGE3cIi9A02JFUyL1CmfeqDgzeyVnV0tRlpv8MeTl7M435/Kdy5wXtG43W7bFLc+l1Re0xRzW5B53",
-	"5P/WOWuusCb/o8+dXXxgcLfumC3PtC1apfAzDKEPA+jAqXgFQxhBj0AfzsQRgQGM4Aw6MIRjcUhL1MQ3",
-	"nskPlajFmpxWqcdZc1P+XaIOf+abDjdo1XN8XqJufYc3GW7q7bZwses5prVN2+0SfexyZ9nIQvVPOIYe",
-	"DMU+9MXXCp/Yh5F4SeAcRhLqCYygKx/34FQcZcDzXe5smsZU4Nrhj1KAS45jOzXutmzL5fiAf8WarYb6",
-	"E3/DP+q2gZ9YebS++fmjxyv3aYk2ueuybXzqcNf2nTonlu2Rp7ZvGVICLcduccczuZv6VPqx+vALyi2/",
-	"SatP6PrS4sPNpT8tr62v0RJdraX+frhUe7CEeyOOxbW15QcrwX837y2u3F++v7i+FPyqUG6Uxg+fwK3T",
-	"WizEJwpavD7+lr31Ba97E+vVCSeXlehD5rr3Oat75nPm8Rp/5nPXm5RFbGo6g5m02BKBrnykTAdG8J7A",
-	"UBzAf9HOiTSxDgzQyKALfblCWpR4lWFm0IP3VCOzwMpcDbD/wDn0xR6MYIB+lWHWuEnOnoj1FM+XhtwR",
-	"f4M+9NH0Pd50NSqLsDLHYbsTKkk6b3SGIhpy/YZGQUa0wtis277laeTxGs8IffEN9MSe2IcujIg4EHtw",
-	"Dj3xba5iYAhDcSj+Wkg5puXxbe4geMv2Nh3OXNfctqZEtlorEXiHgAicQAfOUH3QlyYiXkIPuuKV+B56",
-	"oQaH0CPiAI6RTmEk9sQrLaLZ0aQ3IfAWelIa59ARe0qK5xIW4u3ACf4rvslErkE5ZiqTutUcI0PWOota",
-	"9RuNTE+P3nf4c5P/JQhlaQkF9kpS5+tFNpI4YzfSz43K3NzCzSn8pUSZ7+3YMoToVtcdjhJZlGd4ajtN",
-	"5tEqNZjHb3mmdCvLbzTYFoYLFXY0fOtsz/aFlt9obDpKlllAU2tCDp1Y5XrM891kvHm0urRCSzSILJPx",
-	"YsxKxqHoNk7KNNqypNP5BXaztmM7OuPJ1divQVg6udQCqa15LM+hIrqJTM20vE/vaFkqzJ0uzATiJKuA",
-	"42NCOgmwyZtbgZtHvvlbhz+lVfqbcpzfloPErIxfeSjf0TltKlPIx54MgSGILNjBhhPgTXdTkmNyuy3b",
-	"bnBm5ctR/VYMaCzk6J1SYmcdZsyxp0abJ7sPepakJvLOhR8zrae23Mb0kBjpao2EDkAWpQ02ueWRNe48",
-	"N+uc3FjnrkfWmftliXzOGg2yUFm4i3HgOXdcFU7m5ypzFTyF3eIWa5m0Sm/PVeZuo1cyb0dKrtyKyaes",
-	"qF+K11YxDIXMMDotGwjJdr0EWd1Ty5UcuOt9Zhu7KsG3PK5ckrVaDbMuv1D+wkVUqWIjwWvUn6caKqMt",
-	"59Z8pTKvZZIqXTQM4nLm1HdoO1n/fAz6nJEK9VaRLvHkA1W2yYMtVOanE3jLycpFnlB/AY33NgK5Sr3E",
-	"UUUFk3aOohS8PJJM5ljttlZk6ZxqtUZkqXIik8shKvNO5U4BqcUY8/CkS2nN/vAP6KqCqJws5aCjMmuZ",
-	"6r0PaqhDhe530+l0vGJPVtBxxb5aI6ZBWMPhzNgl/CvT9dwxXcx0TpQzlqE9IvbEgfguzPHFAfTEvtrJ",
-	"bzaZs6tKyEAjMnlfrRHoE+goSaGIZDkp6wQY4E+TCT8cw4gs6HNi6MPJWOEcfV28hA4yM9uWRp+wJ5du",
-	"IMoUI8pMtjAhPpSrZ+DDbDfLc5oL+esCZroc81Suh3niWoJihLs1X7m1cGd9fqF6+0717qd/vjJuCjLc",
-	"62cn6EqCkt4yEkeyd9knIZxrZivEM05L4777RvpVT7po6L0D6MAgAE1uBE2hMziXjSrVHkLnPSKynkc/",
-	"lY0ecXSzuC+GNXhhd6yFL8zgkXbD2IwSRGWol3LS1HculYRcmF4kt/j4Lo1Zpn/3gycTeIZWg9W5sbmF",
-	"1unfpVfnwWMfz2nXjKALI3gHo8mA1KEXFs0OTe+0UYA54I2mFyY7eei+XXGoOorozYjvozBJH04xeOuv",
-	"N17pmGbK9CeoszFCSOgxSf2o9oAT5BzZ4hRHURdc7MEp9Eh0t/CcNfysVCpaFKdSdWZZtkdCPiK2RRQG",
-	"slpTorDse8wyTCOoptK4xL5MXpDwxQGchw3gQZAY9lVaFLQwM6GNXYDE6CybqDqTBCYly8Z6iIeYFsGy",
-	"NATqLQb+Owb0Ta7S3opDOJ3oU+qysbP8Q6QudZL3S0Hla7ryiikkGeLZxNsx3UDSV5e+wo/QES/Fgfg2",
-	"dqJjFegSPXp0Z+iiXet70aqTfjQZMbPa1jJJHcIAf5YxMotEpKwJHCNGXCKXqTy3p/4ev9nMiarIoW45",
-	"lHHcXJDutM01kfUB99bwpZrmnUuFkSyGltAKt8xSHcKLbobUp4tw66M/6HKeU3GQ1tv4XYaGit8nVCmp",
-	"J/OyF87knmX0zTIzjPwsZ52z5qJhzJLZRO3JJ6n+mWrIJ1Ke+WRLq0oXG2ad03Yp/6WF9Euf2Vu0vZFq",
-	"xNEW21X2U9iJ1yPauuKuiBf0bz+2SLZY/UseXKVnOUiItYCgimQRr1MtiWSnBDoqHFdm60akb/djho/O",
-	"/QF7EuOnu2x/IsWtB0TsEUkFHfmFcNbkDPrkRixA8YPYL8MI3gbZIbq4DP2ZF73JUgg1GLK1ZISAlbPI",
-	"Gdc/4J7s7MajMk/08ouXlNOjNGiPs5UE/zceND2njJnOT/BW/B16MECdpWPrtbcQX+f3DdFTC4SrAgac",
-	"Z4HN1LzExeEpPV8xS6TSKTg5oJJoIBXWu34+50NUyU40WaKZJVnIGuWY101U3M0LDPE+05xbvlMsVPwb",
-	"enAiDpC8xD4SF5Gd2j3JeV01iqQd5iGqCMxJl9Gh5q/RoWQVVpa1scqvh+IQ4XfgGN0AhqrvjOkbFmID",
-	"WdzckO1oWdGeQEd8h76F7tiFAZyK74PzdG7+AvjhX0m9XXZqbKLD3p9yZkc2BwfipTgU++IQeljU6QkI",
-	"fd3FGKgS/rxI+BiXPohWThsQkzOcs4fDZDdLbf9Bm2EbY+Gy4KVB8XprYlJFM6hwiRGLNJhC3a/kDOJq",
-	"7ZOcYUNdOZ6Ojqu1T+RkZTAUl9OuKtTtCA1YWmLKgF3uLbuL0aBCdvyUr64lVs8QPRMZ1VPWcHlxG7n0",
-	"DEimoi+agbji0OsHwyKTIshNKbJyzRxRhTvlOY8cXSkWan9KlA0/qP4T8m2GZf4y4uf1h8U3xfvOaYL4",
-	"WebFnUAFwYDp13AKHXhHEpFyGFzb9fNG+CfooB09exGO9KtYh/VP8EAtTjxIdfESz3/PWcPboe2N9v8C",
-	"AAD//zRQOns0MQAA",
+	"H4sIAAAAAAAC/+y9a3PcRnYw/Ffw4n2rItYLihfJmzJV+UBLlMy1TDEk5U3Wq5oFZ1okVsPBGMBQ4rpU",
+	"JZKrlR0qZuRsPbu1T9aXbKry4fkyojTWkCKpqvwC4C/klzx1Tl/QDTQuMxxSlDJfXNYQaJw+ffrcL1+a",
+	"VXet6TZII/DNqS/Npu3ZayQgHv5rul537y+Sqtuo2d7GErHX/r5FvA34U434Vc9pBo7bMKfM8A/hcfg8",
+	"bId7YTfaip4a4UF4HB6G7fAofBltG+HL8Dh8Ex6Hr8MjeCLshK+jp+FReBzuwx9fR7tGtB09CdvRZrQF",
+	"z+ACe5YRbYc/hR0j2gyP8U/H0W70ddiNHhvhnhG+jB5F2+ELuoz0ybBjWqYDkH2BAFtmw14j5pRpw44q",
+	"Pt9SJSD2mmmZfnWVrNl0X3ftVj0wp+7adZ9YZrDRhPeWXbdO7Ib58KFFsQLI+NRdJ1kI+RGACF+HbS06",
+	"jqNN3PzeaaLAuBC+iR6FnfCn8CjajXbVz7ajXfX5toEndBB24R9hJ9qKNqPdkVxEAvoqa+466RWHMw+a",
+	"rhdcd701O8hC4X+Ex9EjAC/aAtC3wj0AKmxPGb/x3YZlVP11I+yGr8Ou0ajBT7Dh8NgAUoz+KeyEB9EW",
+	"IPsobBuAuOgRbC/aHrlohM/CTvgKNtyOHoXt8BC3+wge/J381b1oJ3wedvEZhhCDfuNV2Eakv0Z0HkTb",
+	"xnS1SpqBcSEgD4Kxqr9uGXazWXeqNuxn7MEohXHEApgB/U/CDm7kV40MFN9F7CiYJY3Wmjn1uQnvmZZZ",
+	"9dfhcVzYvCMQ7Qee01hBPC+Qpus7gettzNay8PwnJNWjaCvsRr9DsmsjlT0ykHqAKF7hpQXEdKNd4wLA",
+	"j7TVRcw9soxlu3qPNGpjdtPJohhPgFJxaqZleuSLluORmjkVeC0i7zK9jcWqs3bVbTViWtF9oQpP6Clx",
+	"YnzcMtfsB84aIHAS/+U06L/GBeKcRkBWiCc+ed2pB8TLwtu30Q4Sxk+APURMuEcvj/FrOwg8g3xhrNv1",
+	"Fvm1FdPkSzj96Fl4FB5FO3CrnwACkRp/bTdqv86iBYTELMbSbG3eDlYFhprwD7FKX3hfDGwvmG3UyINc",
+	"5PvisYwTkDA+ocU4sNQ5ey2Tpf6VMcW2kB0duEuHCUYW7WTgEJkV/n9vSLjtE6+f20MFXvQ0fIU8os3E",
+	"3m4GeC2feL3ejIf8j1RcN9zGxprzW7JAfMT5l2bTc5vECxyCD9j8gVrFxj8zFjNl1uyAjAYO4ibxEYDH",
+	"9n1npbFGGvjW/+eRu+aU+f+OxbrDGANjbIGsO+T+gvQGA+ahhTsseh+QjViPkfA5fdFKgJ+AK2Z/7vJv",
+	"SBU/ON10PiEbaTxUPWIHPSKBPGg6HvF7esepKc86jeBnl8007Vtm3faDSsvvESRKOF+m/9D0yF3ngYZY",
+	"/4Jypw1SC+7M6+gb+KdlRE+AZsPn0Q6VtK/DbvQk1l3oc13QN6LN8E3YjTbDA9SxNLSy7t7rcR9+1W3S",
+	"g3ECsuYXkQg91UV4yXwolrM9z95IUQ7eJ3bnGVbE9yyZDrLJ5yo+tEC+aBFfc6dUukjgW6gZx6jfcVQa",
+	"4fOwE21Gm6CSRE9QFOyb1kmP/WR4XHMas/S1iQKkMnyyz2Vjji6dwopH7Jrx34/+QKkOZWfYsYz7nhOQ",
+	"1O8GkF03fAWKRnjEJa1l2LU1p4FPh3vRZvTMAuErKBpw3Q5fhocobTdRqe0YaIOAtG1Hvw+7Yde0hEIF",
+	"MJmWiTCYlomrazQqy5wWHGcx8OyArOhkwp/DdnigaI94k1DO70VPo29QlQV1FC4U/vwS9nnAVHFUIvBG",
+	"7jGdNtoOD5GInuAj3egbw7MbNXdtRN4E/mJaZp0AP6m7do3U9Lto1Zxg3l4haXpukAdBpdryfNfT7Sza",
+	"jh6hJfIIWMFrgDzajr6Jvg474T5XsymIv492riA7iTajbfzvVrgXbYMiTZVy2BlfJDzSLJDFYqquV+uB",
+	"zmGzC/hSIb/ga2uJWlonLVqrgQ5hfgtfNxhHPWImXDcLMZYBjAGeBgLe40p3+IrSLDXitgShdygeqRGk",
+	"kjc82kW9pBO+4D+Gx6B4wv3QIRb3ULnnNGpFKJ33nEbVadr1T+Bh8SpXYXTMqVp3SCOoOE3tX0tLyjUS",
+	"rLr6L7jVasvzepQ9cIZooWXB3WTqtOYPG3DD8PBrNQfWsOvzElFQ5S3lF9gLj8OXcNWpEfkGZcAmmLVJ",
+	"cxSkcdegamN4bKGXAI1HZjoImxZ5pCyau8AwDsJjJl9AP92xYjkUbfJv4b+BTVrwOpjMj6iRHR6yRbfw",
+	"A0ho+BusD6BFW7AkAndA3RK4s46Al24vZtvHMfzH4T5avakr5gnFtcSF5mqlH9hBy0/fvY+XluZHETRg",
+	"v9vRJr07zItgam2QlO4gE1WCWgQxWtzMYpCIfchUHxNMDm/hu1c3UiMNh1CRyW5/G7SKNjCPlO2Bkk2w",
+	"BA0PCbvGhcvjE2OXxy+NWMZd26m3PCp3GS0+YcKS4yraMS4/eDD2wYMHkqzxW9Uq8WGvbAXTYnBmCJxg",
+	"1fVIbb5Vr2eqUs1WvV7x4r/mciBpIap2OuQ+c1umld7wFXe5xLcnIYzpZaNutTa4jsIudc/hNXtqWuWE",
+	"zQIDZDGwg2L1VNmxvAsdjXzU8q8jn/3YaeiUzX/hZ50wh1G9fxp2jfkFvJMGExMgDV7KeOiGr+l9B6P1",
+	"gHrS0F+BXgrm6uqEB6aVODgbTzeTg0qb9EuyeY6JrDVjW15rsss4ls3+GFD1E0kgdeif8TzXWyB+0234",
+	"hGr+9lqzTv8X/gb/U3Vr8NbcraXK9Vu3564hk/B91LSAK7gtr0qMhhsYd90WiM6HSVyKpdSf6cKxB3Bp",
+	"ZvrTysw/zC4uLZqWOb+g/P+nMws3ZuDbAMf04uLsjTn2z8rV6blrs9eml2ZMS4FyfqFy9eatRXzso+lr",
+	"lYWZv789s7hkWvRLs3OV24vwzu3FmQX4162lj2cWKvA30zI/m1lYnL01V7l6a+76zdmrS2zt+Zm5a7Nz",
+	"N+CtuenbSx/fWpj9JX7g+q2Fj2avXZuZMy3z5q0bs3OVpelPZua0nEOgr+icEUPx8+kjTDxPEa096QcB",
+	"8Rp2fbpKPYqpw6i7K05D6ws6ZA5ind8HLJRjA5lKJ/pKKLwgsvGRQ7262/TcdadGdNr4t3wpdO8rS7Ut",
+	"jA4chMeM/2N84CdgatGzaAtDDfA/PBKAqgi+/1Ti8itOULeXTQv+Z7W1rD2gbKVP48Vhl41vyWKo1J3C",
+	"DSe4aS9/SrwVbnzPrBMt4/se+dQhKhY/oWG9RVGNB8HRjO4NFJW7Bq5qsGWNj133niXhCuUDalLiJJnZ",
+	"AjpS9AijXJspLlhdtRsr9H/VP9Q8+66GilCxoBtKRkhgabLuuC1f99eHOtJO4Y/+X8UOAs9ZbgU6wOwq",
+	"RaGGwQqY06A5pZX1wAnqelfFfde7V3EalabnrnjEz9qmoo4h6dAl72Tvl5svunuEj6bVDjtYrdx3glWU",
+	"En7TrpbgNrqXdFBxn6f6Sfi1nPQSTxbyM3n/utOPMcCg0oE7uwZxuRm9DLrrkLoet3WnQbSa1zENECVs",
+	"lZc0EEeNA1DGjQugnbPoCHUdjGSYfyWlAUKUJw34XjN95RC8IzUtl094oyTuCToXaJJ7BobDX0XbpqW5",
+	"QjVvo+K1Gvr7hYKpvHtDPrKUvgkYWFsmnl9pNX3iBUQ+P/mmEnvNrzB/qO6RBHr5BiyBp+Qami+LrelO",
+	"41Pb968R4Ejree5WRfHTi9+E8qsRg0cYUmcmaUdS97vRIy6zwfOtFeQgT8xsOehrkwEocRyDV7CbFTCC",
+	"j+R8kycJJECOfZmCWlKg5dogsn4s9lDmhPT3piaeqFWE+pTyjx4zh1gsrMFAD98wzSj7YKj9Fj3OQZSW",
+	"cTTcoMLjRT1CNr9gGeELAEh2aXSTLl3u0AW+AO6XlzTOwjQqnYlzUmjUj2BQAbEBrp1NisU3LLp8JNnA",
+	"WZAXe0TSZ6vZRgautRSFHOK67XgNpgMkeHAKQSWUDvJFy65X/FXbIxmJSniLMH3BQHcbzcJBQUX/D38G",
+	"cmSueUpYR9R3MmGMGZS1UbBGlMCN21quS47GRmttmYLFfYUqPO468cZajRrx0AODziXh4gVvbbQbJ54c",
+	"R1vGBBwzEn70FUsfCA+pOz1w68SzG1UiKfC4tGmZy3Yd/oKawboi+yW/Onw8DSJi0hgzZLyW2nHhERwl",
+	"HDPdcD/NvV8ylzl4sr4CWwVxkcyUirbLwdSHsZIib44CFSEUe+yctcTurhNIcchinG6TNCrUKaGTId8B",
+	"TXDHq+K/yjE4MTpwSIkbRE7MEKgXul3WrSW52xZXXS/QaRrcZKnkOWfOTzaBBlxLPQTdKYrIR/oA+wqc",
+	"1IldQwhUha9AhFum59ZJ0ccW4JkBx9ctHtLSxrowVGv8/BdLFmWwHarhRJvG9PzsaByf5f5pjLOOBu49",
+	"0jDzXQsakz9N8U8VAz1sXzGo4Iyeqn7NaBtgNK0CHsBMKb5hhnQpf0A6vFxa+YRRBufKuO0K37bddCr3",
+	"yIZpmb+5H2g5c67XXPCnHB84Q2Sa5VJdShuYvjB+8eLkSA96pVXgB2aWwXR2dK7Rqtdt4NwsdqZxBnor",
+	"J1tB9vOWcVjn8TGez6ihzx/SiZPhPviYVFvkkCYDb9E4AzyL0m1+ocxW4rgXp6tb8+hSFe5f5tG9U0To",
+	"SaToUKA6z0WkS0N92quQlB9pMi4dQzjZsZ0DrOkQtKBQk+6Gg/Sr+FLSSS4PT6epKCSbhUMRFMkySX4M",
+	"D2RGqmMcCpOhlnRmEgsyGJGXO5FIy824Aro4EY2s6L01NLHmIJUiD46a5/nZORgWjYOobYyhsdjqHqT3",
+	"U+sgehr9PmzTJVL50pI6zoiBVR249xuE/UPH9fNcHX+WE/dHZVDCTvT7bEDyKTqZoq1mzKqY1hMxPIOJ",
+	"2mUCseA6i7F+hQYnL40bIhsIsX+EWLeoC/8V7FfEcHk0U84igti1tEY6VlmvV1BY9JCZeWm8UrM3ysUu",
+	"EygVn0sslY2+G57dXE0zgeWWX7krsoxKKXJqwFgjr0ltpQe9UAJvprZSHNymq1sy5AW7xmV7lAxFkeL7",
+	"xFlZ1XGy/0Qd8TW6VJQ7bjG7KREPUrha+DraVKiX8gzT6p1AMkPSDPJsnGnsohTyTuD3om4IbnIqaqIl",
+	"nJESkqLH1PeFOPoqdksJnxlm5g/MEZYPHrdzX8ouw7hWChSv5OFF2xrwUhyyf2+XnBoyIF9XaRGRlm5t",
+	"7gJb8dxWs7K88XdMKvUQ4AUaJfcqWPqRYaQdcQcalmx0QQEWP4Vdlal3UfVNwQXfSCZHF4qyTP9N0clo",
+	"GJDjV9DvSfTxmjz89BboowCLdyzpy1qwmQ9ARXsyM/qKgVRSF1nX2+Eb5vZ8LbKso030CHVp2p9SFgg/",
+	"XWEeT56DRh1yeKbAHGUNB77OVYc6zaqm72p1nMXqKqm16qQ2TR3LLBytDcX1lNdZddfW1OB6yhDtaT1y",
+	"9y7Bk+jpLZYbh/zBbeivCHXhgiGYSHOnrLRjxJV2+Cvnqxd40aiU4Ri2jevTszdnro2cqBxFIfgExH9U",
+	"HLYdQzxrqaZth7slt2nIIctL+VQbIE1baXEy0fT8/M1ZaqlNz12duXmT5hThvk+WoSKuH72IMRoS5y/Z",
+	"vwUlJFBAlxFNr5HAduoZhRyipqu888WvOmtL+Fuu1ZuPAf7lXFsVNnUDuLOGPP4VRewbiEEZi1dnP71i",
+	"gNupa9Qcv1m3N6C+jzL9PXgE6xy+wcQaQ5A4BjSSBX0J3MWr5aSTB2n3EUakS2u8sFEao1ogd/XB9cAu",
+	"t0hg93mwmQck4yD3lG46OrfhAktG7A0b9Ng1mMAV5ok3r2ZnSEylP6KOq0q1iwZuYNep7uuXSFuIsae8",
+	"aKnVq8peLAlTWWiOaSR9y+kpaTeLxcHZztpUN4C40iOj6jX/btPPZe8hsNPg192qnZkjxvNZOdfhrPo2",
+	"DbBQWrlT7HSQVsmCbt4Oqqu3ePa9LnimU4RqluERaE/AEeeRZt2uEuMCjZXHWZBMQX5BMyhBPdJK0Mxi",
+	"EH6Uyc25zfwtZXr1xV57u58JPGnFxOBYkARk1i5v+8TLMA108RshMlQZwZIvDXY1LIPKZarPbhqyoE5n",
+	"OGbp7YSl+c5qA0w0PUAxT/AH1HxpEw3urmdQXWEZGNTCeREeG+ImU3W7Ex7hT3O2XltEi+cE0gmKGG81",
+	"6hsJd2nMo7Ok4qkLMcsU++5BBRHv5BHXIKUbDRcPhZtAMuQrpJHbqxqFvXIIT8FIYqFpexDZyHNl/EAL",
+	"UTiXwBI3YWG8xNv1SknT0SfSu3WnulEG2Hn6ZP91JhxDWTi9RuokL4UusKurwmNRGITRdA2SjHQlOyxT",
+	"ZyiRKlfOc1fgpCqPwwQWTpZpBkjPzjNbcRqO3oMW/XP0O8hhwCRLlkLzByyjOIJcsHFWN8fTNyGz1AJU",
+	"Q50jNG0KD4wJyRdjYLwXYxPHICFK5o6t2Q9Kl8faDeXRnEWlHDb9wfV6zxPpfDp7yWmU3Igf1GpkXUf7",
+	"TACD5fiIRs7DjpIyF3tPsFPTC/w3ujylpmNMv6B56G/iwuxyB5Kf4YTstxL75vsKHKXZiaD69PoUs5RQ",
+	"GBEIFFqUvIuZ0k1iawrbVzy70auzLB89pd0xmsxk05IByttJuQzywQKXCY/jv7PdFtK5aUXSc7G1tmZ7",
+	"G6USz7OpkSkMvTrjHb/S9Bz8fmFIZBdc4GqCbbm2fG3jAg9k7Ev5O9EuX4iq//MLI2amVn4eAgiSrqPv",
+	"FcSrdvZp3c5RbgBfTgFRtIwrFFWvwnYcjov9wzRNVSFvKd85eqTV/faLPIQDzJmB2FDFr9uVVbelLTD/",
+	"DhUCNFPDw2iHXiwsdlKydPcMSkZhO3ps5ndDO2+JOBr57CzXncZK5a5dr0Pzv8yeoHH7Gxb4Y4opcjGs",
+	"ttd1vcE8Utrxkud1wKNdPT2wZQQ9ZG8TO1KOmFaJmkp6PRZJEDiNFV9XkZ0dYRLRgt6sDV/62GBsFMts",
+	"NWs9B7vWiec7biO3oo/FzDdpu4kjiVl0woMrxrhI3kkykUQCVif6OnrGimbgvB9TgRTtYsCfFs8UtGrM",
+	"Est8GxJi5aO5U3DkCy4l7UxNIpcCHjRJFfAu4TL/RuUfY/aRfMvPAdpiZSBcKn7jHixQEmiV1T7XFeiV",
+	"hHivWdissQjnRci9jWQ5MNQmcPLvQITRdvQ1LW7fS6JIQgpsm9X7RJs0VEkdfJp47BW5bCjajB5jwflL",
+	"URsEJH95/END0w+hgJsO/OLnHJH4WNEZfRbjt/zp9BNcPw2+p7t3EydnHwXxXln/1Zbbr5NKseHdDw6T",
+	"qyabpyZU2K6mTlbpnwcCNnqsJHqhUosWdju7o7fSW0zaE1bWNLMBTNY3YZ2jyMqLduQri9lecjvsLSOh",
+	"dIYdlvRrMF+YrNdr4dO5IQfVdkY6z5QxryOKJLayKG3JI2SONYZJ9qJw6jWPNHoy3MRyulRRkf/Qjxu1",
+	"FHLP0D/LPqXZlhWjLgvrBaLLrtUqg/WQa5vWa/qsD74nf/hSrIGGcnhI757W4wwqe2Y7+mhHo3RbqTby",
+	"xbvqq7H+qe+jH9qloWmZVkrmAeBUgFTmbdzTgPYAZE17KZq6Kdf/Fdbr7DinrvSIFTwkVHZdUXk7Ntvh",
+	"LaVotxs91hbtwkZo9/vSIbO+7rv2Ire8hu1BDy59Ou49slG6wwQ/KKVQhGUcaPJuaTiA5kXTQkXQuZn/",
+	"mXo9eO6rXtDbDcYM/NKu/prTx0tQa9dTOnLzw/GePpKst8QiSOW7KdjVjySwoTvo234fXsS8YOR3JYZo",
+	"6Lu+Z/tWU5MFNsOOsnC0k7mwcQHjGK/CPbRKvpZGVbAWBkBm2GaaXbrDnOYZvRV8nqLnUhbX+V5MOOHp",
+	"ddup28tO3Qk2ej5t6oTH7l3FWQLpfOXkdoqhvWb7q8uure0jzBplltKQM2nCYs5T6EfERB11uqGwKFv0",
+	"r2vaqVNPErgvKshXzuqh1WvjA7kdy5usMvAE1x0MPgrwwDzFVVH+VlxMxUrlBjEbQUZqAqdWTFVJKLMo",
+	"9F2OWAFGyqve+pwfDZL1yPoFWV513XtZqRxl6paziwOwbPMJnRdksXB20psX9zWEC/E82qG9l7GVb/gG",
+	"fWDYfdksVUHe9Nwq8X2kFGelgTRTmMGZmSoOnyDVlucEG8A411glI7E94gFzgX/hOSBDxp9jMFeDoElH",
+	"nDiNu9iXhrUTNOcXDF6xY8RBG2OReOsOJHcuET8wlmz/nmVct+t1Y3J88oMRyaEzZU5cHL84znmP3XTM",
+	"KfPSxfGLl1grZwRzDEtZYKLRJ4TWfq6QgDVqoVmPkDho3iDBNDw4zZ6zlBlqn+uH5TiNar1VIxU2LaO3",
+	"6Vl3APu0DS2CNTk+Tp1yjYA55eTBU79htBV/IKXo9toapPC64JoacnhopSOyYrbIMb32xzA2AeM5Eo8O",
+	"D68o/cmjHRoxSGo+3AgBCC+PT/SElrx9q71/dRv5C2VbqQ4n0bby4xE+hg1EaPMTCumls4VUisJtMc2Q",
+	"sYx2uEevLfdhqs3rlC4urNWaDX7bz81pOjIDbWFfH7AUx2dka6xK++k9I/pn/OVQbt3euRL3VwSD9bEQ",
+	"CEr0ffHj6dHJD3520bQSN3be9ZNXlnHoj9zaxsBOQjc95qF6UwKvRR6mbvPEyW5z+Tvsk6pH9IWbong2",
+	"esaxupcaehd2DKoiOr9F6KaMj5CHG79qjY9fqtLl8f+L6zWp7ccg6oV10DErQtDR+zR+hvfpeyi8Qyrl",
+	"48gsGuqG8NEeK1jGCT/s3nPOwHMEsOsj/D8tmg4Ph9xrcNzrW0Ycm9EW84XJPEzDvx5aCck/RoU03jXG",
+	"2fL5yQJ9vn+ukjBea/2kD2qz0cpwn/Gz4T6a69/brUctGG9YOzyiCR/C+0ZvGz7yKtqhmTDYPIBGsFBz",
+	"oB0JMPlr5OyZBr1wzO2SnAQzvP8nuf8A0eUzhEhQJO99Ee7TlIAkK/ouptgeGREMh5EMEM20sZ/Cl5Tm",
+	"JUKCD0CcgHYaCzvGjZkliz2BqTU74Z6kesXWdmLaLjbhPaZylt6315YhsUCLdw/QTTWyjNQYHsuYnUey",
+	"4in1SG8GaoMQmdm9aFCnDzbQ3skaYgM/0Rz7N7TnrSgmhgv/Ezgi8DReX8QYQ4bVhqhN2WwZQ7zopRDu",
+	"fkQIxSLzcLxAO1s7Lxn73+QOGtWbiomRQz2/L0YRldRa5dlKD60kKtQJkmy+GDZcbCMjuyBlESgxxayp",
+	"wHc9Vx3AXSbb4aGlTe09wt5XWqiYsdgTaIHbF2C6perOmpMxmfiD7MHE2nSV5M4lfxwL87GuxdEOut6+",
+	"5lJPutNXlP44QsWPNg02PaRr4M3C7p2QZLnFxpJ1M1BFv59Lnif1WhQSLbordQz6R8Vl2FZ4Axe2Zyz7",
+	"6Ww2PIADZh2o2I52OLcJD2KX6ltSDMbQnKHhS6paxVPhzr8F8L/i05ZS+qLdOAFZFlh05Ga+L2okV1o7",
+	"OI9BNhc0eRnS9AsD44mcq7a56xbRLRUngLc7fM0jeCITERgadgRHffersBs+R8Nco/FmCH5Is2JZUsLD",
+	"IvgD8spNCCnJafn8M2DEGlLzdD4hkM/6uGiEf04R+n5q/ofS4UbaMgLFiuWA5tgtfoVzAVAJmNImladj",
+	"p3EdN9+Glc5G6CbSROId00wyaVT7WEDstTG7VrtoXF38jEb0VecIGPYihGnxunUe3/zcEkHCOxeNf5z+",
+	"9CYrZZd8bfC2z0Y1ijGN8ZqgMrHUEZ2CI4xSOh6kSMUJ/wP1q0O4Ubr5LOEeFQ2vBSEJYyVDrlNpmTfM",
+	"nMccqv66aZkb9lpdG2rQJPdKLkLpsvBclwT0GQDGE0x6db1nGfUBeRCMNeu205Cmw9FsPB8rGVmTCDOb",
+	"LmKy+FWjaW9gcZ/VmrCm606VWIBA+fdJ6yN32UJYf4WmGOIw8SF/6lcNwxiNCWfK4CvAHwxORFP0X/Ao",
+	"g2rKaE3wHw2DgzhlIDDxHwTIUwYF0IzH0mfMrB+sD6J4OI7QZx9aRcw4IZk76FvoqhzqWXgkz+kF5sqo",
+	"6S14ElIbSI8fMViF/HHc7fbcaBKpMd3nXbmwzMuTk2dHn9+muXEn7movy2JLMydd00CO9uFM6Ej/Rssf",
+	"qMIDpwJPY1U7uxKisirspgWnyJoEWcjUVpBpWWpSK1gdu7/q2mtOtlPjR+aOOA6f5066tQw2xfipJdzr",
+	"6dR2uZUrqiiP6Hxk5rbIa08HkwIuav0HrWD1F3QXp8jA4nkTOupI1LkoQ8bf2iVXZkbnTSLWTjNPkuaf",
+	"Y+cTS1amGqKihmRQGprN9NT8MTrLcuw+TdjId+fPSi/ewPdYnkehHpWIcnbC59FjpLW2ceHG7NLN6Y8q",
+	"v5j56ONbtz6pLN36ZGZOOB9WiU1H9TD95B9G6YdHl9iYiGLPT+YSdHBmoXU++Bho1gzPgWkB0gzeVKoN",
+	"m146BTV8pFGDeM7/c3kybm04JWW7PCzrH1PzfbTuBjUjhwldsNHkyrLXYVeXpPNWo5fq5Pa3G45QlITE",
+	"XThzF/+fqNwNX0SPYs4lWl3Hda+CfbGkJ3Pq8zsKM8OMLnQ+bNFhNTKppIfSUqlKL5HE4mTuxDhdM05N",
+	"HKPVRvkMTkplpBkKPYcrpZsnNWg3WxOmZlqH2fRGJ8bHJ7QzMqbM6VrN8IntVVfVi/h2JoT0PtjFmF+4",
+	"wtNOmJTq4qFiPORYniPHhBf3qyAzMNjcZpGjrS3nNwc9j6S/+PBEj1zZy5pR9LnZAmbcumTekaE6OQlJ",
+	"DB5HuzzMoamm11PS78MSIer5BaVt4FsL5naFdZrhuhVdMGHW++zCTAVmtFM/1FbSxdKNwwr/RPum0PFR",
+	"dFqiWDLsjJw9d/4XrlmOJUsxkhHZaIdC92FvNJyc7i9P24+n+88vQN9Ku+4Ru7ZhkAeOH/gJ2jvRPoGu",
+	"tmlYZpNqDHLGdDprjzs8QdLg1Bqhg1M3Hx+nkG7tEvvRjMmMziLpejKl+kkSVtL90QkrrPYpLatQRJ5E",
+	"VGWzlTwmUShaCjjx6WXiDIDTxjPVTEiUHp0YH528vDQxOXXp8tQHP/vlwHgxm6919twYfNdSsTjr1cDB",
+	"GXLnU93v/EKaDSd51fcsgMQz9OYXeMiFHhJ4YPFN6pPiniBWfnfMYlVMMR8pz3t458jS7IcP3DkJB3Lr",
+	"tYoom6MXsy+mpKzTlz5cqD7Kn3j7LAyKM1ofnLqyaJmsSXgN6m+n4JOD41iJxXPGdFLX5Atda692sSXg",
+	"meqXSqVWfq8p6xatNCX/m+yq+B+ei/C9thIetadDmp0WbUkhjrgoPOl+Zr7mhEo1v2Bc0BWVlx49+Hb4",
+	"PfduZTjUNfKgR6WcdaEGvQVBj0XJX+g3wlcgGXiiB55HnN0oBn2K+QsaBV88FCv4VbvRcAODSw3DbRgU",
+	"BpjXiqhouFftRs2pMfeLChcLrKO7CFvOstSSdPe7PNDmblWuTs9dm702vTSjQNdweVN8dvGxJq7K4TGc",
+	"BoaAOaDBNOOyCUC/zz00LC9M9YHU2QiH+ZtYqkwvLs7emEugmHN8w/ENwDUXBUbgGsGq4zNMD86ownxG",
+	"6AH2VczqXvJ56fyIeA19F/b+JotLQtVZUq9JPyq1YjnigQLeDVo7pI02WxLdSF6wxmTC3ZTqTZKt+8SO",
+	"rTEWdMuqaIyn0d4gmtwRHcrjR8bit2drf49ZF6ea/Rd/TnvCWo/dUO8/7f3qHaWlbAExM4xX8mvW6er9",
+	"rdG2dAUEYThEcwVaTZ+oeXpp3T8mrdv06ZN4yXUdaWH+vx9U6q5dY9X5yoRmU4pdmemmsBOaAcjyVGGl",
+	"kQlfq3ysK3mxTrN0SHW6l4Yqa35xOY33R6nf6LPwiN/FR9kc4y3nCVOLNxlh0Ot+wxSfd6eCKHeYd4pn",
+	"hu18by8XMpDLGk8q6oOXajt+5zNYmEI4tj45dkPMMtLn9/xHnOtOP/sS9hY9wlbaLDvZwP0AkM+xW600",
+	"8U6bkQMjfD6bZF/uVWmBl6879YB4TGWxSr2yKGbr9PQaNn7pSzcCBP//vVGgOgmwVInECz7GsZjt9QkQ",
+	"Xose6iMStW3ckpZqJooZ3mmAquN4MMVsNMnjLp8lYD9gdAgLR9L8gyMPwNTnMeRnMcgdIWJKoctdkA0S",
+	"2RcLf81rD/FXfTb+a2hqCNElzJNg48QZrXZYz3Aeao12uN84o79ZV98MIsE3yml4J7mHJ4+0n/zr+aMx",
+	"JKyWKZEa8oD3kQcUeeUGDjEWcUa/o2VOdOJjMtYd96g3pGEiUJlYwLIUBUkwrWhbw7aibQ3jSis3Y186",
+	"tYeUk9VJoGsV+VeWha/zost1TpRp0aIiuhhsnfaU/TuP3G35GoWHzpCTeddsrS+tZ7Y2bwerOj3kcvEM",
+	"nW1pi+3h7RvePt3t4/egq799Oi0hyzN5qvQ+fpYSV5qUPrw3Z6S5Jr2LpUixCTOVteOlx2gL7zE+Vpp1",
+	"G2cVdYZUabwVp6C0U/OxIL8hbtktPFEdbYmqXLgP6+Y08LakGQhUuNBhQCOWkYBYns4vvsArhmhRkLDJ",
+	"FUGpLT0FfA36np6iSq4M4j6xo/M0+YTs0lFE7lA3H2oH76x28J+MxXW5IzYuYyzNpWXt/Dbv/6v3PH4b",
+	"d7SSex9RnncoAmjQTwH5KubcsnJK+Bqt27IS5K6Ut0Xb8crJnokQmvt1XtvE+Bv4b/Lri0Y/3tJoR8ya",
+	"Bw8vzQ3IaIVEmTXF29BvmhoJX95tmtlWf8imh8porhs1k3Ky1dLMqLV6nU9Vd2It6d+ONzP+eCotL2Ms",
+	"QKouZ3gjh4rT++LUzJoPU1JjKnZn5uYGck9g7Ne0jOgJ0spz1oFJ6dT9NK56fISjeecXpnC4IB1K2sb7",
+	"0cXY9aNoW21WJXW0yxpdlTujSqcGye5UxMeZeFMzUZrCAX2gTD3hkBkMRDwnnJa93a4iB+bpUNj425ex",
+	"Q/J8W67MXgk0w60Zfp82L+NOeG+wE09XY1oiZ0b35gHtyqvUZYFko2mtYgmQCEay0CQ8HEWB8U9MQToO",
+	"Dy8aIpEayyXY98Cpia3PMuVFNkpkP6oiIOhrheKlwOs5sMv9vjs9e1bgZf9n9Ixf6qESP1Ti32PvZ8+M",
+	"vZU30+W88FAmK7DRtKWmQKzZvn+N2HTCotZnOd8K3h1WW95HMmSxQxY7ZLEDZ7F/xMbUEkdNBkr6cZ0E",
+	"duCP3bUdr0H8nGiTJq1Vjf1j7+iY82YH/nFCxiZjlsfhIaTn76WnHXSMBDt+jX4WeSbqFe36TD1PV3/v",
+	"G8LM2FGsASStaBv5BKjpP9KnErPAk/UDfKy4pWaZ0o3rd/Q5TImwjMAdyQpgwWlc54dR1KNRSWFjI0if",
+	"wBSEuHV5VxqKYrDZo+IEYBzdHgb0fuJlouEhOxW+LCLkGbN4WMYy9riIR4C/CY+lBRNvZ82koGVdPU8B",
+	"6XvMxgAHY2gaF4vJXFgu3xHtQOkwD5Y1iUeNfzImLGVMjTwLFetnE2SNDyPalWAv1TXcdeKNtRq0Y6Z+",
+	"m3Xi2Y0q0fcIH7/4gaUZcS6md4ynx50PdnKnmB5eanTnErHXxA0pGuBJly5TQ3frk/MyQGM/xTm4mIsP",
+	"clj1e5albGUq137Ayvp4hIRoXoxNy9kA+w6vhmMSRHb0g8jcpvJcYaKmJKZpkewNz26uZkvqH6JntFWr",
+	"kALRLucYTAqkZFl4eDJpZoTfggYrRi9AJ3k3gP4gHBYpsUQ0Xltu+ZW7OFCKZpQL5GABDMMbCqU8cbkg",
+	"IaVIYsojF+YXtEAlu8F1UzISWingKL5O9Ex5/P2XdZmKgHz49DCfY7BHHcuWyiKKto3papU0A+MCTp1Y",
+	"b9QursBJrju/HcFG8IxMDbzgBSM60iM52Fs1N9CN5DjdZg4xVcINTm1PXUozXOLdk08yEQxF1LkTUf+K",
+	"HPV3xn/9n5jvGf/9+29T7Vr+6zWaRHvYVbMt7FJFCBEvnlifO1Re4tHqO0W8+q+KrYaSdEtl37QfPfKU",
+	"I7X3TLqJgJUxVFA0Wihg0L0Bp282qpEsYDWhPDl9EVO4gyzhf0T9nVCY+hT6C/Fh5oBttHfEeKVTno1Y",
+	"GuQTQRu4pwNrknrDvTQBHxrygNGwkwEia/Wnk3jY/M8yRduvqzdvLc5cKzWQCoiIWvRgjb4UgyXb6CLh",
+	"s9BEL6cDNq51O/pGIdFoW9f/6UK0qfg4cbPiiyrnTd4TqnLE0Vzg7O2RK8Z9Qu5RgNMAHYlG5d+oFAJ6",
+	"N/Sj4wPJ4gefMh9S2zJuL10d+VWWvrECJQ7QjVCHf3YTAbQMpBd49GceNF0vuI5UN6CmU6q5jVy8tLnN",
+	"OTew8UJzmy6tMbctBcIHo41aGsoUqqjOxGaOvWeqUlwKIHKiDt7iwOyh0pSlNKXSUw5Ya5kn3J2vNanD",
+	"fYkJMtsnwzkfHsraVdDyGrbnthq1fF982ovNZOEhS3jp9GK9q2iZX+BiYJNF0KgQSLallzp6xnM8dYRN",
+	"Z1unxEK0TbU4eTvxnNBtwdG59E5iObvdYJ6/YCnGcEoFLWD3xTMgGfunnX2lTmcZsuC8mv3vloyKT3Qo",
+	"pYZS6hSklCoSvuXz/WksJ6Vd4sAJwYfbtPsxWB3QHBcealtG88Nx4NYUANwR+w5mjuRlr4tuGqx7b3IC",
+	"en6uinbkIfX8auxTbaO1VOQ33DMC21shATZrvKL2+1BCYXxo0LbaT0QaQhptZ8AoTYrM3F3mRvjSyT90",
+	"Yhe9Zp8Xca1jhANEoYilHigLKfDDLsN2WnRG24q41DTARsTo4ICoKsxlUoIFcSvdbvRYBacdPc48547B",
+	"bK8XZYoIlqgw6y0jCV6CjNq4HFInlyiNlBKpnjysAEiq3HRlRaWx1Bjrfqr9AFU5YgxfwOzdXWzStRVb",
+	"wpx1JC7hSJYrIb4WxVMLB9iCFGf6lYij0mMWMwCTTUjx51INSIub6ZzLhqM8riod07DZ6LvYbFRvyZXt",
+	"xj8okP6awXczpE6eKLugCNGUCpKo6VG8b1Ke1xJNfxB6BUz6lxtFl02ljGdAyAOI6LeZm3ozPBAalk4k",
+	"XTTCP2Aqmh5FLGkrgakjBkjK9KR0KDWF3Yx2VaF7HO5fNJJZa3ID+v3U1ygMmLlHg5R7hlCqLo9/aNxe",
+	"nFmozM5Vbi19PLNQWZqZ/vSKYdfr7v2KT6puo2Z7G8hDDLRYn8O1EHMSEw5SfIKPIelKEuY43LfYorBU",
+	"BdryGCwwzjOSo2e6rf6JaqTdeI59BqqzFAd2h6ReP1naAdRmA2lN13pPWJ6GvcHLn7rrpHTLBHxrkWMZ",
+	"XpfNzj4bmbP2RtTw50jjeog02mjCpP/kQ3fqDmbg5L80qb70kbuMwMp9zJv2Bo2ElW5kviQGXwx4uiU3",
+	"Ot42SkRr95xRRRzWEojqQ3XpsWFoiSmLwCh0cxbFvk9x1mJaMcueuzh0PZ/2IAllRhJKTJ1X9SSaTA4Z",
+	"pqWXQo1wMY1lUncbKz5MqbEbbrBKPD5rZ3AUmRTJgia5YgEoUizcpKxGg7sdC+ouw5lk0gERWZy2qCpF",
+	"H1LL92EBzCmHGOMoGHsGHhLtFZfqo8R0XUwzv/DZzMLi7K25ytVbc9dvzl5dGinoZZ+IkG6mpTNmWkBg",
+	"Urz4DCwDuY5lN6eWCXK7R/KUv4JZOfB8P1NyEgb/nZMOzzs3Mqh3qZwg9e/iAuCEsjnktu9GoK/Ehc27",
+	"cXVi1/yiO3cTH3orty5LxRJwl87Ih00UBl7osqUcSj/oBguG3eG9OX+J7/qTyh7opr8lBa6JHzIGTUbb",
+	"Qipz6Rht4lOdpNaAIpyekV91m8Swa2tOY0opWhNJ+2hl6+3nrkFNZyziRBXkSO5rw4Pucfkzy7G3wLaG",
+	"1TZjr4Zarcwm/1pqFeO+KJt7kzPYVJ9xyAMiiTx35WjyLHzkTdTM783MLi854RMD699QhrGVZ2ca9nUC",
+	"7kUbTCknZ1xAVY6fzRGtHdMdr+KbQTJf8exGQGoVOxgZjrB9FzzUvU6TjXaSrPYvqfmbGQN4d9ONudVw",
+	"QludL57BkmlX8PzJgoJLLNCHh4xiEIwC6552o63hzT6XNzu/gWDqTqb0kLSrgI93PrUL7fiFxj+2By6q",
+	"yviLqMbHFi+sHUFa29MG/T1y13nQTylc3VlzAn358gfjlrlmP6C1ypPj41Ll8oS4gk4jICvE0yUKNMiD",
+	"oFJteb7r8VRzppvvYC7513ILGLgS2ZUDdJVTiPRL3hEJXHPKJBs/H5/9jev849r139iTn7V+efXnH7Jp",
+	"qfT0qCOkQn0qfNzqZcusesSm2oM5ZU6OT34wOjE+Onl5aWJyanx8anz8l+gclF/6AHXERqUpfrmU4Te5",
+	"05PnBKhu3l7RX7RUY2qJzCxU/jHMv4v84Yky61qhz/OSatdJ1U7G+UnhAW1zAi/9T0+xU1pKyzFK+A3i",
+	"F9jmAtQg4TfNNNaUKHv0GEav5zBKtWdTse7zqfr8CcKRurskfJcILPVeXjLLXzAVuoFrTxL0cdJRTXyv",
+	"xjnFJE7qr/B0KVJTBj8nf/wgL/pXLrkpuW+R4FRCJQPqxmYwT6m+oZrt6EXQmO1FJvq7MsfYuBDnMkLy",
+	"29e0E44R7olxE7i7kaEe2Iez7N9kSspsjpzTLi/l1mKFpMiTMaekTTND9KTY1Sce5bNEj1CuVMQKF+hz",
+	"J2CBDcLSXrhC5XpkNOaFhVEalVUkVkspY5aZ91dNJ5kK25+6sN7QO03jtKcMiOQmyhmm36dnZ7Ei1XTI",
+	"+uyVq2TOhiGmex3GSh/kTZ+/hIdhpudZhPI0xKsL6l1AyxntZxr1NzTRdtDA/Itxtg4kDMM/WJw61+z2",
+	"STBvewxHGaEN2lOliY9VpM+UyXg8YH5j2g51/2KmI39RAHIC9pyEUc5h06e2jfpftOxcJp1ec1B8+r3k",
+	"y9+pTU3PCT/+Ie2+BBDf4LVqs6icJhonFcGw5hdx24shf35v+fO37Ly1iRbQFuORJlXumFURQ1oYarRS",
+	"K7WwU8CEA6exUpiIscifO3nJU2pUXwc9O1gdiD5GaWRM2L0iNS3F7O49zACDDDQwwKSa6C30JYEu087s",
+	"abNOPN/BtlfxAee6RE+zDZaCVz05pyLdw0ypcxCm3JNJtjg0+SPWy21SF7mUBEFt3P1E4+OONsOhKGGk",
+	"qCO83AGI33kj+j3NR6Gr06yN5DhjHeRF8F2hpPGKibij2L17TOM1uACeM3akiWtUtrL4236ZxIxWklX1",
+	"rc1V3bU1fNJ0G8TgjQqMWgv0KiNYJcZdj5DfEtMyyYMmqYI/j/MW8P3LbNUWfb0qfuDZAVkBqqgT2w8q",
+	"ddeukZrUC0E4/x4OIPGSY+J2s3YaHs4Bcbkf46xjaSx2msjOUZwiA7ahQjYsjewLSUl2ylwzap4cRLaT",
+	"zMZgvbXbSKGvdXn2yYF81Bu0HX0jy7FvksMBDnrIUeTMbmzV8bFdYEld8mP2+LlK72WI7S3Dl+/oM/py",
+	"YbKv+Egpu1ZoyGE3dUxDFfDcWXB/kiZe7kqXLNxPnV46c4X7akXJzh4dfjFS6gJ6br0OmkKOR+1HuYAm",
+	"kxvgXA5UxPiEuEPqQMbuxYyhwLv7V9QRn7uJeiMMVavdq3IdcbiPBb6NgUeMhY422ZcixQEbqlID664k",
+	"jfsZKlHvdvZuoVn8HilW3zJy2eZKVb5FjH2RePXkvpRLdxx9Fb5mPY1jQZHH7AOPkCINawmeKTEhh8bA",
+	"o6eaFrKK340m+SU8b7Hdjp2alYBLtJPjkcz0zslx5LNqCNT7gBVA7pxbIwMcsBL+QSD+ODHgYqjenTP1",
+	"TjmqDAoP94UGlXSfHbJkKJ3r7zDspllJYSFlC707NF6on/b7p2iTJyhJaoFuPhgXMuJq63rPWKzIq+/R",
+	"7KwntTIQLXosvh7uRV/BF1ir03TqojQtOPYv7vNa9hyvpmjT049XM3MgMJwIdbGdRGG1a7VK2fLuv1Ur",
+	"tW94dpUwQxgKTaR1IC9yEDXcp+5CTGYyxl7Tcl24F6Q3eE6jdcKos6XC8Q4HoYfq93uvfqfn4p0HFfwP",
+	"arcvdCPsp2F9/zqcxBtnflcsjRZymP1aoieeJUkwvhYGwtNWvV5JwBytMbvhNjbWnN+SHN8Qa5OWOQ06",
+	"IUmjTf7jC+wO8RWN1FkGk1QKuuVXXtLllDi/Mb+AYj2jeCxjdDVdL4Faaa5WcmiZVWZodV/Ki/4k2cdU",
+	"CFAlDvdoB1WpEz1mOHzFi5pfYydaYII7ommo1HqO4kpUR1PGCXbXK17djm34eXP4RIF02E2Zc+z3aDN6",
+	"TG8B9QrSHj3bWYXwOJt7WtDWCfQfELXwo3lz5sb0zdGJyUtmojtNbuWDzVZMimPRC7DNEHsBj5WeAfah",
+	"tox4NCHN69+lt3wk3f9dgqgo2Y4/eHqpdrLyyE8gv2otqVbpC0/GdYUnE2zv5pSimuJkeVW/NPOaCtHO",
+	"3bXRS3cnqh/a48t/S3pooCXoTBSt9DjyHHIS2HyKMkPPh1rYUAvrs9BZ1ruSqsF3CiV2cypaol1JpiOr",
+	"VWR6zfZXl13byxmH8l2OmOvqil0yIbEMBHaTVjfrhrpQtwYeEVafio4xU1LfVVqQA+b5T4AhmEIWdtgN",
+	"0DWMCY9T/loqH1OdacJuxmQTRNw1gatew83w+mxtQMHmPJKDD8Vg6uM7tPQd+3NnUczQZXheL758fJox",
+	"cMz46HKnFZMIsOVcNlDQuxBf6Kd54UAJX9XXuCZRdB20WlXZKi3tKQ2vx7m9Hqkug5mG6B5q68DzO7zq",
+	"nRXFM59v2u4qukHUi1jmHrEnT3SbznCMlFyj1KrXK8xAozDTWVxS01D5Efpz0xudGB9P/Y13Fq3VDJ/Y",
+	"XnXVtPjozSk6aBPgLWu/JSArGY2bb9XrzCW9uOp6mulWfdhrVgKYtz0LS+n3ML/wN9S8zxX+Z23TgHa2",
+	"xy4k42r/o6dd57O1+YW/Qa/eC+CCuT3P1H54mi6G+XpB3Wncm3kQgO1dn64yOz6vUB2XuKl56wRunbq7",
+	"gqF+G1oQX/TXnAA4RdNz150a8cwpc8UJ6vaymWhfXL6pdgLUUw9N2TEme4NLZTd8mXK6jOQxPAjbimNV",
+	"zloeug3eK7fBWQdr/jd3OYs8qYSr2lDHxBxG21n86xvjws1bN2bnKkvTn8zMpXmivK6IhEBKKvc7U4dA",
+	"F+MzN5zgpr08dsMJPm4tIwxZH4022aBGEJjtAu6odH1L5lwrIgznxlX9dfBeUGFPfe10BOQrPvibDVd/",
+	"FT6nvW/CjhwyYMEa1rAr2pkysHMbe1E76NUy4veOeYbGljKXLXzJFd0j2gUMM3QRdAxU8eYm0pgvWnpl",
+	"0EZpifl8iTjaSJ5LpVRPvH+XJ/9rMk6SCdCJ4T1CiucMBRo5UTpZRle92MeteXnZdevEbmhn632PKUHK",
+	"SHipFWCWScM1EXENmIfgRdjldJO1zS/62Z4P6rK2Z6CkCPMpg/EvwpNffmSv64Gc13/K9qvSZ+i/AJ09",
+	"LH/Ouh9eSc0cpVlMm7Q+GTO8sOuKPO6/zbPCaIM7cGWKibLHmA3aZ0fFt2NmptowetCGsbY049y8+vPm",
+	"L6/O/my2cfvBbGOcEVRGvpM+g5+3b5R+atbtAIY3m3dKzMAo3x8OeJzUgPGMjb5kc8fMjlfD9o3vXPtG",
+	"lkhCddFueJBzusg5tLyCSnKJTJ5wiQkawEtmNu7n6kCQLLjEUuQyckK+1WUJ5CdPZDjteL99iref8CD2",
+	"0woA5raInvz82LTTf/dHxGh9HrCu0EJq/++Ah6BGk9V5sL+EjjzgLxrhDzEeisYQJ3NtczMsPuUHdcIE",
+	"CxlFOj4rWGlvqRfqspII5ukBCbUp0bWoYDrxnjSgmBoHb+IcbNorgJ5jhitlIGkc1lvtnlSysyejkgEM",
+	"Lc60UmPUA+97NkyjGPpDTskf0k2Nygnb+qRWfYc77pHu5gWSBJOmjCVrWHBadMIGa606maZ9dB23kSNE",
+	"/5ROXxAJaEpqJDgd5H6NiZQIrBJhIS7hbjiKnkIWhUHu3iW0o7kdQHog1VIy2vH2JcFPMy8yV/wtppE9",
+	"kOYu63aVryZjTyTQTYyOTyyNfxgn0KUz38qKSfFRTQs/9dsp6vk3mp0YHuF4w5hueDXdFeodec27xD2P",
+	"dsT46efRtrBVIVpwFy09c8qEco7RwEHnSAogaZtfaoR3H8JTdqco2+1Plk6cQJbym1vEujjR1SSqS25Q",
+	"rFVKqGrYQFbe0/H5FKucK8tHaIR7Ui0r0OpQ+L73OYx/1NEt5TrJmW3fSD7cju6v/aQ++unL6Rcmbyzq",
+	"Xko5sHW+tpiR5TrbtN1rk24R2jBeX0qtOhqwDyTz9rPWcKnJeRnOQZaKIcPL3azzM3PXZudumJY5PT9/",
+	"c3bmmmmZV6fnrs7cvIn/f316Fv5H44IdbA4YP8Ly+R5ajlxQhB1/pRSLVoZrJJAd7r8lxnZufFd/7HGs",
+	"ozZLlw72U0TISM93faxqN6qkXiKfQXfpr9KXT6BDgvZzeTJH36PqkVC3nEbws8umLuqgUOup1ou8EwoR",
+	"GCbxT8dD78JQwTkJRBoKS6o24fHZZ1qkwVIzLqQbIJh/6mYkqkrEn1n2bK8lFfk8mASz/rSwCYt4rvT0",
+	"SZhs39b2aRqwZ1LQV67ULjUKK2uMfw6qekrF76P/AeVTb85VNv6QkZ6dpfjX1HSOp6J4X68f9mIQwrdI",
+	"teU5wQYab8vE9og33QpWzanP7zy0vnx4R7z1JTeOaHX8Q0v8QJeTfpAyy5XfF0jT9Z3A9Ryi/D4L+pzH",
+	"LErp92kYoy7/sHh19lP53x8Tux6sQlrC/x0A78ETN6aRAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
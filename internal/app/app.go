@@ -1,23 +1,34 @@
 package app
 
 import (
+	"avito-autumn2025-internship/internal/auth"
 	"avito-autumn2025-internship/internal/config"
+	"avito-autumn2025-internship/internal/connector"
 	httptransport "avito-autumn2025-internship/internal/http"
+	"avito-autumn2025-internship/internal/http/handlers"
+	"avito-autumn2025-internship/internal/metrics"
 	"avito-autumn2025-internship/internal/repository/postgres"
 	"avito-autumn2025-internship/internal/service"
 	"context"
 	"errors"
 	"fmt"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"log"
 	"net/http"
+	"sync"
 	"time"
 )
 
 type App struct {
-	cfg    config.Config
-	server *http.Server
-	db     *pgxpool.Pool
+	cfg     config.Config
+	server  *http.Server
+	db      *pgxpool.Pool
+	userSvc service.UserService
+	// background учитывает фоновые задачи, которые нужно дождаться до закрытия пула.
+	background *sync.WaitGroup
 }
 
 func New(ctx context.Context, cfg config.Config) (*App, error) {
@@ -32,31 +43,101 @@ func New(ctx context.Context, cfg config.Config) (*App, error) {
 	teamRepo := postgres.NewTeamRepository(db)
 	userRepo := postgres.NewUserRepository(db)
 	prRepo := postgres.NewPRRepository(db)
+	repoRepo := postgres.NewRepoRepository(db)
+	settingsRepo := postgres.NewTeamSettingsRepository(db)
+	scheduleRepo := postgres.NewActivationScheduleRepository(db)
+	apiKeyRepo := postgres.NewAPIKeyRepository(db)
+	teamLeadRepo := postgres.NewTeamLeadRepository(db)
+	auditRepo := postgres.NewAuditRepository(db)
+	txManager := postgres.NewTxManager(db)
 
-	teamSvc := service.NewTeamService(teamRepo, userRepo)
-	userSvc := service.NewUserService(userRepo, prRepo)
-	prSvc := service.NewPRService(prRepo, userRepo)
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		metrics.NewPoolCollector(db),
+	)
+	m := metrics.New(reg)
 
-	router := httptransport.NewRouter(prSvc, teamSvc, userSvc, cfg.AdminToken)
+	teamSvc := service.NewTeamService(teamRepo, userRepo, prRepo, repoRepo, settingsRepo, txManager)
+	userSvc := service.NewUserService(userRepo, prRepo, scheduleRepo, txManager)
+	repoSvc := service.NewRepositoryService(repoRepo, teamRepo)
+	background := &sync.WaitGroup{}
+	prSvc := service.NewPRService(prRepo, userRepo, repoRepo, teamRepo)
+	if cfg.GitHub.Token != "" {
+		gh := connector.NewGitHub(connector.GitHubConfig{
+			BaseURL:    cfg.GitHub.APIURL,
+			Token:      cfg.GitHub.Token,
+			MaxRetries: int(cfg.GitHub.MaxRetries),
+		}, userRepo)
+		prSvc = service.NewReviewerSyncPRService(prSvc, gh, background)
+	}
+	prSvc = service.NewInstrumentedPRService(prSvc, m)
+	userSvc = service.NewInstrumentedUserService(userSvc, m)
+
+	accessSvc := service.NewAccessService(teamLeadRepo, teamRepo, userRepo, prRepo, repoRepo, scheduleRepo)
+	opts := []handlers.Option{
+		handlers.WithAPIKeys(service.NewAPIKeyService(apiKeyRepo)),
+		handlers.WithTeamLeads(accessSvc),
+		handlers.WithAudit(service.NewAuditService(auditRepo)),
+	}
+	if cfg.RequireAuth {
+		opts = append(opts, handlers.WithRequireAuth())
+	}
+	if cfg.GitLabWebhookToken != "" {
+		gitLabSvc := service.NewGitLabService(prSvc, userRepo)
+		opts = append(opts, handlers.WithGitLabWebhook(gitLabSvc, cfg.GitLabWebhookToken))
+	}
+	if cfg.SCIMToken != "" {
+		scimSvc := service.NewSCIMService(teamSvc, userRepo, teamRepo, prRepo, txManager)
+		opts = append(opts, handlers.WithSCIM(scimSvc, cfg.SCIMToken))
+	}
+
+	if cfg.JWT.JWKSFile != "" || cfg.JWT.JWKSURL != "" {
+		verifier, err := newJWTVerifier(cfg.JWT)
+		if err != nil {
+			db.Close()
+			return nil, err
+		}
+		opts = append(opts, handlers.WithJWT(verifier))
+	}
+
+	router := httptransport.NewRouter(prSvc, teamSvc, userSvc, repoSvc, cfg.AdminToken, opts...)
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(reg, promhttp.HandlerOpts{Registry: reg}))
+	mux.Handle("/", httptransport.MetricsMiddleware(m)(router))
 
 	srv := &http.Server{
 		Addr:         cfg.HTTPAddr,
-		Handler:      router,
+		Handler:      mux,
 		ReadTimeout:  5 * time.Second,
 		WriteTimeout: 10 * time.Second,
 		IdleTimeout:  60 * time.Second,
 	}
 
 	return &App{
-		cfg:    cfg,
-		server: srv,
-		db:     db,
+		cfg:        cfg,
+		server:     srv,
+		db:         db,
+		userSvc:    userSvc,
+		background: background,
 	}, nil
 }
 
 func (a *App) Run(ctx context.Context) error {
 	errCh := make(chan error, 1)
 
+	// Планировщик учитывается в background, чтобы пул закрывался только после
+	// того, как он остановится.
+	schedulerCtx, stopScheduler := context.WithCancel(ctx)
+	defer stopScheduler()
+	a.background.Add(1)
+	go func() {
+		defer a.background.Done()
+		a.runScheduler(schedulerCtx)
+	}()
+
 	go func() {
 		log.Printf("HTTP server listening on %s", a.cfg.HTTPAddr)
 		if err := a.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
@@ -72,10 +153,39 @@ func (a *App) Run(ctx context.Context) error {
 		if err := a.server.Shutdown(shutdownCtx); err != nil {
 			return fmt.Errorf("server.Shutdown: %w", err)
 		}
+		stopScheduler()
+		a.background.Wait()
 		a.db.Close()
 		return nil
 	case err := <-errCh:
+		stopScheduler()
+		a.background.Wait()
 		a.db.Close()
 		return err
 	}
 }
+
+func newJWTVerifier(cfg config.JWTConfig) (*auth.Verifier, error) {
+	if cfg.Issuer == "" || cfg.Audience == "" {
+		return nil, errors.New("JWT_ISSUER and JWT_AUDIENCE are required for jwt auth")
+	}
+
+	var keys auth.KeySet
+	if cfg.JWKSFile != "" {
+		var err error
+		keys, err = auth.LoadJWKSFile(cfg.JWKSFile)
+		if err != nil {
+			return nil, fmt.Errorf("load jwks: %w", err)
+		}
+	} else {
+		keys = auth.NewRemoteKeySet(cfg.JWKSURL, cfg.JWKSCacheTTL, nil)
+	}
+
+	return auth.NewVerifier(keys, auth.VerifierConfig{
+		Issuer:      cfg.Issuer,
+		Audience:    cfg.Audience,
+		UserIDClaim: cfg.UserIDClaim,
+		RolesClaim:  cfg.RolesClaim,
+		AdminRole:   cfg.AdminRole,
+	}), nil
+}
@@ -0,0 +1,41 @@
+package app
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// runScheduler периодически применяет наступившие запланированные изменения
+// активности пользователей, пока не отменён ctx.
+func (a *App) runScheduler(ctx context.Context) {
+	if a.cfg.SchedulerInterval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(a.cfg.SchedulerInterval)
+	defer ticker.Stop()
+
+	for {
+		a.applyDueActivations(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (a *App) applyDueActivations(ctx context.Context) {
+	applied, failed, err := a.userSvc.ApplyDueActivations(ctx, time.Now())
+	if applied > 0 {
+		log.Printf("scheduler: applied %d activation changes", applied)
+	}
+	if failed > 0 {
+		log.Printf("scheduler: %d activation changes failed and were marked FAILED", failed)
+	}
+	if err != nil && ctx.Err() == nil {
+		log.Printf("scheduler: apply activation changes: %v", err)
+	}
+}
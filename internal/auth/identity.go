@@ -0,0 +1,43 @@
+package auth
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"context"
+)
+
+// Identity — результат аутентификации запроса.
+type Identity struct {
+	// Kind — чем подтверждена личность: ADMIN_TOKEN, API-ключ или JWT.
+	Kind api.PrincipalKind
+	// Subject — sub из JWT, префикс API-ключа или admin-token.
+	Subject string
+	// UserID — пользователь сервиса; задан только для JWT.
+	UserID string
+	Roles  []string
+	Scopes []api.ApiKeyScope
+}
+
+type identityKey struct{}
+
+type identityHolderKey struct{}
+
+// WithIdentity также сообщает личность внешним обработчикам, подготовившим
+// слот через WithIdentityHolder.
+func WithIdentity(ctx context.Context, id *Identity) context.Context {
+	if holder, ok := ctx.Value(identityHolderKey{}).(**Identity); ok {
+		*holder = id
+	}
+	return context.WithValue(ctx, identityKey{}, id)
+}
+
+// WithIdentityHolder готовит слот, в который WithIdentity запишет личность
+// запроса; так её видит код, работающий до аутентификации, например аудит.
+func WithIdentityHolder(ctx context.Context, holder **Identity) context.Context {
+	return context.WithValue(ctx, identityHolderKey{}, holder)
+}
+
+// FromContext возвращает личность запроса; nil, если авторизация отключена.
+func FromContext(ctx context.Context) *Identity {
+	id, _ := ctx.Value(identityKey{}).(*Identity)
+	return id
+}
@@ -0,0 +1,263 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"math/big"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	// minJWKSRefresh — минимальный интервал между загрузками JWKS.
+	minJWKSRefresh   = time.Minute
+	jwksFetchTimeout = 10 * time.Second
+)
+
+var ErrUnknownKey = errors.New("unknown signing key")
+
+// KeySet отдаёт открытый ключ подписи по kid.
+type KeySet interface {
+	Key(ctx context.Context, kid string) (crypto.PublicKey, error)
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// jwks — ключи по kid. Ключи с use, отличным от sig, и неизвестных типов
+// пропускаются.
+type jwks map[string]crypto.PublicKey
+
+func (s jwks) key(kid string) (crypto.PublicKey, bool) {
+	if key, ok := s[kid]; ok {
+		return key, true
+	}
+	// Токен без kid принимается, только если ключ в наборе один.
+	if kid == "" && len(s) == 1 {
+		for _, key := range s {
+			return key, true
+		}
+	}
+	return nil, false
+}
+
+func parseJWKS(data []byte) (jwks, error) {
+	var doc struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("parse jwks: %w", err)
+	}
+
+	set := make(jwks, len(doc.Keys))
+	for _, k := range doc.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		var (
+			key crypto.PublicKey
+			err error
+		)
+		switch k.Kty {
+		case "RSA":
+			key, err = rsaKey(k)
+		case "EC":
+			key, err = ecKey(k)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parse jwks key %q: %w", k.Kid, err)
+		}
+		set[k.Kid] = key
+	}
+	if len(set) == 0 {
+		return nil, errors.New("parse jwks: no signing keys")
+	}
+	return set, nil
+}
+
+func rsaKey(k jsonWebKey) (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		return nil, err
+	}
+	e, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil {
+		return nil, err
+	}
+	exp := new(big.Int).SetBytes(e)
+	if len(n) == 0 || !exp.IsInt64() || exp.Int64() < 3 || exp.Int64() > 1<<31-1 {
+		return nil, errors.New("invalid rsa key")
+	}
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exp.Int64())}, nil
+}
+
+func ecKey(k jsonWebKey) (*ecdsa.PublicKey, error) {
+	var curve elliptic.Curve
+	switch k.Crv {
+	case "P-256":
+		curve = elliptic.P256()
+	case "P-384":
+		curve = elliptic.P384()
+	case "P-521":
+		curve = elliptic.P521()
+	default:
+		return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+	}
+	x, err := base64.RawURLEncoding.DecodeString(k.X)
+	if err != nil {
+		return nil, err
+	}
+	y, err := base64.RawURLEncoding.DecodeString(k.Y)
+	if err != nil {
+		return nil, err
+	}
+	key := &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+	if !curve.IsOnCurve(key.X, key.Y) {
+		return nil, errors.New("point is not on curve")
+	}
+	return key, nil
+}
+
+type staticKeySet struct {
+	keys jwks
+}
+
+func (s *staticKeySet) Key(_ context.Context, kid string) (crypto.PublicKey, error) {
+	if key, ok := s.keys.key(kid); ok {
+		return key, nil
+	}
+	return nil, ErrUnknownKey
+}
+
+// LoadJWKSFile читает JWKS из файла один раз при старте.
+func LoadJWKSFile(path string) (KeySet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := parseJWKS(data)
+	if err != nil {
+		return nil, err
+	}
+	return &staticKeySet{keys: keys}, nil
+}
+
+// RemoteKeySet загружает JWKS по URL и держит его в кэше ttl. Неизвестный kid
+// перечитывает набор досрочно, чтобы подхватить ротацию ключей; при ошибке
+// загрузки используется прежний набор. Загрузки — не чаще раза в минуту, в том
+// числе пока набор ещё пуст, и идут без блокировки: запросы с известным kid
+// не ждут их, а с неизвестным ждут только уже начатую загрузку.
+type RemoteKeySet struct {
+	url    string
+	ttl    time.Duration
+	client *http.Client
+
+	mu          sync.Mutex
+	keys        jwks
+	fetchedAt   time.Time
+	attemptedAt time.Time
+	// loading закрывается по окончании текущей загрузки; nil — загрузки нет.
+	loading chan struct{}
+}
+
+func NewRemoteKeySet(url string, ttl time.Duration, client *http.Client) *RemoteKeySet {
+	if client == nil {
+		client = &http.Client{Timeout: jwksFetchTimeout}
+	}
+	return &RemoteKeySet{url: url, ttl: ttl, client: client}
+}
+
+func (s *RemoteKeySet) Key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	s.mu.Lock()
+	now := time.Now()
+	key, ok := s.keys.key(kid)
+	expired := s.keys == nil || now.Sub(s.fetchedAt) >= s.ttl
+	if (expired || !ok) && s.loading == nil && now.Sub(s.attemptedAt) >= minJWKSRefresh {
+		s.attemptedAt = now
+		s.loading = make(chan struct{})
+		go s.refresh(s.loading)
+	}
+	loading := s.loading
+	s.mu.Unlock()
+
+	if ok {
+		return key, nil
+	}
+	if loading == nil {
+		return nil, ErrUnknownKey
+	}
+	select {
+	case <-loading:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	s.mu.Lock()
+	key, ok = s.keys.key(kid)
+	s.mu.Unlock()
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+	return key, nil
+}
+
+func (s *RemoteKeySet) refresh(done chan struct{}) {
+	defer close(done)
+
+	keys, err := s.fetch()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.loading = nil
+	if err != nil {
+		log.Printf("jwks refresh from %s failed: %v", s.url, err)
+		return
+	}
+	s.keys = keys
+	s.fetchedAt = time.Now()
+}
+
+func (s *RemoteKeySet) fetch() (jwks, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), jwksFetchTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch jwks: unexpected status %d", resp.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, err
+	}
+	return parseJWKS(data)
+}
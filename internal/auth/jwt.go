@@ -0,0 +1,256 @@
+package auth
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+)
+
+const defaultLeeway = time.Minute
+
+var ErrInvalidToken = errors.New("invalid token")
+
+type VerifierConfig struct {
+	Issuer   string
+	Audience string
+	// UserIDClaim и RolesClaim — имена claim'ов, вложенные через точку
+	// (например, realm_access.roles).
+	UserIDClaim string
+	RolesClaim  string
+	// AdminRole даёт scope admin, остальным пользователям выдаётся write.
+	AdminRole string
+	Leeway    time.Duration
+}
+
+// Verifier проверяет JWT, выпущенные SSO, и превращает их в Identity.
+type Verifier struct {
+	keys KeySet
+	cfg  VerifierConfig
+}
+
+func NewVerifier(keys KeySet, cfg VerifierConfig) *Verifier {
+	if cfg.UserIDClaim == "" {
+		cfg.UserIDClaim = "sub"
+	}
+	if cfg.RolesClaim == "" {
+		cfg.RolesClaim = "roles"
+	}
+	if cfg.AdminRole == "" {
+		cfg.AdminRole = "admin"
+	}
+	if cfg.Leeway == 0 {
+		cfg.Leeway = defaultLeeway
+	}
+	return &Verifier{keys: keys, cfg: cfg}
+}
+
+// LooksLikeJWT отличает JWT (три части через точку) от API-ключей.
+func LooksLikeJWT(token string) bool {
+	return strings.Count(token, ".") == 2
+}
+
+func (v *Verifier) Verify(ctx context.Context, token string) (*Identity, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: malformed", ErrInvalidToken)
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("%w: header: %v", ErrInvalidToken, err)
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: signature: %v", ErrInvalidToken, err)
+	}
+	key, err := v.keys.Key(ctx, header.Kid)
+	if err != nil {
+		if errors.Is(err, ErrUnknownKey) {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+		}
+		return nil, err
+	}
+	if err := verifySignature(header.Alg, key, []byte(parts[0]+"."+parts[1]), sig); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	var claims map[string]any
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("%w: claims: %v", ErrInvalidToken, err)
+	}
+	if err := v.validateClaims(claims); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	return v.identity(claims)
+}
+
+func (v *Verifier) validateClaims(claims map[string]any) error {
+	if iss, _ := claims["iss"].(string); iss != v.cfg.Issuer {
+		return fmt.Errorf("unexpected issuer %q", iss)
+	}
+	if !audienceContains(claims["aud"], v.cfg.Audience) {
+		return errors.New("unexpected audience")
+	}
+
+	now := time.Now()
+	exp, ok := numericDate(claims["exp"])
+	if !ok {
+		return errors.New("exp is required")
+	}
+	if !now.Before(exp.Add(v.cfg.Leeway)) {
+		return errors.New("token expired")
+	}
+	if nbf, ok := numericDate(claims["nbf"]); ok && now.Add(v.cfg.Leeway).Before(nbf) {
+		return errors.New("token not yet valid")
+	}
+	return nil
+}
+
+func (v *Verifier) identity(claims map[string]any) (*Identity, error) {
+	userID, _ := lookupClaim(claims, v.cfg.UserIDClaim).(string)
+	if userID == "" {
+		return nil, fmt.Errorf("%w: claim %s is required", ErrInvalidToken, v.cfg.UserIDClaim)
+	}
+	sub, _ := claims["sub"].(string)
+
+	var roles []string
+	switch raw := lookupClaim(claims, v.cfg.RolesClaim).(type) {
+	case string:
+		roles = strings.Fields(raw)
+	case []any:
+		for _, r := range raw {
+			if s, ok := r.(string); ok {
+				roles = append(roles, s)
+			}
+		}
+	}
+
+	scopes := []api.ApiKeyScope{api.ApiKeyScopeWrite}
+	if slices.Contains(roles, v.cfg.AdminRole) {
+		scopes = []api.ApiKeyScope{api.ApiKeyScopeAdmin}
+	}
+	return &Identity{
+		Kind:    api.PrincipalKindJwt,
+		Subject: sub,
+		UserID:  userID,
+		Roles:   roles,
+		Scopes:  scopes,
+	}, nil
+}
+
+func decodeSegment(seg string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(seg)
+	if err != nil {
+		return err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	return dec.Decode(v)
+}
+
+func lookupClaim(claims map[string]any, path string) any {
+	var cur any = claims
+	for _, name := range strings.Split(path, ".") {
+		m, ok := cur.(map[string]any)
+		if !ok {
+			return nil
+		}
+		cur = m[name]
+	}
+	return cur
+}
+
+func audienceContains(aud any, want string) bool {
+	switch v := aud.(type) {
+	case string:
+		return v == want
+	case []any:
+		return slices.Contains(v, any(want))
+	}
+	return false
+}
+
+func numericDate(v any) (time.Time, bool) {
+	n, ok := v.(json.Number)
+	if !ok {
+		return time.Time{}, false
+	}
+	f, err := n.Float64()
+	if err != nil {
+		return time.Time{}, false
+	}
+	sec := int64(f)
+	return time.Unix(sec, int64((f-float64(sec))*1e9)), true
+}
+
+// verifySignature поддерживает RS*, PS* и ES*; none и HMAC не принимаются.
+func verifySignature(alg string, key crypto.PublicKey, signed, sig []byte) error {
+	var hash crypto.Hash
+	switch alg[min(2, len(alg)):] {
+	case "256":
+		hash = crypto.SHA256
+	case "384":
+		hash = crypto.SHA384
+	case "512":
+		hash = crypto.SHA512
+	default:
+		return fmt.Errorf("unsupported alg %q", alg)
+	}
+	h := hash.New()
+	h.Write(signed)
+	digest := h.Sum(nil)
+
+	switch {
+	case strings.HasPrefix(alg, "RS"), strings.HasPrefix(alg, "PS"):
+		pub, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("key does not match alg %q", alg)
+		}
+		if alg[0] == 'R' {
+			return rsa.VerifyPKCS1v15(pub, hash, digest, sig)
+		}
+		return rsa.VerifyPSS(pub, hash, digest, sig, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+	case strings.HasPrefix(alg, "ES"):
+		pub, ok := key.(*ecdsa.PublicKey)
+		if !ok || pub.Curve.Params().BitSize != curveBits(hash) {
+			return fmt.Errorf("key does not match alg %q", alg)
+		}
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		if len(sig) != 2*size {
+			return errors.New("invalid signature length")
+		}
+		r := new(big.Int).SetBytes(sig[:size])
+		s := new(big.Int).SetBytes(sig[size:])
+		if !ecdsa.Verify(pub, digest, r, s) {
+			return errors.New("signature mismatch")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported alg %q", alg)
+	}
+}
+
+func curveBits(hash crypto.Hash) int {
+	switch hash {
+	case crypto.SHA256:
+		return 256
+	case crypto.SHA384:
+		return 384
+	default:
+		return 521
+	}
+}
@@ -13,10 +13,34 @@ type DBConfig struct {
 	MaxIdleTime time.Duration
 }
 
+type GitHubConfig struct {
+	APIURL     string
+	Token      string
+	MaxRetries int32
+}
+
+// JWTConfig включает приём JWT от SSO, если задан JWKSFile или JWKSURL.
+type JWTConfig struct {
+	Issuer       string
+	Audience     string
+	JWKSFile     string
+	JWKSURL      string
+	JWKSCacheTTL time.Duration
+	UserIDClaim  string
+	RolesClaim   string
+	AdminRole    string
+}
+
 type Config struct {
-	HTTPAddr   string
-	AdminToken string
-	DB         DBConfig
+	HTTPAddr           string
+	AdminToken         string
+	RequireAuth        bool
+	GitLabWebhookToken string
+	SCIMToken          string
+	SchedulerInterval  time.Duration
+	GitHub             GitHubConfig
+	JWT                JWTConfig
+	DB                 DBConfig
 }
 
 func MustLoad() Config {
@@ -24,6 +48,27 @@ func MustLoad() Config {
 
 	cfg.HTTPAddr = getenv("HTTP_ADDR", ":8080")
 	cfg.AdminToken = os.Getenv("ADMIN_TOKEN")
+	cfg.RequireAuth = getBool("REQUIRE_AUTH", false)
+	cfg.GitLabWebhookToken = os.Getenv("GITLAB_WEBHOOK_TOKEN")
+	cfg.SCIMToken = os.Getenv("SCIM_TOKEN")
+	cfg.SchedulerInterval = getDuration("SCHEDULER_INTERVAL", "1m")
+
+	cfg.GitHub = GitHubConfig{
+		APIURL:     getenv("GITHUB_API_URL", "https://api.github.com"),
+		Token:      os.Getenv("GITHUB_TOKEN"),
+		MaxRetries: getInt32("GITHUB_MAX_RETRIES", 3),
+	}
+
+	cfg.JWT = JWTConfig{
+		Issuer:       os.Getenv("JWT_ISSUER"),
+		Audience:     os.Getenv("JWT_AUDIENCE"),
+		JWKSFile:     os.Getenv("JWT_JWKS_FILE"),
+		JWKSURL:      os.Getenv("JWT_JWKS_URL"),
+		JWKSCacheTTL: getDuration("JWT_JWKS_CACHE_TTL", "10m"),
+		UserIDClaim:  getenv("JWT_USER_ID_CLAIM", "sub"),
+		RolesClaim:   getenv("JWT_ROLES_CLAIM", "roles"),
+		AdminRole:    getenv("JWT_ADMIN_ROLE", "admin"),
+	}
 
 	cfg.DB = DBConfig{
 		DSN:         getenv("DB_DSN", "postgres://postgres:postgres@db:5432/postgres?sslmode=disable"),
@@ -53,6 +98,18 @@ func getInt32(key string, def int32) int32 {
 	return int32(n)
 }
 
+func getBool(key string, def bool) bool {
+	v := os.Getenv(key)
+	if v == "" {
+		return def
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		log.Fatalf("invalid %s value %q: %v", key, v, err)
+	}
+	return b
+}
+
 func getDuration(key, def string) time.Duration {
 	v := getenv(key, def)
 	d, err := time.ParseDuration(v)
@@ -0,0 +1,244 @@
+package connector
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/repository"
+	"avito-autumn2025-internship/internal/service"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultGitHubBaseURL = "https://api.github.com"
+
+	gitHubPRPrefix   = "github:"
+	gitHubAPIVersion = "2022-11-28"
+	maxRetryDelay    = 10 * time.Second
+)
+
+type GitHubConfig struct {
+	BaseURL    string
+	Token      string
+	MaxRetries int
+	RetryDelay time.Duration
+	HTTPClient *http.Client
+}
+
+// PullRequestRef адресует PR в GitHub.
+type PullRequestRef struct {
+	Owner  string
+	Repo   string
+	Number int64
+}
+
+func (r PullRequestRef) String() string {
+	return fmt.Sprintf("%s/%s#%d", r.Owner, r.Repo, r.Number)
+}
+
+// GitHubPullRequestID строит идентификатор PR сервиса вида github:owner/repo#number.
+func GitHubPullRequestID(ref PullRequestRef) string {
+	return gitHubPRPrefix + ref.String()
+}
+
+// ParseGitHubPullRequestID разбирает идентификатор, построенный GitHubPullRequestID.
+func ParseGitHubPullRequestID(prID string) (PullRequestRef, bool) {
+	rest, ok := strings.CutPrefix(prID, gitHubPRPrefix)
+	if !ok {
+		return PullRequestRef{}, false
+	}
+	repoPath, num, ok := strings.Cut(rest, "#")
+	if !ok {
+		return PullRequestRef{}, false
+	}
+	owner, repo, ok := strings.Cut(repoPath, "/")
+	if !ok || owner == "" || repo == "" {
+		return PullRequestRef{}, false
+	}
+	n, err := strconv.ParseInt(num, 10, 64)
+	if err != nil || n <= 0 {
+		return PullRequestRef{}, false
+	}
+	return PullRequestRef{Owner: owner, Repo: repo, Number: n}, true
+}
+
+type GitHubError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *GitHubError) Error() string {
+	return fmt.Sprintf("github api: status %d: %s", e.StatusCode, e.Body)
+}
+
+// GitHub реализует service.ReviewerConnector поверх GitHub REST API.
+// Запрос и снятие ревьюверов в GitHub идемпотентны, поэтому изменения
+// отправляются всегда, без локального кэша состояния: он расходился бы
+// с GitHub после рестарта и между репликами.
+type GitHub struct {
+	cfg      GitHubConfig
+	userRepo repository.UserRepository
+}
+
+var _ service.ReviewerConnector = (*GitHub)(nil)
+
+func NewGitHub(cfg GitHubConfig, userRepo repository.UserRepository) *GitHub {
+	if cfg.BaseURL == "" {
+		cfg.BaseURL = DefaultGitHubBaseURL
+	}
+	cfg.BaseURL = strings.TrimRight(cfg.BaseURL, "/")
+	if cfg.RetryDelay <= 0 {
+		cfg.RetryDelay = 200 * time.Millisecond
+	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &GitHub{
+		cfg:      cfg,
+		userRepo: userRepo,
+	}
+}
+
+func (g *GitHub) RequestReviewers(ctx context.Context, prID string, userIDs []string) error {
+	return g.apply(ctx, prID, userIDs, true)
+}
+
+func (g *GitHub) RemoveReviewers(ctx context.Context, prID string, userIDs []string) error {
+	return g.apply(ctx, prID, userIDs, false)
+}
+
+func (g *GitHub) apply(ctx context.Context, prID string, userIDs []string, requested bool) error {
+	ref, ok := ParseGitHubPullRequestID(prID)
+	if !ok || len(userIDs) == 0 {
+		return nil
+	}
+
+	logins, err := g.userRepo.ListExternalLogins(ctx, api.Github, userIDs)
+	if err != nil {
+		return err
+	}
+
+	var pending []string
+	for _, id := range userIDs {
+		login, ok := logins[id]
+		if !ok {
+			log.Printf("github: user %s has no linked github login, skipping %s", id, ref)
+			continue
+		}
+		pending = append(pending, login)
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+	sort.Strings(pending)
+
+	method := http.MethodPost
+	if !requested {
+		method = http.MethodDelete
+	}
+	path := fmt.Sprintf("/repos/%s/%s/pulls/%d/requested_reviewers", ref.Owner, ref.Repo, ref.Number)
+
+	return g.do(ctx, method, path, map[string][]string{"reviewers": pending})
+}
+
+func (g *GitHub) do(ctx context.Context, method, path string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= g.cfg.MaxRetries; attempt++ {
+		if attempt > 0 {
+			if err := sleep(ctx, retryDelay(lastErr, g.cfg.RetryDelay, attempt)); err != nil {
+				return err
+			}
+		}
+
+		lastErr = g.send(ctx, method, path, body)
+		if lastErr == nil || !retryable(lastErr) {
+			return lastErr
+		}
+	}
+	return lastErr
+}
+
+func (g *GitHub) send(ctx context.Context, method, path string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, method, g.cfg.BaseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-GitHub-Api-Version", gitHubAPIVersion)
+	if g.cfg.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+g.cfg.Token)
+	}
+
+	resp, err := g.cfg.HTTPClient.Do(req)
+	if err != nil {
+		return &retryableError{err: err}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	ghErr := &GitHubError{StatusCode: resp.StatusCode, Body: strings.TrimSpace(string(respBody))}
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+		return &retryableError{err: ghErr, retryAfter: parseRetryAfter(resp.Header.Get("Retry-After"))}
+	}
+	return ghErr
+}
+
+type retryableError struct {
+	err        error
+	retryAfter time.Duration
+}
+
+func (e *retryableError) Error() string { return e.err.Error() }
+func (e *retryableError) Unwrap() error { return e.err }
+
+func retryable(err error) bool {
+	var re *retryableError
+	return errors.As(err, &re)
+}
+
+func retryDelay(err error, base time.Duration, attempt int) time.Duration {
+	var re *retryableError
+	if errors.As(err, &re) && re.retryAfter > 0 {
+		return min(re.retryAfter, maxRetryDelay)
+	}
+	return min(base<<(attempt-1), maxRetryDelay)
+}
+
+func parseRetryAfter(v string) time.Duration {
+	secs, err := strconv.Atoi(v)
+	if err != nil || secs <= 0 {
+		return 0
+	}
+	return time.Duration(secs) * time.Second
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,150 @@
+package handlers
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/auth"
+	"avito-autumn2025-internship/internal/service"
+	"context"
+	"net/http"
+)
+
+func (s *Server) PostAdminImport(
+	ctx context.Context,
+	req api.PostAdminImportRequestObject,
+) (api.PostAdminImportResponseObject, error) {
+	if req.Body == nil {
+		errResp := makeError(api.BADREQUEST, "request body is required")
+		return api.PostAdminImport400JSONResponse(errResp), nil
+	}
+
+	dryRun := req.Params.DryRun != nil && *req.Params.DryRun
+	result, err := s.teamService.ImportTeams(ctx, req.Params.Format, []byte(*req.Body), dryRun)
+	if err != nil {
+		code, status := mapDomainError(err)
+		errResp := makeError(code, err.Error())
+
+		if status == http.StatusBadRequest {
+			return api.PostAdminImport400JSONResponse(errResp), nil
+		}
+		return nil, err
+	}
+
+	if len(result.Errors) > 0 {
+		return api.PostAdminImport422JSONResponse(*result), nil
+	}
+	return api.PostAdminImport200JSONResponse(*result), nil
+}
+
+func (s *Server) GetAdminApiKeys(
+	ctx context.Context,
+	req api.GetAdminApiKeysRequestObject,
+) (api.GetAdminApiKeysResponseObject, error) {
+	includeRevoked := req.Params.IncludeRevoked != nil && *req.Params.IncludeRevoked
+	keys, err := s.apiKeyService.ListKeys(ctx, includeRevoked)
+	if err != nil {
+		return nil, err
+	}
+	return api.GetAdminApiKeys200JSONResponse{Keys: keys}, nil
+}
+
+func (s *Server) PostAdminApiKeys(
+	ctx context.Context,
+	req api.PostAdminApiKeysRequestObject,
+) (api.PostAdminApiKeysResponseObject, error) {
+	if req.Body == nil {
+		errResp := makeError(api.BADREQUEST, "request body is required")
+		return api.PostAdminApiKeys400JSONResponse(errResp), nil
+	}
+
+	key, secret, err := s.apiKeyService.CreateKey(ctx, *req.Body)
+	if err != nil {
+		code, status := mapDomainError(err)
+		errResp := makeError(code, err.Error())
+
+		if status == http.StatusBadRequest {
+			return api.PostAdminApiKeys400JSONResponse(errResp), nil
+		}
+		return nil, err
+	}
+	return api.PostAdminApiKeys201JSONResponse{Key: *key, Secret: secret}, nil
+}
+
+func (s *Server) PostAdminApiKeysRevoke(
+	ctx context.Context,
+	req api.PostAdminApiKeysRevokeRequestObject,
+) (api.PostAdminApiKeysRevokeResponseObject, error) {
+	if req.Body == nil {
+		errResp := makeError(api.BADREQUEST, "request body is required")
+		return api.PostAdminApiKeysRevoke400JSONResponse(errResp), nil
+	}
+
+	key, err := s.apiKeyService.RevokeKey(ctx, req.Body.Id)
+	if err != nil {
+		code, status := mapDomainError(err)
+		errResp := makeError(code, err.Error())
+
+		if status == http.StatusNotFound {
+			return api.PostAdminApiKeysRevoke404JSONResponse(errResp), nil
+		}
+		return nil, err
+	}
+	return api.PostAdminApiKeysRevoke200JSONResponse{Key: *key}, nil
+}
+
+func (s *Server) GetAuthWhoami(
+	ctx context.Context,
+	req api.GetAuthWhoamiRequestObject,
+) (api.GetAuthWhoamiResponseObject, error) {
+	identity := auth.FromContext(ctx)
+	if identity == nil {
+		errResp := makeError(api.UNAUTHORIZED, "no valid bearer token")
+		return api.GetAuthWhoami401JSONResponse(errResp), nil
+	}
+
+	principal := api.Principal{
+		Kind:      identity.Kind,
+		Subject:   identity.Subject,
+		Role:      api.RoleMember,
+		Scopes:    identity.Scopes,
+		LeadTeams: []string{},
+	}
+	if identity.UserID != "" {
+		principal.UserId = &identity.UserID
+		if s.accessService != nil {
+			led, err := s.accessService.LedTeams(ctx, identity.UserID)
+			if err != nil {
+				return nil, err
+			}
+			if len(led) > 0 {
+				principal.LeadTeams = led
+				principal.Role = api.RoleTeamLead
+			}
+		}
+	}
+	if service.HasScope(identity.Scopes, api.ApiKeyScopeAdmin) {
+		principal.Role = api.RoleAdmin
+	}
+	return api.GetAuthWhoami200JSONResponse(principal), nil
+}
+
+func (s *Server) GetAdminAudit(
+	ctx context.Context,
+	req api.GetAdminAuditRequestObject,
+) (api.GetAdminAuditResponseObject, error) {
+	// Без журнала аудита отдаём пустую страницу.
+	if s.auditService == nil {
+		return api.GetAdminAudit200JSONResponse{Records: []api.AuditRecord{}}, nil
+	}
+
+	page, err := s.auditService.List(ctx, req.Params)
+	if err != nil {
+		code, status := mapDomainError(err)
+		errResp := makeError(code, err.Error())
+
+		if status == http.StatusBadRequest {
+			return api.GetAdminAudit400JSONResponse(errResp), nil
+		}
+		return nil, err
+	}
+	return api.GetAdminAudit200JSONResponse(*page), nil
+}
@@ -0,0 +1,226 @@
+package handlers
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/auth"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	auditMaxString = 256
+	auditMaxItems  = 50
+	auditMaxDepth  = 4
+)
+
+// auditSensitiveKeys — подстроки имён полей, значения которых не пишутся в журнал.
+var auditSensitiveKeys = []string{"password", "secret", "token"}
+
+// auditPersonalKeys — поля с персональными данными (имена, логины, почта).
+// В сводку они не попадают: журнал не чистится при анонимизации пользователя,
+// поэтому в нём остаются только идентификаторы.
+var auditPersonalKeys = map[string]bool{
+	"username":    true,
+	"displayname": true,
+	"display":     true,
+	"name":        true,
+	"emails":      true,
+	"email":       true,
+	"login":       true,
+}
+
+// auditEntry заполняется по ходу запроса: operationId и сводку тела выставляет
+// AuditMiddleware, личность — AuthMiddleware через auth.WithIdentity.
+type auditEntry struct {
+	operationID string
+	payload     map[string]interface{}
+	identity    *auth.Identity
+}
+
+type auditEntryKey struct{}
+
+type auditResponseWriter struct {
+	http.ResponseWriter
+	statusCode int
+}
+
+func (w *auditResponseWriter) WriteHeader(statusCode int) {
+	w.statusCode = statusCode
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+// Unwrap открывает исходный ResponseWriter для http.ResponseController.
+func (w *auditResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// AuditHandler пишет в журнал каждый изменяющий запрос, дошедший до
+// обработчика API, — в том числе отклонённые авторизацией.
+func (s *Server) AuditHandler(next http.Handler) http.Handler {
+	if s.auditService == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !isMutating(r.Method) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		entry := &auditEntry{}
+		ctx := context.WithValue(r.Context(), auditEntryKey{}, entry)
+		ctx = auth.WithIdentityHolder(ctx, &entry.identity)
+		arw := &auditResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		start := time.Now()
+		next.ServeHTTP(arw, r.WithContext(ctx))
+		if entry.operationID == "" {
+			return
+		}
+
+		rec := api.AuditRecord{
+			OccurredAt:  start,
+			OperationId: entry.operationID,
+			Method:      r.Method,
+			Path:        r.URL.Path,
+			Status:      arw.statusCode,
+			Result:      auditResult(arw.statusCode),
+			ClientIp:    clientIP(r),
+			Payload:     entry.payload,
+		}
+		if id := entry.identity; id != nil {
+			kind := id.Kind
+			rec.ActorKind = &kind
+			rec.Actor = &id.Subject
+			if id.UserID != "" {
+				rec.ActorUserId = &id.UserID
+			}
+		}
+		if err := s.auditService.Record(context.WithoutCancel(r.Context()), rec); err != nil {
+			log.Printf("audit %s: %v", entry.operationID, err)
+		}
+	})
+}
+
+// AuditMiddleware сообщает AuditHandler operationId и сводку запроса.
+func (s *Server) AuditMiddleware() api.StrictMiddlewareFunc {
+	return func(next api.StrictHandlerFunc, operationID string) api.StrictHandlerFunc {
+		return func(
+			ctx context.Context,
+			w http.ResponseWriter,
+			r *http.Request,
+			request interface{},
+		) (response interface{}, err error) {
+			if entry, ok := ctx.Value(auditEntryKey{}).(*auditEntry); ok {
+				entry.operationID = operationID
+				entry.payload = summarizeRequest(request)
+			}
+			return next(ctx, w, r, request)
+		}
+	}
+}
+
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	return true
+}
+
+func auditResult(status int) api.AuditResult {
+	switch {
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		return api.Denied
+	case status >= http.StatusBadRequest:
+		return api.Failure
+	default:
+		return api.Success
+	}
+}
+
+// clientIP берёт адрес соединения: X-Forwarded-For подделывается клиентом.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// summarizeRequest превращает объект запроса в компактную сводку: длинные
+// строки и списки обрезаются, секреты скрываются, персональные данные
+// опускаются, текстовое тело заменяется его размером.
+func summarizeRequest(request interface{}) map[string]interface{} {
+	raw, err := json.Marshal(request)
+	if err != nil {
+		return nil
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil
+	}
+
+	summary := make(map[string]interface{}, len(fields))
+	for key, value := range fields {
+		if value == nil {
+			continue
+		}
+		key = strings.ToLower(key)
+		if text, ok := value.(string); ok && key == "body" {
+			summary[key] = map[string]interface{}{"body_bytes": len(text)}
+			continue
+		}
+		summary[key] = summarizeValue(key, value, 1)
+	}
+	return summary
+}
+
+func summarizeValue(key string, value interface{}, depth int) interface{} {
+	lower := strings.ToLower(key)
+	for _, s := range auditSensitiveKeys {
+		if strings.Contains(lower, s) {
+			return "[redacted]"
+		}
+	}
+
+	switch v := value.(type) {
+	case string:
+		if runes := []rune(v); len(runes) > auditMaxString {
+			return string(runes[:auditMaxString]) + "…"
+		}
+		return v
+	case map[string]interface{}:
+		if depth >= auditMaxDepth {
+			return fmt.Sprintf("{%d fields}", len(v))
+		}
+		out := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			if auditPersonalKeys[strings.ToLower(k)] {
+				continue
+			}
+			out[k] = summarizeValue(k, item, depth+1)
+		}
+		return out
+	case []interface{}:
+		if depth >= auditMaxDepth {
+			return fmt.Sprintf("[%d items]", len(v))
+		}
+		n := min(len(v), auditMaxItems)
+		out := make([]interface{}, 0, n+1)
+		for _, item := range v[:n] {
+			out = append(out, summarizeValue("", item, depth+1))
+		}
+		if len(v) > n {
+			out = append(out, fmt.Sprintf("… %d more", len(v)-n))
+		}
+		return out
+	default:
+		return value
+	}
+}
@@ -0,0 +1,217 @@
+package handlers
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/auth"
+	"avito-autumn2025-internship/internal/service"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+)
+
+// publicOperations проверяют собственные токены интеграций.
+var publicOperations = map[string]bool{
+	"PostIntegrationsGitlabWebhook": true,
+	"GetScimV2Users":                true,
+	"PostScimV2Users":               true,
+	"GetScimV2UsersId":              true,
+	"PutScimV2UsersId":              true,
+	"PatchScimV2UsersId":            true,
+	"DeleteScimV2UsersId":           true,
+	"GetScimV2Groups":               true,
+	"PostScimV2Groups":              true,
+	"GetScimV2GroupsId":             true,
+	"PatchScimV2GroupsId":           true,
+	"DeleteScimV2GroupsId":          true,
+}
+
+// adminOperations требуют scope admin; остальные GET — read, прочие — write.
+// Часть из них доступна и руководителям команд — см. leadTargets.
+var adminOperations = map[string]bool{
+	"PostAdminImport":                     true,
+	"PostTeamLeadsAdd":                    true,
+	"PostTeamLeadsRemove":                 true,
+	"PostPullRequestReassign":             true,
+	"GetAdminAudit":                       true,
+	"GetAdminApiKeys":                     true,
+	"PostAdminApiKeys":                    true,
+	"PostAdminApiKeysRevoke":              true,
+	"PostRepositoryUpsert":                true,
+	"PostTeamMassDeactivate":              true,
+	"PatchTeamUpdate":                     true,
+	"PostTeamRename":                      true,
+	"PostTeamSetParent":                   true,
+	"DeleteTeam":                          true,
+	"PutTeamSettings":                     true,
+	"PostTeamSettingsRollback":            true,
+	"PostUsersSetIsActive":                true,
+	"PostUsersAnonymize":                  true,
+	"PostUsersLinkExternalAccount":        true,
+	"PostUsersMoveTeam":                   true,
+	"PostUsersScheduleActivation":         true,
+	"PostUsersScheduledActivationsCancel": true,
+}
+
+func requiredScope(operationID, method string) api.ApiKeyScope {
+	switch {
+	case adminOperations[operationID]:
+		return api.ApiKeyScopeAdmin
+	case method == http.MethodGet:
+		return api.ApiKeyScopeRead
+	default:
+		return api.ApiKeyScopeWrite
+	}
+}
+
+// AuthMiddleware проверяет bearer-токен, кладёт в контекст auth.Identity и
+// сверяет scope операции; без scope admin операцию могут выполнить
+// руководители затронутых команд. Пока не заданы ни ADMIN_TOKEN, ни JWT,
+// сервис работает без авторизации, как и раньше. Операции без admin доступны
+// анонимно, пока не включён REQUIRE_AUTH: предъявленный токен тогда лишь
+// определяет вызывающего.
+func (s *Server) AuthMiddleware() api.StrictMiddlewareFunc {
+	return func(next api.StrictHandlerFunc, operationID string) api.StrictHandlerFunc {
+		return func(
+			ctx context.Context,
+			w http.ResponseWriter,
+			r *http.Request,
+			request interface{},
+		) (response interface{}, err error) {
+			if (s.adminToken == "" && s.jwtVerifier == nil) || publicOperations[operationID] {
+				return next(ctx, w, r, request)
+			}
+
+			required := requiredScope(operationID, r.Method)
+			optional := !s.requireAuth && required != api.ApiKeyScopeAdmin
+
+			identity, err := s.authenticate(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if identity == nil {
+				if optional {
+					return next(ctx, w, r, request)
+				}
+				writeAuthError(w, service.ErrUnauthorized, "unauthorized")
+				return nil, nil
+			}
+			ctx = auth.WithIdentity(ctx, identity)
+			if !optional && !service.HasScope(identity.Scopes, required) {
+				allowed, err := s.allowedAsTeamLead(ctx, identity, request)
+				if err != nil {
+					return nil, err
+				}
+				if !allowed {
+					writeAuthError(w, service.ErrForbidden, "forbidden: "+string(required)+" scope required")
+					return nil, nil
+				}
+			}
+			return next(ctx, w, r, request)
+		}
+	}
+}
+
+// authenticate определяет, кто предъявил токен; nil — токен не принят.
+func (s *Server) authenticate(ctx context.Context) (*auth.Identity, error) {
+	token := bearerTokenFromContext(ctx)
+	switch {
+	case token == "":
+		return nil, nil
+	case s.adminToken != "" && tokensEqual(token, s.adminToken):
+		return &auth.Identity{
+			Kind:    api.PrincipalKindAdminToken,
+			Subject: "admin-token",
+			Scopes:  []api.ApiKeyScope{api.ApiKeyScopeAdmin},
+		}, nil
+	case s.jwtVerifier != nil && auth.LooksLikeJWT(token):
+		identity, err := s.jwtVerifier.Verify(ctx, token)
+		if errors.Is(err, auth.ErrInvalidToken) {
+			return nil, nil
+		}
+		return identity, err
+	case s.apiKeyService != nil:
+		key, err := s.apiKeyService.Authenticate(ctx, token)
+		if err != nil {
+			if errors.Is(err, service.ErrUnauthorized) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return &auth.Identity{
+			Kind:    api.PrincipalKindApiKey,
+			Subject: key.Prefix,
+			Scopes:  key.Scopes,
+		}, nil
+	default:
+		return nil, nil
+	}
+}
+
+// allowedAsTeamLead пропускает пользователя, если он руководит хотя бы одной
+// из команд, которых касается запрос.
+func (s *Server) allowedAsTeamLead(ctx context.Context, identity *auth.Identity, request interface{}) (bool, error) {
+	if s.accessService == nil || identity.UserID == "" ||
+		!service.HasScope(identity.Scopes, api.ApiKeyScopeWrite) {
+		return false, nil
+	}
+
+	targets, err := s.leadTargets(ctx, request)
+	if err != nil || len(targets) == 0 {
+		return false, err
+	}
+	led, err := s.accessService.LedTeams(ctx, identity.UserID)
+	if err != nil {
+		return false, err
+	}
+	for _, team := range targets {
+		if slices.Contains(led, team) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// leadTargets возвращает команды, руководителям которых доступна операция;
+// nil — операция только для admin.
+func (s *Server) leadTargets(ctx context.Context, request interface{}) ([]string, error) {
+	switch req := request.(type) {
+	case api.PostTeamMassDeactivateRequestObject:
+		if req.Body != nil {
+			return []string{req.Body.TeamName}, nil
+		}
+	case api.PutTeamSettingsRequestObject:
+		if req.Body != nil {
+			return []string{req.Body.TeamName}, nil
+		}
+	case api.PostTeamSettingsRollbackRequestObject:
+		if req.Body != nil {
+			return []string{req.Body.TeamName}, nil
+		}
+	case api.PostUsersSetIsActiveRequestObject:
+		if req.Body != nil {
+			return s.accessService.UserTeams(ctx, req.Body.UserId)
+		}
+	case api.PostUsersScheduleActivationRequestObject:
+		if req.Body != nil {
+			return s.accessService.UserTeams(ctx, req.Body.UserId)
+		}
+	case api.PostUsersScheduledActivationsCancelRequestObject:
+		if req.Body != nil {
+			return s.accessService.ScheduledActivationTeams(ctx, req.Body.Id)
+		}
+	case api.PostPullRequestReassignRequestObject:
+		if req.Body != nil {
+			return s.accessService.PullRequestTeams(ctx, req.Body.PullRequestId)
+		}
+	}
+	return nil, nil
+}
+
+func writeAuthError(w http.ResponseWriter, err error, msg string) {
+	code, status := mapDomainError(err)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(makeError(code, msg))
+}
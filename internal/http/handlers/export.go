@@ -0,0 +1,199 @@
+package handlers
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/repository"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type exportFormat string
+
+const (
+	formatJSON   exportFormat = "json"
+	formatCSV    exportFormat = "csv"
+	formatNDJSON exportFormat = "ndjson"
+	formatDOT    exportFormat = "dot"
+)
+
+var formatMediaTypes = map[string]exportFormat{
+	"application/json":     formatJSON,
+	"text/csv":             formatCSV,
+	"application/x-ndjson": formatNDJSON,
+	"text/vnd.graphviz":    formatDOT,
+}
+
+// exportWriteTimeout — сколько даётся на отправку очередной порции выгрузки.
+// WriteTimeout сервера ограничивает весь ответ и оборвал бы длинную выгрузку,
+// поэтому exportReader продлевает дедлайн перед каждой порцией.
+const exportWriteTimeout = 10 * time.Second
+
+type (
+	acceptKey         struct{}
+	responseWriterKey struct{}
+)
+
+// AcceptMiddleware передаёт обработчикам заголовок Accept для выбора формата
+// ответа и ResponseWriter, дедлайн записи которого продлевают выгрузки.
+func AcceptMiddleware() api.StrictMiddlewareFunc {
+	return func(next api.StrictHandlerFunc, operationID string) api.StrictHandlerFunc {
+		return func(
+			ctx context.Context,
+			w http.ResponseWriter,
+			r *http.Request,
+			request interface{},
+		) (response interface{}, err error) {
+			if accept := r.Header.Get("Accept"); accept != "" {
+				ctx = context.WithValue(ctx, acceptKey{}, accept)
+			}
+			ctx = context.WithValue(ctx, responseWriterKey{}, w)
+			return next(ctx, w, r, request)
+		}
+	}
+}
+
+// negotiateFormat выбирает формат ответа среди json и allowed: параметр format
+// приоритетнее Accept, из Accept берётся первый допустимый тип (q-веса не
+// учитываются). false — в format передано недопустимое значение.
+func negotiateFormat(ctx context.Context, param *string, allowed ...exportFormat) (exportFormat, bool) {
+	isAllowed := func(f exportFormat) bool {
+		return f == formatJSON || slices.Contains(allowed, f)
+	}
+
+	if param != nil && *param != "" {
+		f := exportFormat(*param)
+		return f, isAllowed(f)
+	}
+
+	accept, _ := ctx.Value(acceptKey{}).(string)
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, _, _ := strings.Cut(part, ";")
+		if f, ok := formatMediaTypes[strings.ToLower(strings.TrimSpace(mediaType))]; ok && isAllowed(f) {
+			return f, true
+		}
+	}
+	return formatJSON, true
+}
+
+// exportReader кодирует записи по мере чтения тела ответа, поэтому выгрузка
+// не накапливается в памяти. Ошибка Rows посреди выгрузки обрывает ответ.
+type exportReader[T any] struct {
+	rows   repository.Rows[T]
+	record func(T) []string
+	rc     *http.ResponseController
+	buf    bytes.Buffer
+	csv    *csv.Writer
+	done   bool
+}
+
+func newExportReader[T any](
+	ctx context.Context,
+	rows repository.Rows[T],
+	format exportFormat,
+	header []string,
+	record func(T) []string,
+) *exportReader[T] {
+	r := &exportReader[T]{rows: rows, record: record}
+	if w, ok := ctx.Value(responseWriterKey{}).(http.ResponseWriter); ok {
+		r.rc = http.NewResponseController(w)
+	}
+	if format == formatCSV {
+		r.csv = csv.NewWriter(&r.buf)
+		_ = r.csv.Write(header)
+		r.csv.Flush()
+	}
+	return r
+}
+
+func (r *exportReader[T]) Read(p []byte) (int, error) {
+	if r.rc != nil {
+		// ErrNotSupported (например, у httptest.ResponseRecorder) не мешает выгрузке.
+		_ = r.rc.SetWriteDeadline(time.Now().Add(exportWriteTimeout))
+	}
+	for r.buf.Len() == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		if err := r.fill(); err != nil {
+			return 0, err
+		}
+	}
+	return r.buf.Read(p)
+}
+
+// Close вызывается сгенерированным обработчиком после отправки ответа.
+func (r *exportReader[T]) Close() error {
+	r.rows.Close()
+	return nil
+}
+
+func (r *exportReader[T]) fill() error {
+	if !r.rows.Next() {
+		r.done = true
+		return r.rows.Err()
+	}
+
+	v := r.rows.Value()
+	if r.csv != nil {
+		_ = r.csv.Write(r.record(v))
+		r.csv.Flush()
+		return r.csv.Error()
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	r.buf.Write(data)
+	r.buf.WriteByte('\n')
+	return nil
+}
+
+var userExportHeader = []string{"user_id", "username", "team_name", "is_active", "teams"}
+
+func userRecord(u api.User) []string {
+	var teams string
+	if u.Teams != nil {
+		teams = strings.Join(*u.Teams, ";")
+	}
+	return []string{u.UserId, u.Username, u.TeamName, strconv.FormatBool(u.IsActive), teams}
+}
+
+var pullRequestShortExportHeader = []string{"pull_request_id", "pull_request_name", "author_id", "status"}
+
+func pullRequestShortRecord(pr api.PullRequestShort) []string {
+	return []string{pr.PullRequestId, pr.PullRequestName, pr.AuthorId, string(pr.Status)}
+}
+
+var reviewerStatExportHeader = []string{"user_id", "assigned_count", "team_name", "week_start"}
+
+func reviewerStatRecord(st api.ReviewerStat) []string {
+	var team, week string
+	if st.TeamName != nil {
+		team = *st.TeamName
+	}
+	if st.WeekStart != nil {
+		week = st.WeekStart.String()
+	}
+	return []string{st.UserId, strconv.FormatInt(st.AssignedCount, 10), team, week}
+}
+
+var turnaroundExportHeader = []string{"key", "merged_count", "median_seconds", "p90_seconds", "mean_seconds"}
+
+func turnaroundRecord(st api.TurnaroundStat) []string {
+	return []string{
+		st.Key,
+		strconv.FormatInt(st.MergedCount, 10),
+		strconv.FormatFloat(st.MedianSeconds, 'f', -1, 64),
+		strconv.FormatFloat(st.P90Seconds, 'f', -1, 64),
+		strconv.FormatFloat(st.MeanSeconds, 'f', -1, 64),
+	}
+}
@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/service"
+	"context"
+)
+
+const gitLabMergeRequestEvent = "Merge Request Hook"
+
+func (s *Server) PostIntegrationsGitlabWebhook(
+	ctx context.Context,
+	req api.PostIntegrationsGitlabWebhookRequestObject,
+) (api.PostIntegrationsGitlabWebhookResponseObject, error) {
+	if s.gitLabService == nil {
+		errResp := makeError(api.NOTFOUND, "gitlab integration is not configured")
+		return api.PostIntegrationsGitlabWebhook404JSONResponse(errResp), nil
+	}
+
+	token := ""
+	if req.Params.XGitlabToken != nil {
+		token = *req.Params.XGitlabToken
+	}
+	if !tokensEqual(token, s.gitLabToken) {
+		err := service.ErrUnauthorized
+		code, _ := mapDomainError(err)
+		errResp := makeError(code, err.Error())
+		return api.PostIntegrationsGitlabWebhook401JSONResponse(errResp), nil
+	}
+
+	if req.Params.XGitlabEvent != nil && *req.Params.XGitlabEvent != gitLabMergeRequestEvent {
+		reason := "unsupported event " + *req.Params.XGitlabEvent
+		return api.PostIntegrationsGitlabWebhook200JSONResponse{
+			Status: api.Ignored,
+			Reason: &reason,
+		}, nil
+	}
+
+	if req.Body == nil {
+		errResp := makeError(api.BADREQUEST, "request body is required")
+		return api.PostIntegrationsGitlabWebhook400JSONResponse(errResp), nil
+	}
+
+	result, err := s.gitLabService.HandleMergeRequestEvent(ctx, *req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PostIntegrationsGitlabWebhook200JSONResponse(*result), nil
+}
@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"context"
+	"net/http"
+)
+
+func (s *Server) PostRepositoryUpsert(
+	ctx context.Context,
+	req api.PostRepositoryUpsertRequestObject,
+) (api.PostRepositoryUpsertResponseObject, error) {
+	if req.Body == nil {
+		errResp := makeError(api.BADREQUEST, "request body is required")
+		return api.PostRepositoryUpsert400JSONResponse(errResp), nil
+	}
+
+	repo, err := s.repoService.UpsertRepository(ctx, *req.Body)
+	if err != nil {
+		code, status := mapDomainError(err)
+		errResp := makeError(code, err.Error())
+
+		switch status {
+		case http.StatusBadRequest:
+			return api.PostRepositoryUpsert400JSONResponse(errResp), nil
+		case http.StatusNotFound:
+			return api.PostRepositoryUpsert404JSONResponse(errResp), nil
+		default:
+			return nil, err
+		}
+	}
+
+	return api.PostRepositoryUpsert200JSONResponse{
+		Repository: *repo,
+	}, nil
+}
+
+func (s *Server) GetRepositoryGet(
+	ctx context.Context,
+	req api.GetRepositoryGetRequestObject,
+) (api.GetRepositoryGetResponseObject, error) {
+	repo, err := s.repoService.GetRepository(ctx, req.Params.RepositoryId)
+	if err != nil {
+		code, status := mapDomainError(err)
+		errResp := makeError(code, err.Error())
+
+		if status == http.StatusNotFound {
+			return api.GetRepositoryGet404JSONResponse(errResp), nil
+		}
+		return nil, err
+	}
+
+	return api.GetRepositoryGet200JSONResponse(*repo), nil
+}
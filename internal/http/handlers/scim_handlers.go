@@ -0,0 +1,358 @@
+package handlers
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/service"
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+)
+
+const scimErrorSchema = "urn:ietf:params:scim:api:messages:2.0:Error"
+
+func makeSCIMError(status int, scimType, detail string) api.ScimError {
+	e := api.ScimError{
+		Schemas: []string{scimErrorSchema},
+		Status:  strconv.Itoa(status),
+	}
+	if scimType != "" {
+		e.ScimType = &scimType
+	}
+	if detail != "" {
+		e.Detail = &detail
+	}
+	return e
+}
+
+// scimGuard проверяет, что SCIM включён и запрос несёт его bearer-токен.
+// Нулевой статус означает, что запрос можно обрабатывать.
+func (s *Server) scimGuard(ctx context.Context) (api.ScimError, int) {
+	if s.scimService == nil {
+		return makeSCIMError(http.StatusNotFound, "", "scim is not configured"), http.StatusNotFound
+	}
+	if !tokensEqual(bearerTokenFromContext(ctx), s.scimToken) {
+		err := service.ErrUnauthorized
+		return makeSCIMError(http.StatusUnauthorized, "", err.Error()), http.StatusUnauthorized
+	}
+	return api.ScimError{}, 0
+}
+
+// mapSCIMError переводит доменную ошибку в ответ SCIM. Конфликт имён по RFC 7644
+// отдаётся как 409 uniqueness, а не как TEAM_EXISTS из основного API.
+func mapSCIMError(err error) (api.ScimError, int) {
+	switch {
+	case errors.Is(err, service.ErrTeamExists), errors.Is(err, service.ErrUserExists):
+		return makeSCIMError(http.StatusConflict, "uniqueness", err.Error()), http.StatusConflict
+	case errors.Is(err, service.ErrTeamInUse):
+		return makeSCIMError(http.StatusConflict, "", err.Error()), http.StatusConflict
+	}
+
+	_, status := mapDomainError(err)
+	scimType := ""
+	if status == http.StatusBadRequest {
+		scimType = "invalidValue"
+	}
+	return makeSCIMError(status, scimType, err.Error()), status
+}
+
+func (s *Server) GetScimV2Users(
+	ctx context.Context,
+	req api.GetScimV2UsersRequestObject,
+) (api.GetScimV2UsersResponseObject, error) {
+	if errResp, status := s.scimGuard(ctx); status != 0 {
+		if status == http.StatusNotFound {
+			return api.GetScimV2Users404ApplicationScimPlusJSONResponse(errResp), nil
+		}
+		return api.GetScimV2Users401ApplicationScimPlusJSONResponse(errResp), nil
+	}
+
+	list, err := s.scimService.ListUsers(ctx, req.Params)
+	if err != nil {
+		errResp, status := mapSCIMError(err)
+		switch status {
+		case http.StatusBadRequest:
+			return api.GetScimV2Users400ApplicationScimPlusJSONResponse(errResp), nil
+		default:
+			return nil, err
+		}
+	}
+	return api.GetScimV2Users200ApplicationScimPlusJSONResponse(*list), nil
+}
+
+func (s *Server) PostScimV2Users(
+	ctx context.Context,
+	req api.PostScimV2UsersRequestObject,
+) (api.PostScimV2UsersResponseObject, error) {
+	if errResp, status := s.scimGuard(ctx); status != 0 {
+		if status == http.StatusNotFound {
+			return api.PostScimV2Users404ApplicationScimPlusJSONResponse(errResp), nil
+		}
+		return api.PostScimV2Users401ApplicationScimPlusJSONResponse(errResp), nil
+	}
+
+	if req.Body == nil {
+		errResp := makeSCIMError(http.StatusBadRequest, "invalidSyntax", "request body is required")
+		return api.PostScimV2Users400ApplicationScimPlusJSONResponse(errResp), nil
+	}
+
+	user, err := s.scimService.CreateUser(ctx, *req.Body)
+	if err != nil {
+		errResp, status := mapSCIMError(err)
+		switch status {
+		case http.StatusBadRequest:
+			return api.PostScimV2Users400ApplicationScimPlusJSONResponse(errResp), nil
+		case http.StatusConflict:
+			return api.PostScimV2Users409ApplicationScimPlusJSONResponse(errResp), nil
+		default:
+			return nil, err
+		}
+	}
+	return api.PostScimV2Users201ApplicationScimPlusJSONResponse(*user), nil
+}
+
+func (s *Server) GetScimV2UsersId(
+	ctx context.Context,
+	req api.GetScimV2UsersIdRequestObject,
+) (api.GetScimV2UsersIdResponseObject, error) {
+	if errResp, status := s.scimGuard(ctx); status != 0 {
+		if status == http.StatusNotFound {
+			return api.GetScimV2UsersId404ApplicationScimPlusJSONResponse(errResp), nil
+		}
+		return api.GetScimV2UsersId401ApplicationScimPlusJSONResponse(errResp), nil
+	}
+
+	user, err := s.scimService.GetUser(ctx, req.Id)
+	if err != nil {
+		errResp, status := mapSCIMError(err)
+		if status == http.StatusNotFound {
+			return api.GetScimV2UsersId404ApplicationScimPlusJSONResponse(errResp), nil
+		}
+		return nil, err
+	}
+	return api.GetScimV2UsersId200ApplicationScimPlusJSONResponse(*user), nil
+}
+
+func (s *Server) PutScimV2UsersId(
+	ctx context.Context,
+	req api.PutScimV2UsersIdRequestObject,
+) (api.PutScimV2UsersIdResponseObject, error) {
+	if errResp, status := s.scimGuard(ctx); status != 0 {
+		if status == http.StatusNotFound {
+			return api.PutScimV2UsersId404ApplicationScimPlusJSONResponse(errResp), nil
+		}
+		return api.PutScimV2UsersId401ApplicationScimPlusJSONResponse(errResp), nil
+	}
+
+	if req.Body == nil {
+		errResp := makeSCIMError(http.StatusBadRequest, "invalidSyntax", "request body is required")
+		return api.PutScimV2UsersId400ApplicationScimPlusJSONResponse(errResp), nil
+	}
+
+	user, err := s.scimService.ReplaceUser(ctx, req.Id, *req.Body)
+	if err != nil {
+		errResp, status := mapSCIMError(err)
+		switch status {
+		case http.StatusBadRequest:
+			return api.PutScimV2UsersId400ApplicationScimPlusJSONResponse(errResp), nil
+		case http.StatusConflict:
+			return api.PutScimV2UsersId409ApplicationScimPlusJSONResponse(errResp), nil
+		case http.StatusNotFound:
+			return api.PutScimV2UsersId404ApplicationScimPlusJSONResponse(errResp), nil
+		default:
+			return nil, err
+		}
+	}
+	return api.PutScimV2UsersId200ApplicationScimPlusJSONResponse(*user), nil
+}
+
+func (s *Server) PatchScimV2UsersId(
+	ctx context.Context,
+	req api.PatchScimV2UsersIdRequestObject,
+) (api.PatchScimV2UsersIdResponseObject, error) {
+	if errResp, status := s.scimGuard(ctx); status != 0 {
+		if status == http.StatusNotFound {
+			return api.PatchScimV2UsersId404ApplicationScimPlusJSONResponse(errResp), nil
+		}
+		return api.PatchScimV2UsersId401ApplicationScimPlusJSONResponse(errResp), nil
+	}
+
+	if req.Body == nil {
+		errResp := makeSCIMError(http.StatusBadRequest, "invalidSyntax", "request body is required")
+		return api.PatchScimV2UsersId400ApplicationScimPlusJSONResponse(errResp), nil
+	}
+
+	user, err := s.scimService.PatchUser(ctx, req.Id, *req.Body)
+	if err != nil {
+		errResp, status := mapSCIMError(err)
+		switch status {
+		case http.StatusBadRequest:
+			return api.PatchScimV2UsersId400ApplicationScimPlusJSONResponse(errResp), nil
+		case http.StatusConflict:
+			return api.PatchScimV2UsersId409ApplicationScimPlusJSONResponse(errResp), nil
+		case http.StatusNotFound:
+			return api.PatchScimV2UsersId404ApplicationScimPlusJSONResponse(errResp), nil
+		default:
+			return nil, err
+		}
+	}
+	return api.PatchScimV2UsersId200ApplicationScimPlusJSONResponse(*user), nil
+}
+
+func (s *Server) DeleteScimV2UsersId(
+	ctx context.Context,
+	req api.DeleteScimV2UsersIdRequestObject,
+) (api.DeleteScimV2UsersIdResponseObject, error) {
+	if errResp, status := s.scimGuard(ctx); status != 0 {
+		if status == http.StatusNotFound {
+			return api.DeleteScimV2UsersId404ApplicationScimPlusJSONResponse(errResp), nil
+		}
+		return api.DeleteScimV2UsersId401ApplicationScimPlusJSONResponse(errResp), nil
+	}
+
+	if err := s.scimService.DeactivateUser(ctx, req.Id); err != nil {
+		errResp, status := mapSCIMError(err)
+		if status == http.StatusNotFound {
+			return api.DeleteScimV2UsersId404ApplicationScimPlusJSONResponse(errResp), nil
+		}
+		return nil, err
+	}
+	return api.DeleteScimV2UsersId204Response{}, nil
+}
+
+func (s *Server) GetScimV2Groups(
+	ctx context.Context,
+	req api.GetScimV2GroupsRequestObject,
+) (api.GetScimV2GroupsResponseObject, error) {
+	if errResp, status := s.scimGuard(ctx); status != 0 {
+		if status == http.StatusNotFound {
+			return api.GetScimV2Groups404ApplicationScimPlusJSONResponse(errResp), nil
+		}
+		return api.GetScimV2Groups401ApplicationScimPlusJSONResponse(errResp), nil
+	}
+
+	list, err := s.scimService.ListGroups(ctx, req.Params)
+	if err != nil {
+		errResp, status := mapSCIMError(err)
+		switch status {
+		case http.StatusBadRequest:
+			return api.GetScimV2Groups400ApplicationScimPlusJSONResponse(errResp), nil
+		default:
+			return nil, err
+		}
+	}
+	return api.GetScimV2Groups200ApplicationScimPlusJSONResponse(*list), nil
+}
+
+func (s *Server) PostScimV2Groups(
+	ctx context.Context,
+	req api.PostScimV2GroupsRequestObject,
+) (api.PostScimV2GroupsResponseObject, error) {
+	if errResp, status := s.scimGuard(ctx); status != 0 {
+		if status == http.StatusNotFound {
+			return api.PostScimV2Groups404ApplicationScimPlusJSONResponse(errResp), nil
+		}
+		return api.PostScimV2Groups401ApplicationScimPlusJSONResponse(errResp), nil
+	}
+
+	if req.Body == nil {
+		errResp := makeSCIMError(http.StatusBadRequest, "invalidSyntax", "request body is required")
+		return api.PostScimV2Groups400ApplicationScimPlusJSONResponse(errResp), nil
+	}
+
+	group, err := s.scimService.CreateGroup(ctx, *req.Body)
+	if err != nil {
+		errResp, status := mapSCIMError(err)
+		switch status {
+		case http.StatusBadRequest:
+			return api.PostScimV2Groups400ApplicationScimPlusJSONResponse(errResp), nil
+		case http.StatusConflict:
+			return api.PostScimV2Groups409ApplicationScimPlusJSONResponse(errResp), nil
+		case http.StatusNotFound:
+			return api.PostScimV2Groups404ApplicationScimPlusJSONResponse(errResp), nil
+		default:
+			return nil, err
+		}
+	}
+	return api.PostScimV2Groups201ApplicationScimPlusJSONResponse(*group), nil
+}
+
+func (s *Server) GetScimV2GroupsId(
+	ctx context.Context,
+	req api.GetScimV2GroupsIdRequestObject,
+) (api.GetScimV2GroupsIdResponseObject, error) {
+	if errResp, status := s.scimGuard(ctx); status != 0 {
+		if status == http.StatusNotFound {
+			return api.GetScimV2GroupsId404ApplicationScimPlusJSONResponse(errResp), nil
+		}
+		return api.GetScimV2GroupsId401ApplicationScimPlusJSONResponse(errResp), nil
+	}
+
+	group, err := s.scimService.GetGroup(ctx, req.Id)
+	if err != nil {
+		errResp, status := mapSCIMError(err)
+		if status == http.StatusNotFound {
+			return api.GetScimV2GroupsId404ApplicationScimPlusJSONResponse(errResp), nil
+		}
+		return nil, err
+	}
+	return api.GetScimV2GroupsId200ApplicationScimPlusJSONResponse(*group), nil
+}
+
+func (s *Server) PatchScimV2GroupsId(
+	ctx context.Context,
+	req api.PatchScimV2GroupsIdRequestObject,
+) (api.PatchScimV2GroupsIdResponseObject, error) {
+	if errResp, status := s.scimGuard(ctx); status != 0 {
+		if status == http.StatusNotFound {
+			return api.PatchScimV2GroupsId404ApplicationScimPlusJSONResponse(errResp), nil
+		}
+		return api.PatchScimV2GroupsId401ApplicationScimPlusJSONResponse(errResp), nil
+	}
+
+	if req.Body == nil {
+		errResp := makeSCIMError(http.StatusBadRequest, "invalidSyntax", "request body is required")
+		return api.PatchScimV2GroupsId400ApplicationScimPlusJSONResponse(errResp), nil
+	}
+
+	group, err := s.scimService.PatchGroup(ctx, req.Id, *req.Body)
+	if err != nil {
+		errResp, status := mapSCIMError(err)
+		switch status {
+		case http.StatusBadRequest:
+			return api.PatchScimV2GroupsId400ApplicationScimPlusJSONResponse(errResp), nil
+		case http.StatusNotFound:
+			return api.PatchScimV2GroupsId404ApplicationScimPlusJSONResponse(errResp), nil
+		case http.StatusConflict:
+			return api.PatchScimV2GroupsId409ApplicationScimPlusJSONResponse(errResp), nil
+		default:
+			return nil, err
+		}
+	}
+	return api.PatchScimV2GroupsId200ApplicationScimPlusJSONResponse(*group), nil
+}
+
+func (s *Server) DeleteScimV2GroupsId(
+	ctx context.Context,
+	req api.DeleteScimV2GroupsIdRequestObject,
+) (api.DeleteScimV2GroupsIdResponseObject, error) {
+	if errResp, status := s.scimGuard(ctx); status != 0 {
+		if status == http.StatusNotFound {
+			return api.DeleteScimV2GroupsId404ApplicationScimPlusJSONResponse(errResp), nil
+		}
+		return api.DeleteScimV2GroupsId401ApplicationScimPlusJSONResponse(errResp), nil
+	}
+
+	if err := s.scimService.DeleteGroup(ctx, req.Id); err != nil {
+		errResp, status := mapSCIMError(err)
+		switch status {
+		case http.StatusNotFound:
+			return api.DeleteScimV2GroupsId404ApplicationScimPlusJSONResponse(errResp), nil
+		case http.StatusConflict:
+			return api.DeleteScimV2GroupsId409ApplicationScimPlusJSONResponse(errResp), nil
+		default:
+			return nil, err
+		}
+	}
+	return api.DeleteScimV2GroupsId204Response{}, nil
+}
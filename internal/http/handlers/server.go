@@ -2,41 +2,117 @@ package handlers
 
 import (
 	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/auth"
 	"avito-autumn2025-internship/internal/service"
 	"context"
+	"crypto/subtle"
 	"errors"
 	"net/http"
 	"strings"
 )
 
 type Server struct {
-	prService   service.PRService
-	teamService service.TeamService
-	userService service.UserService
+	prService     service.PRService
+	teamService   service.TeamService
+	userService   service.UserService
+	repoService   service.RepositoryService
+	gitLabService service.GitLabService
+	scimService   service.SCIMService
+	apiKeyService service.APIKeyService
+	jwtVerifier   *auth.Verifier
+	accessService service.AccessService
+	auditService  service.AuditService
 
-	adminToken string
+	adminToken  string
+	gitLabToken string
+	scimToken   string
+	requireAuth bool
 }
 
 var _ api.StrictServerInterface = (*Server)(nil)
 
+// Option подключает к серверу необязательные интеграции.
+type Option func(*Server)
+
+func WithGitLabWebhook(svc service.GitLabService, token string) Option {
+	return func(s *Server) {
+		s.gitLabService = svc
+		s.gitLabToken = token
+	}
+}
+
+func WithSCIM(svc service.SCIMService, token string) Option {
+	return func(s *Server) {
+		s.scimService = svc
+		s.scimToken = token
+	}
+}
+
+// WithAPIKeys включает API-ключи из базы в дополнение к ADMIN_TOKEN.
+func WithAPIKeys(svc service.APIKeyService) Option {
+	return func(s *Server) {
+		s.apiKeyService = svc
+	}
+}
+
+// WithJWT принимает JWT от SSO; авторизация включается и без ADMIN_TOKEN.
+func WithJWT(v *auth.Verifier) Option {
+	return func(s *Server) {
+		s.jwtVerifier = v
+	}
+}
+
+// WithRequireAuth требует токен и для операций, открытых до появления
+// авторизации.
+func WithRequireAuth() Option {
+	return func(s *Server) {
+		s.requireAuth = true
+	}
+}
+
+// WithTeamLeads включает руководителей команд.
+func WithTeamLeads(svc service.AccessService) Option {
+	return func(s *Server) {
+		s.accessService = svc
+	}
+}
+
+// WithAudit записывает изменяющие запросы в журнал аудита.
+func WithAudit(svc service.AuditService) Option {
+	return func(s *Server) {
+		s.auditService = svc
+	}
+}
+
 func NewServer(
 	prSvc service.PRService,
 	teamSvc service.TeamService,
 	userSvc service.UserService,
+	repoSvc service.RepositoryService,
 	adminToken string,
+	opts ...Option,
 ) *Server {
-	return &Server{
+	s := &Server{
 		prService:   prSvc,
 		teamService: teamSvc,
 		userService: userSvc,
+		repoService: repoSvc,
 		adminToken:  adminToken,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+func tokensEqual(got, want string) bool {
+	return subtle.ConstantTimeCompare([]byte(got), []byte(want)) == 1
 }
 
-type adminTokenKey struct{}
+type bearerTokenKey struct{}
 
-func adminTokenFromContext(ctx context.Context) string {
-	v := ctx.Value(adminTokenKey{})
+func bearerTokenFromContext(ctx context.Context) string {
+	v := ctx.Value(bearerTokenKey{})
 	if v == nil {
 		return ""
 	}
@@ -44,7 +120,9 @@ func adminTokenFromContext(ctx context.Context) string {
 	return s
 }
 
-func AdminTokenMiddleware() api.StrictMiddlewareFunc {
+// BearerTokenMiddleware кладёт в контекст токен из заголовка Authorization.
+// Проверяет его AuthMiddleware, а интеграции сверяют со своими токенами.
+func BearerTokenMiddleware() api.StrictMiddlewareFunc {
 	return func(next api.StrictHandlerFunc, operationID string) api.StrictHandlerFunc {
 		return func(
 			ctx context.Context,
@@ -57,7 +135,7 @@ func AdminTokenMiddleware() api.StrictMiddlewareFunc {
 			if strings.HasPrefix(auth, prefix) {
 				token := strings.TrimSpace(auth[len(prefix):])
 				if token != "" {
-					ctx = context.WithValue(ctx, adminTokenKey{}, token)
+					ctx = context.WithValue(ctx, bearerTokenKey{}, token)
 				}
 			}
 			return next(ctx, w, r, request)
@@ -85,14 +163,30 @@ func mapDomainError(err error) (api.ErrorResponseErrorCode, int) {
 		return api.PREXISTS, http.StatusConflict
 	case errors.Is(err, service.ErrPRMerged):
 		return api.PRMERGED, http.StatusConflict
+	case errors.Is(err, service.ErrPRClosed):
+		return api.PRCLOSED, http.StatusConflict
 	case errors.Is(err, service.ErrReviewerNotAssigned):
 		return api.NOTASSIGNED, http.StatusConflict
+	case errors.Is(err, service.ErrUserInOtherTeam):
+		return api.USERINOTHERTEAM, http.StatusConflict
+	case errors.Is(err, service.ErrTeamInUse):
+		return api.TEAMINUSE, http.StatusConflict
+	case errors.Is(err, service.ErrScheduleNotPending):
+		return api.NOTPENDING, http.StatusConflict
+	case errors.Is(err, service.ErrLoginTaken):
+		return api.LOGINTAKEN, http.StatusConflict
+	case errors.Is(err, service.ErrSettingsConflict):
+		return api.VERSIONCONFLICT, http.StatusConflict
 	case errors.Is(err, service.ErrNoCandidate):
 		return api.NOCANDIDATE, http.StatusConflict
 	case errors.Is(err, service.ErrNotFound):
 		return api.NOTFOUND, http.StatusNotFound
+	case errors.Is(err, service.ErrInvalidArgument):
+		return api.BADREQUEST, http.StatusBadRequest
 	case errors.Is(err, service.ErrUnauthorized):
-		return api.NOTFOUND, http.StatusUnauthorized
+		return api.UNAUTHORIZED, http.StatusUnauthorized
+	case errors.Is(err, service.ErrForbidden):
+		return api.FORBIDDEN, http.StatusForbidden
 	default:
 		return api.NOTFOUND, http.StatusInternalServerError
 	}
@@ -2,19 +2,160 @@ package handlers
 
 import (
 	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/repository"
 	"context"
+	"fmt"
+	"net/http"
+	"strings"
 )
 
 func (s *Server) GetStatsReviewerAssignments(
 	ctx context.Context,
-	_ api.GetStatsReviewerAssignmentsRequestObject,
+	req api.GetStatsReviewerAssignmentsRequestObject,
 ) (api.GetStatsReviewerAssignmentsResponseObject, error) {
-	stats, err := s.prService.GetReviewerAssignments(ctx)
+	format, ok := negotiateFormat(ctx, (*string)(req.Params.Format), formatCSV, formatNDJSON)
+	if !ok {
+		return api.GetStatsReviewerAssignments400JSONResponse(makeError(api.BADREQUEST, "unknown format")), nil
+	}
+
+	stats, err := s.prService.GetReviewerAssignments(ctx, req.Params)
 	if err != nil {
-		return nil, err
+		code, status := mapDomainError(err)
+		errResp := makeError(code, err.Error())
+
+		switch status {
+		case http.StatusBadRequest:
+			return api.GetStatsReviewerAssignments400JSONResponse(errResp), nil
+		case http.StatusNotFound:
+			return api.GetStatsReviewerAssignments404JSONResponse(errResp), nil
+		default:
+			return nil, err
+		}
+	}
+
+	// Статистика агрегируется в SQL и невелика, поэтому кодируется из среза.
+	switch format {
+	case formatCSV:
+		body := newExportReader(ctx, repository.NewSliceRows(stats), format, reviewerStatExportHeader, reviewerStatRecord)
+		return api.GetStatsReviewerAssignments200TextcsvResponse{Body: body}, nil
+	case formatNDJSON:
+		body := newExportReader(ctx, repository.NewSliceRows(stats), format, reviewerStatExportHeader, reviewerStatRecord)
+		return api.GetStatsReviewerAssignments200ApplicationxNdjsonResponse{Body: body}, nil
 	}
 
 	return api.GetStatsReviewerAssignments200JSONResponse{
 		Stats: stats,
 	}, nil
 }
+
+func (s *Server) GetStatsTurnaround(
+	ctx context.Context,
+	req api.GetStatsTurnaroundRequestObject,
+) (api.GetStatsTurnaroundResponseObject, error) {
+	format, ok := negotiateFormat(ctx, (*string)(req.Params.Format), formatCSV, formatNDJSON)
+	if !ok {
+		return api.GetStatsTurnaround400JSONResponse(makeError(api.BADREQUEST, "unknown format")), nil
+	}
+
+	stats, err := s.prService.GetTurnaround(ctx, req.Params)
+	if err != nil {
+		code, status := mapDomainError(err)
+		errResp := makeError(code, err.Error())
+
+		if status == http.StatusBadRequest {
+			return api.GetStatsTurnaround400JSONResponse(errResp), nil
+		}
+		return nil, err
+	}
+
+	switch format {
+	case formatCSV:
+		body := newExportReader(ctx, repository.NewSliceRows(stats), format, turnaroundExportHeader, turnaroundRecord)
+		return api.GetStatsTurnaround200TextcsvResponse{Body: body}, nil
+	case formatNDJSON:
+		body := newExportReader(ctx, repository.NewSliceRows(stats), format, turnaroundExportHeader, turnaroundRecord)
+		return api.GetStatsTurnaround200ApplicationxNdjsonResponse{Body: body}, nil
+	}
+
+	return api.GetStatsTurnaround200JSONResponse{
+		Stats: stats,
+	}, nil
+}
+
+func (s *Server) GetStatsFairness(
+	ctx context.Context,
+	req api.GetStatsFairnessRequestObject,
+) (api.GetStatsFairnessResponseObject, error) {
+	teams, err := s.prService.GetFairness(ctx, req.Params)
+	if err != nil {
+		code, status := mapDomainError(err)
+		errResp := makeError(code, err.Error())
+
+		switch status {
+		case http.StatusBadRequest:
+			return api.GetStatsFairness400JSONResponse(errResp), nil
+		case http.StatusNotFound:
+			return api.GetStatsFairness404JSONResponse(errResp), nil
+		default:
+			return nil, err
+		}
+	}
+
+	return api.GetStatsFairness200JSONResponse{
+		Teams: teams,
+	}, nil
+}
+
+func (s *Server) GetStatsReviewGraph(
+	ctx context.Context,
+	req api.GetStatsReviewGraphRequestObject,
+) (api.GetStatsReviewGraphResponseObject, error) {
+	format, ok := negotiateFormat(ctx, (*string)(req.Params.Format), formatDOT)
+	if !ok {
+		return api.GetStatsReviewGraph400JSONResponse(makeError(api.BADREQUEST, "unknown format")), nil
+	}
+
+	graph, err := s.prService.GetReviewGraph(ctx, req.Params)
+	if err != nil {
+		code, status := mapDomainError(err)
+		errResp := makeError(code, err.Error())
+
+		switch status {
+		case http.StatusBadRequest:
+			return api.GetStatsReviewGraph400JSONResponse(errResp), nil
+		case http.StatusNotFound:
+			return api.GetStatsReviewGraph404JSONResponse(errResp), nil
+		default:
+			return nil, err
+		}
+	}
+
+	if format == formatDOT {
+		return api.GetStatsReviewGraph200TextvndGraphvizResponse{
+			Body: strings.NewReader(reviewGraphDOT(graph)),
+		}, nil
+	}
+	return api.GetStatsReviewGraph200JSONResponse(*graph), nil
+}
+
+// reviewGraphDOT рисует граф в Graphviz; рёбра авторов из bus_factor — красные.
+func reviewGraphDOT(graph *api.ReviewGraph) string {
+	single := make(map[string]struct{}, len(graph.BusFactor))
+	for _, hint := range graph.BusFactor {
+		single[hint.AuthorId] = struct{}{}
+	}
+	quote := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+	var b strings.Builder
+	b.WriteString("digraph review_graph {\n")
+	for _, e := range graph.Edges {
+		fmt.Fprintf(&b, "  \"%s\" -> \"%s\" [weight=%d, label=\"%d\"",
+			quote.Replace(e.AuthorId), quote.Replace(e.ReviewerId), e.Weight, e.Weight)
+		if _, ok := single[e.AuthorId]; ok {
+			b.WriteString(", color=red")
+		}
+		b.WriteString("];\n")
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
@@ -16,7 +16,15 @@ func (s *Server) PostTeamAdd(
 		return api.PostTeamAdd400JSONResponse(errResp), nil
 	}
 
-	team, err := s.teamService.AddTeam(ctx, *req.Body)
+	mode := service.MembershipRefuse
+	switch {
+	case req.Params.AllowTeamMove != nil && *req.Params.AllowTeamMove:
+		mode = service.MembershipMove
+	case req.Params.AllowSecondaryTeam != nil && *req.Params.AllowSecondaryTeam:
+		mode = service.MembershipSecondary
+	}
+
+	team, err := s.teamService.AddTeam(ctx, *req.Body, mode)
 	if err != nil {
 		code, status := mapDomainError(err)
 		errResp := makeError(code, req.Body.TeamName+" "+err.Error())
@@ -24,6 +32,10 @@ func (s *Server) PostTeamAdd(
 		switch status {
 		case http.StatusBadRequest:
 			return api.PostTeamAdd400JSONResponse(errResp), nil
+		case http.StatusNotFound:
+			return api.PostTeamAdd404JSONResponse(errResp), nil
+		case http.StatusConflict:
+			return api.PostTeamAdd409JSONResponse(errResp), nil
 		default:
 			return nil, err
 		}
@@ -34,6 +46,24 @@ func (s *Server) PostTeamAdd(
 	}, nil
 }
 
+func (s *Server) GetTeamList(
+	ctx context.Context,
+	req api.GetTeamListRequestObject,
+) (api.GetTeamListResponseObject, error) {
+	page, err := s.teamService.ListTeams(ctx, req.Params)
+	if err != nil {
+		code, status := mapDomainError(err)
+		errResp := makeError(code, err.Error())
+
+		if status == http.StatusBadRequest {
+			return api.GetTeamList400JSONResponse(errResp), nil
+		}
+		return nil, err
+	}
+
+	return api.GetTeamList200JSONResponse(*page), nil
+}
+
 func (s *Server) GetTeamGet(
 	ctx context.Context,
 	req api.GetTeamGetRequestObject,
@@ -64,16 +94,6 @@ func (s *Server) PostTeamMassDeactivate(
 
 	body := req.Body
 
-	if s.adminToken != "" {
-		token := adminTokenFromContext(ctx)
-		if token == "" || token != s.adminToken {
-			err := service.ErrUnauthorized
-			code, _ := mapDomainError(err)
-			errResp := makeError(code, err.Error())
-			return api.PostTeamMassDeactivate401JSONResponse(errResp), nil
-		}
-	}
-
 	result, err := s.userService.MassDeactivateTeamUsers(ctx, body.TeamName, body.UserIds)
 	if err != nil {
 		code, status := mapDomainError(err)
@@ -99,3 +119,297 @@ func (s *Server) PostTeamMassDeactivate(
 		Result: resp,
 	}, nil
 }
+
+func (s *Server) PatchTeamUpdate(
+	ctx context.Context,
+	req api.PatchTeamUpdateRequestObject,
+) (api.PatchTeamUpdateResponseObject, error) {
+	if req.Body == nil {
+		errResp := makeError(api.BADREQUEST, "request body is required")
+		return api.PatchTeamUpdate400JSONResponse(errResp), nil
+	}
+
+	team, reassignment, err := s.teamService.UpdateTeam(ctx, *req.Body)
+	if err != nil {
+		code, status := mapDomainError(err)
+		errResp := makeError(code, err.Error())
+
+		switch status {
+		case http.StatusBadRequest:
+			return api.PatchTeamUpdate400JSONResponse(errResp), nil
+		case http.StatusNotFound:
+			return api.PatchTeamUpdate404JSONResponse(errResp), nil
+		case http.StatusConflict:
+			return api.PatchTeamUpdate409JSONResponse(errResp), nil
+		default:
+			return nil, err
+		}
+	}
+
+	return api.PatchTeamUpdate200JSONResponse{
+		Team:         *team,
+		Reassignment: *reassignment,
+	}, nil
+}
+
+func (s *Server) PostTeamRename(
+	ctx context.Context,
+	req api.PostTeamRenameRequestObject,
+) (api.PostTeamRenameResponseObject, error) {
+	if req.Body == nil {
+		errResp := makeError(api.BADREQUEST, "request body is required")
+		return api.PostTeamRename400JSONResponse(errResp), nil
+	}
+
+	team, err := s.teamService.RenameTeam(ctx, *req.Body)
+	if err != nil {
+		code, status := mapDomainError(err)
+		errResp := makeError(code, err.Error())
+
+		switch status {
+		case http.StatusBadRequest:
+			return api.PostTeamRename400JSONResponse(errResp), nil
+		case http.StatusNotFound:
+			return api.PostTeamRename404JSONResponse(errResp), nil
+		default:
+			return nil, err
+		}
+	}
+
+	return api.PostTeamRename200JSONResponse{
+		Team: *team,
+	}, nil
+}
+
+func (s *Server) PostTeamSetParent(
+	ctx context.Context,
+	req api.PostTeamSetParentRequestObject,
+) (api.PostTeamSetParentResponseObject, error) {
+	if req.Body == nil {
+		errResp := makeError(api.BADREQUEST, "request body is required")
+		return api.PostTeamSetParent400JSONResponse(errResp), nil
+	}
+
+	team, err := s.teamService.SetParent(ctx, *req.Body)
+	if err != nil {
+		code, status := mapDomainError(err)
+		errResp := makeError(code, err.Error())
+
+		switch status {
+		case http.StatusBadRequest:
+			return api.PostTeamSetParent400JSONResponse(errResp), nil
+		case http.StatusNotFound:
+			return api.PostTeamSetParent404JSONResponse(errResp), nil
+		default:
+			return nil, err
+		}
+	}
+
+	return api.PostTeamSetParent200JSONResponse{
+		Team: *team,
+	}, nil
+}
+
+func (s *Server) GetTeamTree(
+	ctx context.Context,
+	req api.GetTeamTreeRequestObject,
+) (api.GetTeamTreeResponseObject, error) {
+	teams, err := s.teamService.GetTree(ctx, req.Params)
+	if err != nil {
+		code, status := mapDomainError(err)
+		errResp := makeError(code, err.Error())
+
+		if status == http.StatusNotFound {
+			return api.GetTeamTree404JSONResponse(errResp), nil
+		}
+		return nil, err
+	}
+
+	return api.GetTeamTree200JSONResponse{
+		Teams: teams,
+	}, nil
+}
+
+func (s *Server) DeleteTeam(
+	ctx context.Context,
+	req api.DeleteTeamRequestObject,
+) (api.DeleteTeamResponseObject, error) {
+	result, err := s.teamService.DeleteTeam(ctx, req.Params)
+	if err != nil {
+		code, status := mapDomainError(err)
+		errResp := makeError(code, err.Error())
+
+		switch status {
+		case http.StatusBadRequest:
+			return api.DeleteTeam400JSONResponse(errResp), nil
+		case http.StatusNotFound:
+			return api.DeleteTeam404JSONResponse(errResp), nil
+		case http.StatusConflict:
+			return api.DeleteTeam409JSONResponse(errResp), nil
+		default:
+			return nil, err
+		}
+	}
+
+	return api.DeleteTeam200JSONResponse{
+		Result: *result,
+	}, nil
+}
+
+func (s *Server) GetTeamSettings(
+	ctx context.Context,
+	req api.GetTeamSettingsRequestObject,
+) (api.GetTeamSettingsResponseObject, error) {
+	settings, err := s.teamService.GetSettings(ctx, req.Params)
+	if err != nil {
+		code, status := mapDomainError(err)
+		errResp := makeError(code, err.Error())
+
+		if status == http.StatusNotFound {
+			return api.GetTeamSettings404JSONResponse(errResp), nil
+		}
+		return nil, err
+	}
+
+	return api.GetTeamSettings200JSONResponse(*settings), nil
+}
+
+func (s *Server) PutTeamSettings(
+	ctx context.Context,
+	req api.PutTeamSettingsRequestObject,
+) (api.PutTeamSettingsResponseObject, error) {
+	if req.Body == nil {
+		errResp := makeError(api.BADREQUEST, "request body is required")
+		return api.PutTeamSettings400JSONResponse(errResp), nil
+	}
+
+	settings, err := s.teamService.UpdateSettings(ctx, *req.Body)
+	if err != nil {
+		code, status := mapDomainError(err)
+		errResp := makeError(code, err.Error())
+
+		switch status {
+		case http.StatusBadRequest:
+			return api.PutTeamSettings400JSONResponse(errResp), nil
+		case http.StatusNotFound:
+			return api.PutTeamSettings404JSONResponse(errResp), nil
+		case http.StatusConflict:
+			return api.PutTeamSettings409JSONResponse(errResp), nil
+		default:
+			return nil, err
+		}
+	}
+
+	return api.PutTeamSettings200JSONResponse(*settings), nil
+}
+
+func (s *Server) GetTeamSettingsHistory(
+	ctx context.Context,
+	req api.GetTeamSettingsHistoryRequestObject,
+) (api.GetTeamSettingsHistoryResponseObject, error) {
+	versions, err := s.teamService.SettingsHistory(ctx, string(req.Params.TeamName))
+	if err != nil {
+		code, status := mapDomainError(err)
+		errResp := makeError(code, err.Error())
+
+		if status == http.StatusNotFound {
+			return api.GetTeamSettingsHistory404JSONResponse(errResp), nil
+		}
+		return nil, err
+	}
+
+	return api.GetTeamSettingsHistory200JSONResponse{
+		Versions: versions,
+	}, nil
+}
+
+func (s *Server) PostTeamSettingsRollback(
+	ctx context.Context,
+	req api.PostTeamSettingsRollbackRequestObject,
+) (api.PostTeamSettingsRollbackResponseObject, error) {
+	if req.Body == nil {
+		errResp := makeError(api.BADREQUEST, "request body is required")
+		return api.PostTeamSettingsRollback400JSONResponse(errResp), nil
+	}
+
+	settings, err := s.teamService.RollbackSettings(ctx, *req.Body)
+	if err != nil {
+		code, status := mapDomainError(err)
+		errResp := makeError(code, err.Error())
+
+		switch status {
+		case http.StatusBadRequest:
+			return api.PostTeamSettingsRollback400JSONResponse(errResp), nil
+		case http.StatusNotFound:
+			return api.PostTeamSettingsRollback404JSONResponse(errResp), nil
+		case http.StatusConflict:
+			return api.PostTeamSettingsRollback409JSONResponse(errResp), nil
+		default:
+			return nil, err
+		}
+	}
+
+	return api.PostTeamSettingsRollback200JSONResponse(*settings), nil
+}
+
+func (s *Server) GetTeamLeads(
+	ctx context.Context,
+	req api.GetTeamLeadsRequestObject,
+) (api.GetTeamLeadsResponseObject, error) {
+	leads, err := s.accessService.ListTeamLeads(ctx, string(req.Params.TeamName))
+	if err != nil {
+		code, status := mapDomainError(err)
+		errResp := makeError(code, err.Error())
+
+		if status == http.StatusNotFound {
+			return api.GetTeamLeads404JSONResponse(errResp), nil
+		}
+		return nil, err
+	}
+
+	return api.GetTeamLeads200JSONResponse{Leads: leads}, nil
+}
+
+func (s *Server) PostTeamLeadsAdd(
+	ctx context.Context,
+	req api.PostTeamLeadsAddRequestObject,
+) (api.PostTeamLeadsAddResponseObject, error) {
+	if req.Body == nil {
+		return nil, service.ErrInvalidArgument
+	}
+
+	lead, err := s.accessService.AddTeamLead(ctx, *req.Body)
+	if err != nil {
+		code, status := mapDomainError(err)
+		errResp := makeError(code, err.Error())
+
+		if status == http.StatusNotFound {
+			return api.PostTeamLeadsAdd404JSONResponse(errResp), nil
+		}
+		return nil, err
+	}
+
+	return api.PostTeamLeadsAdd200JSONResponse{Lead: *lead}, nil
+}
+
+func (s *Server) PostTeamLeadsRemove(
+	ctx context.Context,
+	req api.PostTeamLeadsRemoveRequestObject,
+) (api.PostTeamLeadsRemoveResponseObject, error) {
+	if req.Body == nil {
+		return nil, service.ErrInvalidArgument
+	}
+
+	lead, err := s.accessService.RemoveTeamLead(ctx, *req.Body)
+	if err != nil {
+		code, status := mapDomainError(err)
+		errResp := makeError(code, err.Error())
+
+		if status == http.StatusNotFound {
+			return api.PostTeamLeadsRemove404JSONResponse(errResp), nil
+		}
+		return nil, err
+	}
+
+	return api.PostTeamLeadsRemove200JSONResponse{Lead: *lead}, nil
+}
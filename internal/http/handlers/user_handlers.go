@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/repository"
 	"avito-autumn2025-internship/internal/service"
 	"context"
 	"errors"
@@ -12,16 +13,6 @@ func (s *Server) PostUsersSetIsActive(
 	ctx context.Context,
 	req api.PostUsersSetIsActiveRequestObject,
 ) (api.PostUsersSetIsActiveResponseObject, error) {
-	if s.adminToken != "" {
-		token := adminTokenFromContext(ctx)
-		if token == "" || token != s.adminToken {
-			err := service.ErrUnauthorized
-			code, _ := mapDomainError(err)
-			errResp := makeError(code, err.Error())
-			return api.PostUsersSetIsActive401JSONResponse(errResp), nil
-		}
-	}
-
 	if req.Body == nil {
 		errResp := makeError(api.NOTFOUND, "request body is required")
 		return api.PostUsersSetIsActive404JSONResponse(errResp), nil
@@ -47,12 +38,138 @@ func (s *Server) PostUsersSetIsActive(
 	}, nil
 }
 
+func (s *Server) PostUsersAnonymize(
+	ctx context.Context,
+	req api.PostUsersAnonymizeRequestObject,
+) (api.PostUsersAnonymizeResponseObject, error) {
+	if req.Body == nil {
+		errResp := makeError(api.BADREQUEST, "request body is required")
+		return api.PostUsersAnonymize400JSONResponse(errResp), nil
+	}
+
+	res, err := s.userService.AnonymizeUser(ctx, *req.Body)
+	if err != nil {
+		code, status := mapDomainError(err)
+		errResp := makeError(code, err.Error())
+
+		switch status {
+		case http.StatusBadRequest:
+			return api.PostUsersAnonymize400JSONResponse(errResp), nil
+		case http.StatusNotFound:
+			return api.PostUsersAnonymize404JSONResponse(errResp), nil
+		default:
+			return nil, err
+		}
+	}
+
+	return api.PostUsersAnonymize200JSONResponse(*res), nil
+}
+
+func (s *Server) GetUsersGet(
+	ctx context.Context,
+	req api.GetUsersGetRequestObject,
+) (api.GetUsersGetResponseObject, error) {
+	user, err := s.userService.GetUser(ctx, string(req.Params.UserId))
+	if err != nil {
+		code, status := mapDomainError(err)
+		errResp := makeError(code, err.Error())
+
+		if status == http.StatusNotFound {
+			return api.GetUsersGet404JSONResponse(errResp), nil
+		}
+		return nil, err
+	}
+
+	return api.GetUsersGet200JSONResponse{
+		User: *user,
+	}, nil
+}
+
+func (s *Server) GetUsersDashboard(
+	ctx context.Context,
+	req api.GetUsersDashboardRequestObject,
+) (api.GetUsersDashboardResponseObject, error) {
+	dashboard, err := s.userService.GetDashboard(ctx, string(req.Params.UserId))
+	if err != nil {
+		code, status := mapDomainError(err)
+		errResp := makeError(code, err.Error())
+
+		if status == http.StatusNotFound {
+			return api.GetUsersDashboard404JSONResponse(errResp), nil
+		}
+		return nil, err
+	}
+
+	return api.GetUsersDashboard200JSONResponse(*dashboard), nil
+}
+
+func (s *Server) GetUsersList(
+	ctx context.Context,
+	req api.GetUsersListRequestObject,
+) (api.GetUsersListResponseObject, error) {
+	format, ok := negotiateFormat(ctx, (*string)(req.Params.Format), formatCSV, formatNDJSON)
+	if !ok {
+		return api.GetUsersList400JSONResponse(makeError(api.BADREQUEST, "unknown format")), nil
+	}
+	if format != formatJSON {
+		rows, err := s.userService.ExportUsers(ctx, req.Params)
+		if err != nil {
+			code, status := mapDomainError(err)
+			errResp := makeError(code, err.Error())
+
+			if status == http.StatusBadRequest {
+				return api.GetUsersList400JSONResponse(errResp), nil
+			}
+			return nil, err
+		}
+
+		body := newExportReader(ctx, rows, format, userExportHeader, userRecord)
+		if format == formatCSV {
+			return api.GetUsersList200TextcsvResponse{Body: body}, nil
+		}
+		return api.GetUsersList200ApplicationxNdjsonResponse{Body: body}, nil
+	}
+
+	page, err := s.userService.ListUsers(ctx, req.Params)
+	if err != nil {
+		code, status := mapDomainError(err)
+		errResp := makeError(code, err.Error())
+
+		if status == http.StatusBadRequest {
+			return api.GetUsersList400JSONResponse(errResp), nil
+		}
+		return nil, err
+	}
+
+	return api.GetUsersList200JSONResponse(*page), nil
+}
+
 func (s *Server) GetUsersGetReview(
 	ctx context.Context,
 	req api.GetUsersGetReviewRequestObject,
 ) (api.GetUsersGetReviewResponseObject, error) {
 	userID := string(req.Params.UserId)
 
+	format, ok := negotiateFormat(ctx, (*string)(req.Params.Format), formatCSV, formatNDJSON)
+	if !ok {
+		return api.GetUsersGetReview400JSONResponse(makeError(api.BADREQUEST, "unknown format")), nil
+	}
+	if format != formatJSON {
+		rows, err := s.userService.ExportReviews(ctx, userID)
+		if errors.Is(err, service.ErrNotFound) {
+			rows, err = repository.NewSliceRows[api.PullRequestShort](nil), nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		body := newExportReader(ctx, rows, format, pullRequestShortExportHeader, pullRequestShortRecord)
+		if format == formatCSV {
+			return api.GetUsersGetReview200TextcsvResponse{Body: body}, nil
+		}
+		return api.GetUsersGetReview200ApplicationxNdjsonResponse{Body: body}, nil
+	}
+
 	prs, err := s.userService.GetReviews(ctx, userID)
 	if err != nil {
 		if errors.Is(err, service.ErrNotFound) {
@@ -69,3 +186,132 @@ func (s *Server) GetUsersGetReview(
 		PullRequests: prs,
 	}, nil
 }
+
+func (s *Server) PostUsersLinkExternalAccount(
+	ctx context.Context,
+	req api.PostUsersLinkExternalAccountRequestObject,
+) (api.PostUsersLinkExternalAccountResponseObject, error) {
+	if req.Body == nil {
+		errResp := makeError(api.NOTFOUND, "request body is required")
+		return api.PostUsersLinkExternalAccount404JSONResponse(errResp), nil
+	}
+
+	account, err := s.userService.LinkExternalAccount(ctx, *req.Body)
+	if err != nil {
+		code, status := mapDomainError(err)
+		errResp := makeError(code, err.Error())
+
+		switch status {
+		case http.StatusNotFound:
+			return api.PostUsersLinkExternalAccount404JSONResponse(errResp), nil
+		case http.StatusConflict:
+			return api.PostUsersLinkExternalAccount409JSONResponse(errResp), nil
+		}
+		return nil, err
+	}
+
+	return api.PostUsersLinkExternalAccount200JSONResponse{
+		Account: *account,
+	}, nil
+}
+
+func (s *Server) PostUsersMoveTeam(
+	ctx context.Context,
+	req api.PostUsersMoveTeamRequestObject,
+) (api.PostUsersMoveTeamResponseObject, error) {
+	if req.Body == nil {
+		errResp := makeError(api.BADREQUEST, "request body is required")
+		return api.PostUsersMoveTeam400JSONResponse(errResp), nil
+	}
+
+	result, err := s.teamService.MoveUser(ctx, *req.Body)
+	if err != nil {
+		code, status := mapDomainError(err)
+		errResp := makeError(code, err.Error())
+
+		switch status {
+		case http.StatusBadRequest:
+			return api.PostUsersMoveTeam400JSONResponse(errResp), nil
+		case http.StatusNotFound:
+			return api.PostUsersMoveTeam404JSONResponse(errResp), nil
+		default:
+			return nil, err
+		}
+	}
+
+	return api.PostUsersMoveTeam200JSONResponse{
+		Result: *result,
+	}, nil
+}
+
+func (s *Server) PostUsersScheduleActivation(
+	ctx context.Context,
+	req api.PostUsersScheduleActivationRequestObject,
+) (api.PostUsersScheduleActivationResponseObject, error) {
+	if req.Body == nil {
+		errResp := makeError(api.BADREQUEST, "request body is required")
+		return api.PostUsersScheduleActivation400JSONResponse(errResp), nil
+	}
+
+	schedule, err := s.userService.ScheduleActivation(ctx, *req.Body)
+	if err != nil {
+		code, status := mapDomainError(err)
+		errResp := makeError(code, err.Error())
+
+		switch status {
+		case http.StatusBadRequest:
+			return api.PostUsersScheduleActivation400JSONResponse(errResp), nil
+		case http.StatusNotFound:
+			return api.PostUsersScheduleActivation404JSONResponse(errResp), nil
+		default:
+			return nil, err
+		}
+	}
+
+	return api.PostUsersScheduleActivation201JSONResponse{
+		Schedule: *schedule,
+	}, nil
+}
+
+func (s *Server) GetUsersScheduledActivations(
+	ctx context.Context,
+	req api.GetUsersScheduledActivationsRequestObject,
+) (api.GetUsersScheduledActivationsResponseObject, error) {
+	schedules, err := s.userService.ListScheduledActivations(ctx, req.Params)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.GetUsersScheduledActivations200JSONResponse{
+		Schedules: schedules,
+	}, nil
+}
+
+func (s *Server) PostUsersScheduledActivationsCancel(
+	ctx context.Context,
+	req api.PostUsersScheduledActivationsCancelRequestObject,
+) (api.PostUsersScheduledActivationsCancelResponseObject, error) {
+	if req.Body == nil {
+		errResp := makeError(api.BADREQUEST, "request body is required")
+		return api.PostUsersScheduledActivationsCancel400JSONResponse(errResp), nil
+	}
+
+	schedule, err := s.userService.CancelScheduledActivation(ctx, req.Body.Id)
+	if err != nil {
+		code, status := mapDomainError(err)
+		errResp := makeError(code, err.Error())
+
+		switch status {
+		case http.StatusNotFound:
+			return api.PostUsersScheduledActivationsCancel404JSONResponse(errResp), nil
+		case http.StatusConflict:
+			return api.PostUsersScheduledActivationsCancel409JSONResponse(errResp), nil
+		default:
+			return nil, err
+		}
+	}
+
+	return api.PostUsersScheduledActivationsCancel200JSONResponse{
+		Schedule: *schedule,
+	}, nil
+}
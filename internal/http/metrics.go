@@ -0,0 +1,48 @@
+package http
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/metrics"
+	"context"
+	nethttp "net/http"
+	"time"
+)
+
+// unmatchedOperation — метка запросов, не дошедших до обработчика API (swagger, 404).
+const unmatchedOperation = "unmatched"
+
+type operationKey struct{}
+
+// operationMiddleware сообщает MetricsMiddleware operationId обработчика.
+func operationMiddleware() api.StrictMiddlewareFunc {
+	return func(next api.StrictHandlerFunc, operationID string) api.StrictHandlerFunc {
+		return func(
+			ctx context.Context,
+			w nethttp.ResponseWriter,
+			r *nethttp.Request,
+			request interface{},
+		) (response interface{}, err error) {
+			if op, ok := ctx.Value(operationKey{}).(*string); ok {
+				*op = operationID
+			}
+			return next(ctx, w, r, request)
+		}
+	}
+}
+
+// MetricsMiddleware считает запросы и их длительность по operationId.
+func MetricsMiddleware(m *metrics.Metrics) func(nethttp.Handler) nethttp.Handler {
+	return func(next nethttp.Handler) nethttp.Handler {
+		return nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+			op := unmatchedOperation
+			lrw := &loggingResponseWriter{
+				ResponseWriter: w,
+				statusCode:     nethttp.StatusOK,
+			}
+
+			start := time.Now()
+			next.ServeHTTP(lrw, r.WithContext(context.WithValue(r.Context(), operationKey{}, &op)))
+			m.ObserveRequest(op, lrw.statusCode, time.Since(start))
+		})
+	}
+}
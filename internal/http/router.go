@@ -19,6 +19,11 @@ func (w *loggingResponseWriter) WriteHeader(statusCode int) {
 	w.ResponseWriter.WriteHeader(statusCode)
 }
 
+// Unwrap открывает исходный ResponseWriter для http.ResponseController.
+func (w *loggingResponseWriter) Unwrap() nethttp.ResponseWriter {
+	return w.ResponseWriter
+}
+
 func loggingMiddleware(next nethttp.Handler) nethttp.Handler {
 	return nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
 		lrw := &loggingResponseWriter{
@@ -38,17 +43,23 @@ func NewRouter(
 	prSvc service.PRService,
 	teamSvc service.TeamService,
 	userSvc service.UserService,
+	repoSvc service.RepositoryService,
 	adminToken string,
+	opts ...handlers.Option,
 ) nethttp.Handler {
-	srv := handlers.NewServer(prSvc, teamSvc, userSvc, adminToken)
+	srv := handlers.NewServer(prSvc, teamSvc, userSvc, repoSvc, adminToken, opts...)
 
 	strict := api.NewStrictHandler(srv, []api.StrictMiddlewareFunc{
-		handlers.AdminTokenMiddleware(),
+		srv.AuthMiddleware(),
+		srv.AuditMiddleware(),
+		handlers.BearerTokenMiddleware(),
+		handlers.AcceptMiddleware(),
+		operationMiddleware(),
 	})
 
 	mux := nethttp.NewServeMux()
 	registerSwaggerRoutes(mux)
 
 	handler := api.HandlerFromMux(strict, mux)
-	return loggingMiddleware(handler)
+	return loggingMiddleware(srv.AuditHandler(handler))
 }
@@ -0,0 +1,99 @@
+package metrics
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"github.com/prometheus/client_golang/prometheus"
+	"strconv"
+	"time"
+)
+
+const namespace = "pr_reviewer"
+
+// Metrics — HTTP- и доменные метрики сервиса. Реализует service.DomainMetrics.
+type Metrics struct {
+	requests        *prometheus.CounterVec
+	requestDuration *prometheus.HistogramVec
+
+	prsCreated        prometheus.Counter
+	reviewersAssigned prometheus.Counter
+	reassignments     prometheus.Counter
+	noCandidate       prometheus.Counter
+	massDeactivation  *prometheus.CounterVec
+}
+
+func New(reg prometheus.Registerer) *Metrics {
+	m := &Metrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "http_requests_total",
+			Help:      "HTTP requests by operation ID and status code.",
+		}, []string{"operation", "code"}),
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "HTTP request latency by operation ID.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"operation"}),
+		prsCreated: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "pull_requests_created_total",
+			Help:      "Pull requests created.",
+		}),
+		reviewersAssigned: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "reviewers_assigned_total",
+			Help:      "Reviewers assigned to newly created pull requests.",
+		}),
+		reassignments: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "reviewer_reassignments_total",
+			Help:      "Successful /pullRequest/reassign calls.",
+		}),
+		noCandidate: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "no_candidate_total",
+			Help:      "Reassignments rejected because no replacement candidate was found.",
+		}),
+		massDeactivation: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "mass_deactivation_total",
+			Help:      "Mass deactivation outcomes: deactivated users, reassigned and not reassigned pull requests.",
+		}, []string{"outcome"}),
+	}
+
+	reg.MustRegister(
+		m.requests,
+		m.requestDuration,
+		m.prsCreated,
+		m.reviewersAssigned,
+		m.reassignments,
+		m.noCandidate,
+		m.massDeactivation,
+	)
+	return m
+}
+
+// ObserveRequest учитывает HTTP-запрос; operation — operationId из спецификации.
+func (m *Metrics) ObserveRequest(operation string, code int, duration time.Duration) {
+	m.requests.WithLabelValues(operation, strconv.Itoa(code)).Inc()
+	m.requestDuration.WithLabelValues(operation).Observe(duration.Seconds())
+}
+
+func (m *Metrics) PRCreated(reviewers int) {
+	m.prsCreated.Inc()
+	m.reviewersAssigned.Add(float64(reviewers))
+}
+
+func (m *Metrics) ReviewerReassigned() {
+	m.reassignments.Inc()
+}
+
+func (m *Metrics) NoCandidate() {
+	m.noCandidate.Inc()
+}
+
+func (m *Metrics) MassDeactivation(res api.MassDeactivateResult) {
+	m.massDeactivation.WithLabelValues("deactivated").Add(float64(res.DeactivatedCount))
+	m.massDeactivation.WithLabelValues("reassigned").Add(float64(res.ReassignedCount))
+	m.massDeactivation.WithLabelValues("not_reassigned").Add(float64(res.NotReassignedCount))
+}
@@ -0,0 +1,149 @@
+package postgres
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/repository"
+	"context"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"time"
+)
+
+const activationScheduleColumns = `id, user_id, is_active, effective_at, status, comment, created_at, applied_at, failure_reason`
+
+type activationScheduleRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewActivationScheduleRepository(pool *pgxpool.Pool) repository.ActivationScheduleRepository {
+	return &activationScheduleRepository{pool: pool}
+}
+
+func (r *activationScheduleRepository) Create(
+	ctx context.Context,
+	userID string,
+	isActive bool,
+	effectiveAt time.Time,
+	comment *string,
+) (*api.ScheduledActivation, error) {
+	row := conn(ctx, r.pool).QueryRow(ctx, `
+		INSERT INTO user_activation_schedules (user_id, is_active, effective_at, comment)
+		VALUES ($1, $2, $3, $4)
+		RETURNING `+activationScheduleColumns+`
+	`, userID, isActive, effectiveAt, comment)
+	return scanActivationSchedule(row)
+}
+
+func (r *activationScheduleRepository) Get(ctx context.Context, id int64) (*api.ScheduledActivation, error) {
+	row := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT `+activationScheduleColumns+`
+		FROM user_activation_schedules
+		WHERE id = $1
+	`, id)
+	return scanActivationScheduleOrNil(row)
+}
+
+func (r *activationScheduleRepository) List(
+	ctx context.Context,
+	filter repository.ActivationScheduleFilter,
+) ([]api.ScheduledActivation, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT `+activationScheduleColumns+`
+		FROM user_activation_schedules
+		WHERE ($1::text IS NULL OR user_id = $1)
+		  AND status = $2
+		ORDER BY effective_at, id
+	`, filter.UserID, string(filter.Status))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var schedules []api.ScheduledActivation
+	for rows.Next() {
+		s, err := scanActivationSchedule(rows)
+		if err != nil {
+			return nil, err
+		}
+		schedules = append(schedules, *s)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return schedules, nil
+}
+
+func (r *activationScheduleRepository) Cancel(ctx context.Context, id int64) (*api.ScheduledActivation, error) {
+	row := conn(ctx, r.pool).QueryRow(ctx, `
+		UPDATE user_activation_schedules
+		SET status = 'CANCELLED'
+		WHERE id = $1 AND status = 'PENDING'
+		RETURNING `+activationScheduleColumns+`
+	`, id)
+	return scanActivationScheduleOrNil(row)
+}
+
+// ClaimDue пропускает строки, заблокированные другими экземплярами сервиса.
+func (r *activationScheduleRepository) ClaimDue(ctx context.Context, now time.Time) (*api.ScheduledActivation, error) {
+	row := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT `+activationScheduleColumns+`
+		FROM user_activation_schedules
+		WHERE status = 'PENDING' AND effective_at <= $1
+		ORDER BY effective_at, id
+		LIMIT 1
+		FOR UPDATE SKIP LOCKED
+	`, now)
+	return scanActivationScheduleOrNil(row)
+}
+
+func (r *activationScheduleRepository) MarkApplied(ctx context.Context, id int64, appliedAt time.Time) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		UPDATE user_activation_schedules
+		SET status = 'APPLIED',
+		    applied_at = $2
+		WHERE id = $1
+	`, id, appliedAt)
+	return err
+}
+
+func (r *activationScheduleRepository) MarkFailed(ctx context.Context, id int64, reason string) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		UPDATE user_activation_schedules
+		SET status = 'FAILED',
+		    failure_reason = $2
+		WHERE id = $1
+	`, id, reason)
+	return err
+}
+
+func scanActivationScheduleOrNil(row pgx.Row) (*api.ScheduledActivation, error) {
+	s, err := scanActivationSchedule(row)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return s, nil
+}
+
+func scanActivationSchedule(row pgx.Row) (*api.ScheduledActivation, error) {
+	var s api.ScheduledActivation
+	var status string
+	err := row.Scan(
+		&s.Id,
+		&s.UserId,
+		&s.IsActive,
+		&s.EffectiveAt,
+		&status,
+		&s.Comment,
+		&s.CreatedAt,
+		&s.AppliedAt,
+		&s.FailureReason,
+	)
+	if err != nil {
+		return nil, err
+	}
+	s.Status = api.ScheduledActivationStatus(status)
+	return &s, nil
+}
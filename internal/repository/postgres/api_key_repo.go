@@ -0,0 +1,119 @@
+package postgres
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/repository"
+	"context"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"time"
+)
+
+const apiKeyColumns = `id, name, prefix, scopes, expires_at, last_used_at, revoked_at, created_at`
+
+type apiKeyRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewAPIKeyRepository(pool *pgxpool.Pool) repository.APIKeyRepository {
+	return &apiKeyRepository{pool: pool}
+}
+
+func (r *apiKeyRepository) Create(
+	ctx context.Context,
+	name, prefix, hash string,
+	scopes []api.ApiKeyScope,
+	expiresAt *time.Time,
+) (*api.ApiKey, error) {
+	raw := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		raw = append(raw, string(scope))
+	}
+
+	row := conn(ctx, r.pool).QueryRow(ctx, `
+		INSERT INTO api_keys (name, prefix, key_hash, scopes, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING `+apiKeyColumns+`
+	`, name, prefix, hash, raw, expiresAt)
+	return scanAPIKey(row)
+}
+
+func (r *apiKeyRepository) GetByHash(ctx context.Context, hash string) (*api.ApiKey, error) {
+	row := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT `+apiKeyColumns+`
+		FROM api_keys
+		WHERE key_hash = $1
+	`, hash)
+	return scanAPIKeyOrNil(row)
+}
+
+func (r *apiKeyRepository) List(ctx context.Context, includeRevoked bool) ([]api.ApiKey, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT `+apiKeyColumns+`
+		FROM api_keys
+		WHERE $1 OR revoked_at IS NULL
+		ORDER BY created_at DESC, id DESC
+	`, includeRevoked)
+	if err != nil {
+		return nil, err
+	}
+	return collectRows(newRows(rows, func(rows pgx.Rows) (api.ApiKey, error) {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return api.ApiKey{}, err
+		}
+		return *key, nil
+	}), nil)
+}
+
+func (r *apiKeyRepository) Revoke(ctx context.Context, id int64, at time.Time) (*api.ApiKey, error) {
+	row := conn(ctx, r.pool).QueryRow(ctx, `
+		UPDATE api_keys
+		SET revoked_at = COALESCE(revoked_at, $2)
+		WHERE id = $1
+		RETURNING `+apiKeyColumns+`
+	`, id, at)
+	return scanAPIKeyOrNil(row)
+}
+
+func (r *apiKeyRepository) TouchLastUsed(ctx context.Context, id int64, at time.Time) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		UPDATE api_keys
+		SET last_used_at = $2
+		WHERE id = $1
+	`, id, at)
+	return err
+}
+
+func scanAPIKeyOrNil(row pgx.Row) (*api.ApiKey, error) {
+	key, err := scanAPIKey(row)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return key, nil
+}
+
+func scanAPIKey(row pgx.Row) (*api.ApiKey, error) {
+	var key api.ApiKey
+	var scopes []string
+	err := row.Scan(
+		&key.Id,
+		&key.Name,
+		&key.Prefix,
+		&scopes,
+		&key.ExpiresAt,
+		&key.LastUsedAt,
+		&key.RevokedAt,
+		&key.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, scope := range scopes {
+		key.Scopes = append(key.Scopes, api.ApiKeyScope(scope))
+	}
+	return &key, nil
+}
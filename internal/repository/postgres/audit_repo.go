@@ -0,0 +1,107 @@
+package postgres
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/repository"
+	"context"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type auditRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewAuditRepository(pool *pgxpool.Pool) repository.AuditRepository {
+	return &auditRepository{pool: pool}
+}
+
+func (r *auditRepository) Insert(ctx context.Context, rec api.AuditRecord) error {
+	var actorKind *string
+	if rec.ActorKind != nil {
+		kind := string(*rec.ActorKind)
+		actorKind = &kind
+	}
+	payload := rec.Payload
+	if payload == nil {
+		payload = map[string]interface{}{}
+	}
+
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		INSERT INTO audit_log (
+			occurred_at, operation_id, method, path, status, result,
+			actor_kind, actor, actor_user_id, client_ip, payload
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`,
+		rec.OccurredAt,
+		rec.OperationId,
+		rec.Method,
+		rec.Path,
+		rec.Status,
+		string(rec.Result),
+		actorKind,
+		rec.Actor,
+		rec.ActorUserId,
+		rec.ClientIp,
+		payload,
+	)
+	return err
+}
+
+func (r *auditRepository) List(ctx context.Context, filter repository.AuditFilter) ([]api.AuditRecord, error) {
+	var result *string
+	if filter.Result != nil {
+		v := string(*filter.Result)
+		result = &v
+	}
+
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT id, occurred_at, operation_id, method, path, status, result,
+		       actor_kind, actor, actor_user_id, client_ip, payload
+		FROM audit_log
+		WHERE ($1::text IS NULL OR actor = $1 OR actor_user_id = $1)
+		  AND ($2::text IS NULL OR operation_id = $2)
+		  AND ($3::text IS NULL OR result = $3)
+		  AND ($4::timestamptz IS NULL OR occurred_at >= $4)
+		  AND ($5::timestamptz IS NULL OR occurred_at < $5)
+		  AND ($6::bigint IS NULL OR id < $6)
+		ORDER BY id DESC
+		LIMIT $7
+	`, filter.Actor, filter.OperationID, result, filter.From, filter.To, filter.BeforeID, filter.Limit)
+	if err != nil {
+		return nil, err
+	}
+	return collectRows(newRows(rows, scanAuditRecord), nil)
+}
+
+func scanAuditRecord(rows pgx.Rows) (api.AuditRecord, error) {
+	var (
+		rec       api.AuditRecord
+		result    string
+		actorKind *string
+	)
+	err := rows.Scan(
+		&rec.Id,
+		&rec.OccurredAt,
+		&rec.OperationId,
+		&rec.Method,
+		&rec.Path,
+		&rec.Status,
+		&result,
+		&actorKind,
+		&rec.Actor,
+		&rec.ActorUserId,
+		&rec.ClientIp,
+		&rec.Payload,
+	)
+	if err != nil {
+		return api.AuditRecord{}, err
+	}
+	rec.Result = api.AuditResult(result)
+	if actorKind != nil {
+		kind := api.PrincipalKind(*actorKind)
+		rec.ActorKind = &kind
+	}
+	return rec, nil
+}
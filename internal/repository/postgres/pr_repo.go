@@ -4,6 +4,7 @@ import (
 	"avito-autumn2025-internship/internal/api"
 	"avito-autumn2025-internship/internal/repository"
 	"context"
+	"fmt"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"time"
@@ -18,15 +19,15 @@ func NewPRRepository(pool *pgxpool.Pool) repository.PRRepository {
 }
 
 func (r *prRepository) Create(ctx context.Context, pr *api.PullRequest) error {
-	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	tx, err := conn(ctx, r.pool).Begin(ctx)
 	if err != nil {
 		return err
 	}
 	defer tx.Rollback(ctx)
 
 	_, err = tx.Exec(ctx, `
-		INSERT INTO pull_requests (pull_request_id, pull_request_name, author_id, status, created_at, merged_at)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO pull_requests (pull_request_id, pull_request_name, author_id, status, created_at, merged_at, repository_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
 	`,
 		pr.PullRequestId,
 		pr.PullRequestName,
@@ -34,6 +35,7 @@ func (r *prRepository) Create(ctx context.Context, pr *api.PullRequest) error {
 		string(pr.Status),
 		pr.CreatedAt,
 		pr.MergedAt,
+		pr.Repository,
 	)
 	if err != nil {
 		return err
@@ -62,8 +64,8 @@ func (r *prRepository) Create(ctx context.Context, pr *api.PullRequest) error {
 func (r *prRepository) GetByID(ctx context.Context, prID string) (*api.PullRequest, error) {
 	var pr api.PullRequest
 	var status string
-	err := r.pool.QueryRow(ctx, `
-		SELECT pull_request_id, pull_request_name, author_id, status, created_at, merged_at
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT pull_request_id, pull_request_name, author_id, status, created_at, merged_at, repository_id
 		FROM pull_requests
 		WHERE pull_request_id = $1
 	`, prID).Scan(
@@ -73,6 +75,7 @@ func (r *prRepository) GetByID(ctx context.Context, prID string) (*api.PullReque
 		&status,
 		&pr.CreatedAt,
 		&pr.MergedAt,
+		&pr.Repository,
 	)
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -92,7 +95,7 @@ func (r *prRepository) GetByID(ctx context.Context, prID string) (*api.PullReque
 }
 
 func (r *prRepository) SetMerged(ctx context.Context, prID string, mergedAt time.Time) (*api.PullRequest, error) {
-	_, err := r.pool.Exec(ctx, `
+	_, err := conn(ctx, r.pool).Exec(ctx, `
 		UPDATE pull_requests
 		SET status = 'MERGED',
 		    merged_at = COALESCE(merged_at, $2)
@@ -104,8 +107,20 @@ func (r *prRepository) SetMerged(ctx context.Context, prID string, mergedAt time
 	return r.GetByID(ctx, prID)
 }
 
+func (r *prRepository) SetStatus(ctx context.Context, prID string, status api.PullRequestStatus) (*api.PullRequest, error) {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		UPDATE pull_requests
+		SET status = $2
+		WHERE pull_request_id = $1
+	`, prID, string(status))
+	if err != nil {
+		return nil, err
+	}
+	return r.GetByID(ctx, prID)
+}
+
 func (r *prRepository) ReplaceReviewer(ctx context.Context, prID, oldReviewerID, newReviewerID string) error {
-	_, err := r.pool.Exec(ctx, `
+	_, err := conn(ctx, r.pool).Exec(ctx, `
 		UPDATE pull_request_reviewers
 		SET reviewer_id = $3
 		WHERE pull_request_id = $1 AND reviewer_id = $2
@@ -114,7 +129,7 @@ func (r *prRepository) ReplaceReviewer(ctx context.Context, prID, oldReviewerID,
 }
 
 func (r *prRepository) ListReviewers(ctx context.Context, prID string) ([]string, error) {
-	rows, err := r.pool.Query(ctx, `
+	rows, err := conn(ctx, r.pool).Query(ctx, `
 		SELECT reviewer_id
 		FROM pull_request_reviewers
 		WHERE pull_request_id = $1
@@ -140,7 +155,7 @@ func (r *prRepository) ListReviewers(ctx context.Context, prID string) ([]string
 }
 
 func (r *prRepository) SetReviewers(ctx context.Context, prID string, reviewers []string) error {
-	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	tx, err := conn(ctx, r.pool).Begin(ctx)
 	if err != nil {
 		return err
 	}
@@ -171,7 +186,14 @@ func (r *prRepository) SetReviewers(ctx context.Context, prID string, reviewers
 }
 
 func (r *prRepository) ListShortByReviewer(ctx context.Context, reviewerID string) ([]api.PullRequestShort, error) {
-	rows, err := r.pool.Query(ctx, `
+	return collectRows(r.StreamShortByReviewer(ctx, reviewerID))
+}
+
+func (r *prRepository) StreamShortByReviewer(
+	ctx context.Context,
+	reviewerID string,
+) (repository.Rows[api.PullRequestShort], error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
 		SELECT pr.pull_request_id,
 		       pr.pull_request_name,
 		       pr.author_id,
@@ -185,45 +207,202 @@ func (r *prRepository) ListShortByReviewer(ctx context.Context, reviewerID strin
 	if err != nil {
 		return nil, err
 	}
+	return newRows(rows, scanPullRequestShort), nil
+}
+
+// ListOpenShortByReviewerInTeam считает PR командным, если автор в ней по
+// основной команде или репозиторий PR принадлежит ей.
+func (r *prRepository) ListOpenShortByReviewerInTeam(
+	ctx context.Context,
+	reviewerID, teamName string,
+) ([]api.PullRequestShort, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT pr.pull_request_id,
+		       pr.pull_request_name,
+		       pr.author_id,
+		       pr.status
+		FROM pull_requests pr
+		JOIN pull_request_reviewers r
+		  ON pr.pull_request_id = r.pull_request_id
+		LEFT JOIN users au
+		  ON au.user_id = pr.author_id
+		LEFT JOIN repositories repo
+		  ON repo.repository_id = pr.repository_id
+		WHERE r.reviewer_id = $1
+		  AND pr.status = 'OPEN'
+		  AND (au.team_name = $2 OR repo.team_name = $2)
+		ORDER BY pr.created_at, pr.pull_request_id
+	`, reviewerID, teamName)
+	if err != nil {
+		return nil, err
+	}
+	return collectRows(newRows(rows, scanPullRequestShort), nil)
+}
+
+func scanPullRequestShort(rows pgx.Rows) (api.PullRequestShort, error) {
+	var item api.PullRequestShort
+	var status string
+	if err := rows.Scan(
+		&item.PullRequestId,
+		&item.PullRequestName,
+		&item.AuthorId,
+		&status,
+	); err != nil {
+		return item, err
+	}
+	item.Status = api.PullRequestShortStatus(status)
+	return item, nil
+}
+
+// GetReviewerAssignmentsStats считает назначения одним запросом: при группировке
+// по команде строка ревьювера размножается по его командам, по команде назначения
+// каждое назначение попадает в одну команду, по неделе — по неделям создания PR.
+func (r *prRepository) GetReviewerAssignmentsStats(
+	ctx context.Context,
+	filter repository.StatsFilter,
+) ([]repository.ReviewerAssignmentsStat, error) {
+	var status *string
+	if filter.Status != nil {
+		v := string(*filter.Status)
+		status = &v
+	}
+
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT r.reviewer_id,
+		       COALESCE(tm.team_name, st.team_name) AS team_name,
+		       CASE WHEN $6 = 'week' THEN date_trunc('week', pr.created_at AT TIME ZONE 'UTC') END AS week_start,
+		       COUNT(*) AS cnt
+		FROM pull_request_reviewers r
+		JOIN pull_requests pr
+		  ON pr.pull_request_id = r.pull_request_id
+		LEFT JOIN team_members tm
+		  ON $6 = 'team'
+		 AND tm.user_id = r.reviewer_id
+		 AND ($2::text[] IS NULL OR tm.team_name = ANY($2))
+		LEFT JOIN LATERAL (
+		    SELECT COALESCE(
+		        (SELECT m.team_name
+		         FROM users au
+		         JOIN team_members m
+		           ON m.team_name = au.team_name AND m.user_id = r.reviewer_id
+		         WHERE au.user_id = pr.author_id),
+		        (SELECT ru.team_name FROM users ru WHERE ru.user_id = r.reviewer_id)
+		    ) AS team_name
+		) st ON $6 = 'assignment_team'
+		WHERE ($1::text IS NULL OR pr.repository_id = $1)
+		  AND ($2::text[] IS NULL OR CASE
+		      WHEN $6 = 'assignment_team' THEN st.team_name = ANY($2)
+		      ELSE EXISTS (
+		          SELECT 1
+		          FROM team_members tm
+		          WHERE tm.user_id = r.reviewer_id AND tm.team_name = ANY($2)
+		      )
+		  END)
+		  AND ($3::timestamptz IS NULL OR pr.created_at >= $3)
+		  AND ($4::timestamptz IS NULL OR pr.created_at < $4)
+		  AND ($5::text IS NULL OR pr.status = $5)
+		GROUP BY 1, 2, 3
+		ORDER BY 2, 3, 1
+	`, filter.Repository, filter.Teams, filter.From, filter.To, status, string(filter.GroupBy))
+	if err != nil {
+		return nil, err
+	}
 	defer rows.Close()
 
-	var result []api.PullRequestShort
+	var res []repository.ReviewerAssignmentsStat
 	for rows.Next() {
-		var item api.PullRequestShort
-		var status string
-		if err := rows.Scan(
-			&item.PullRequestId,
-			&item.PullRequestName,
-			&item.AuthorId,
-			&status,
-		); err != nil {
+		var s repository.ReviewerAssignmentsStat
+		if err := rows.Scan(&s.UserID, &s.TeamName, &s.WeekStart, &s.Count); err != nil {
 			return nil, err
 		}
-		item.Status = api.PullRequestShortStatus(status)
-		result = append(result, item)
+		res = append(res, s)
 	}
 	if rows.Err() != nil {
 		return nil, rows.Err()
 	}
-	return result, nil
+	return res, nil
 }
 
-func (r *prRepository) GetReviewerAssignmentsStats(ctx context.Context) ([]repository.ReviewerAssignmentsStat, error) {
-	rows, err := r.pool.Query(ctx, `
-		SELECT reviewer_id, COUNT(*) AS cnt
-		FROM pull_request_reviewers
-		GROUP BY reviewer_id
-		ORDER BY reviewer_id
-	`)
+func (r *prRepository) CountOpenAssignments(ctx context.Context, reviewerIDs []string) (map[string]int64, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT r.reviewer_id, COUNT(*) AS cnt
+		FROM pull_request_reviewers r
+		JOIN pull_requests pr
+		  ON pr.pull_request_id = r.pull_request_id
+		WHERE pr.status = 'OPEN' AND r.reviewer_id = ANY($1)
+		GROUP BY r.reviewer_id
+	`, reviewerIDs)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var res []repository.ReviewerAssignmentsStat
+	res := make(map[string]int64, len(reviewerIDs))
 	for rows.Next() {
-		var s repository.ReviewerAssignmentsStat
-		if err := rows.Scan(&s.UserID, &s.Count); err != nil {
+		var id string
+		var cnt int64
+		if err := rows.Scan(&id, &cnt); err != nil {
+			return nil, err
+		}
+		res[id] = cnt
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return res, nil
+}
+
+// GetTurnaroundStats считает перцентили в Postgres (percentile_cont), чтобы не
+// выгружать все PR в память.
+func (r *prRepository) GetTurnaroundStats(
+	ctx context.Context,
+	filter repository.TurnaroundFilter,
+) ([]repository.TurnaroundStat, error) {
+	var key, join string
+	switch filter.GroupBy {
+	case repository.StatsGroupByTeam:
+		key = "u.team_name"
+		join = "JOIN users u ON u.user_id = m.author_id AND u.team_name IS NOT NULL"
+	case repository.StatsGroupByAuthor:
+		key = "m.author_id"
+	case repository.StatsGroupByReviewer:
+		key = "r.reviewer_id"
+		join = "JOIN pull_request_reviewers r ON r.pull_request_id = m.pull_request_id"
+	default:
+		return nil, fmt.Errorf("unsupported turnaround grouping %q", filter.GroupBy)
+	}
+
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		WITH merged AS (
+		    SELECT pr.pull_request_id,
+		           pr.author_id,
+		           EXTRACT(EPOCH FROM pr.merged_at - pr.created_at)::float8 AS seconds
+		    FROM pull_requests pr
+		    WHERE pr.status = 'MERGED'
+		      AND pr.merged_at IS NOT NULL
+		      AND ($1::timestamptz IS NULL OR pr.merged_at >= $1)
+		      AND ($2::timestamptz IS NULL OR pr.merged_at < $2)
+		)
+		SELECT `+key+` AS key,
+		       COUNT(*),
+		       percentile_cont(0.5) WITHIN GROUP (ORDER BY m.seconds),
+		       percentile_cont(0.9) WITHIN GROUP (ORDER BY m.seconds),
+		       AVG(m.seconds)
+		FROM merged m
+		`+join+`
+		GROUP BY key
+		ORDER BY key
+	`, filter.From, filter.To)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []repository.TurnaroundStat
+	for rows.Next() {
+		var s repository.TurnaroundStat
+		err := rows.Scan(&s.Key, &s.MergedCount, &s.MedianSeconds, &s.P90Seconds, &s.MeanSeconds)
+		if err != nil {
 			return nil, err
 		}
 		res = append(res, s)
@@ -233,3 +412,91 @@ func (r *prRepository) GetReviewerAssignmentsStats(ctx context.Context) ([]repos
 	}
 	return res, nil
 }
+
+func (r *prRepository) GetReviewGraph(
+	ctx context.Context,
+	filter repository.ReviewGraphFilter,
+) ([]repository.ReviewEdge, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT pr.author_id, r.reviewer_id, COUNT(*) AS cnt
+		FROM pull_request_reviewers r
+		JOIN pull_requests pr
+		  ON pr.pull_request_id = r.pull_request_id
+		WHERE ($1::text[] IS NULL OR EXISTS (
+		      SELECT 1
+		      FROM team_members tm
+		      WHERE tm.user_id = pr.author_id AND tm.team_name = ANY($1)
+		  ))
+		  AND ($2::timestamptz IS NULL OR pr.created_at >= $2)
+		  AND ($3::timestamptz IS NULL OR pr.created_at < $3)
+		GROUP BY pr.author_id, r.reviewer_id
+		ORDER BY cnt DESC, pr.author_id, r.reviewer_id
+	`, filter.AuthorTeams, filter.From, filter.To)
+	if err != nil {
+		return nil, err
+	}
+	return collectRows(newRows(rows, func(rows pgx.Rows) (repository.ReviewEdge, error) {
+		var e repository.ReviewEdge
+		err := rows.Scan(&e.AuthorID, &e.ReviewerID, &e.Count)
+		return e, err
+	}), nil)
+}
+
+func (r *prRepository) ListOpenByReviewer(ctx context.Context, reviewerID string) ([]api.PullRequest, error) {
+	return r.listOpen(ctx, `EXISTS (
+		    SELECT 1 FROM pull_request_reviewers r
+		    WHERE r.pull_request_id = pr.pull_request_id AND r.reviewer_id = $1)`, reviewerID)
+}
+
+func (r *prRepository) ListOpenByAuthor(ctx context.Context, authorID string) ([]api.PullRequest, error) {
+	return r.listOpen(ctx, "pr.author_id = $1", authorID)
+}
+
+// listOpen выбирает открытые PR по условию с единственным параметром $1
+// вместе с ревьюверами, от самых старых.
+func (r *prRepository) listOpen(ctx context.Context, cond string, arg string) ([]api.PullRequest, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.status,
+		       pr.created_at, pr.merged_at, pr.repository_id,
+		       ARRAY(SELECT r.reviewer_id FROM pull_request_reviewers r
+		             WHERE r.pull_request_id = pr.pull_request_id ORDER BY r.reviewer_id)
+		FROM pull_requests pr
+		WHERE pr.status = 'OPEN' AND `+cond+`
+		ORDER BY pr.created_at, pr.pull_request_id
+	`, arg)
+	if err != nil {
+		return nil, err
+	}
+	return collectRows(newRows(rows, func(rows pgx.Rows) (api.PullRequest, error) {
+		var pr api.PullRequest
+		var status string
+		err := rows.Scan(
+			&pr.PullRequestId,
+			&pr.PullRequestName,
+			&pr.AuthorId,
+			&status,
+			&pr.CreatedAt,
+			&pr.MergedAt,
+			&pr.Repository,
+			&pr.AssignedReviewers,
+		)
+		pr.Status = api.PullRequestStatus(status)
+		return pr, err
+	}), nil)
+}
+
+func (r *prRepository) CountAssignments(
+	ctx context.Context,
+	reviewerID string,
+	since time.Time,
+) (total, recent int64, err error) {
+	err = conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT COUNT(*),
+		       COUNT(*) FILTER (WHERE pr.created_at >= $2)
+		FROM pull_request_reviewers r
+		JOIN pull_requests pr
+		  ON pr.pull_request_id = r.pull_request_id
+		WHERE r.reviewer_id = $1
+	`, reviewerID, since).Scan(&total, &recent)
+	return total, recent, err
+}
@@ -0,0 +1,96 @@
+package postgres
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/repository"
+	"context"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type repoRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewRepoRepository(pool *pgxpool.Pool) repository.RepoRepository {
+	return &repoRepository{pool: pool}
+}
+
+func (r *repoRepository) Upsert(ctx context.Context, repo api.Repository) (*api.Repository, error) {
+	var strategyArg *string
+	if repo.AssignmentStrategy != nil {
+		v := string(*repo.AssignmentStrategy)
+		strategyArg = &v
+	}
+
+	var res api.Repository
+	var strategy *string
+	var source string
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		INSERT INTO repositories (repository_id, team_name, reviewer_count, assignment_strategy, reviewer_source)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (repository_id) DO UPDATE
+		    SET team_name = EXCLUDED.team_name,
+		        reviewer_count = EXCLUDED.reviewer_count,
+		        assignment_strategy = EXCLUDED.assignment_strategy,
+		        reviewer_source = EXCLUDED.reviewer_source
+		RETURNING repository_id, team_name, reviewer_count, assignment_strategy, reviewer_source
+	`,
+		repo.RepositoryId,
+		repo.TeamName,
+		repo.ReviewerCount,
+		strategyArg,
+		string(repo.ReviewerSource),
+	).Scan(&res.RepositoryId, &res.TeamName, &res.ReviewerCount, &strategy, &source)
+	if err != nil {
+		return nil, err
+	}
+	fillRepositoryEnums(&res, strategy, source)
+	return &res, nil
+}
+
+func (r *repoRepository) GetByID(ctx context.Context, repositoryID string) (*api.Repository, error) {
+	var res api.Repository
+	var strategy *string
+	var source string
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT repository_id, team_name, reviewer_count, assignment_strategy, reviewer_source
+		FROM repositories
+		WHERE repository_id = $1
+	`, repositoryID).Scan(&res.RepositoryId, &res.TeamName, &res.ReviewerCount, &strategy, &source)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	fillRepositoryEnums(&res, strategy, source)
+	return &res, nil
+}
+
+func (r *repoRepository) CountByTeam(ctx context.Context, teamName string) (int64, error) {
+	var cnt int64
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT COUNT(*)
+		FROM repositories
+		WHERE team_name = $1
+	`, teamName).Scan(&cnt)
+	return cnt, err
+}
+
+func (r *repoRepository) TransferTeam(ctx context.Context, fromTeam, toTeam string) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		UPDATE repositories
+		SET team_name = $2
+		WHERE team_name = $1
+	`, fromTeam, toTeam)
+	return err
+}
+
+func fillRepositoryEnums(repo *api.Repository, strategy *string, source string) {
+	if strategy != nil {
+		s := api.AssignmentStrategy(*strategy)
+		repo.AssignmentStrategy = &s
+	}
+	repo.ReviewerSource = api.RepositoryReviewerSource(source)
+}
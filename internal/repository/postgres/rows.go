@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"avito-autumn2025-internship/internal/repository"
+	"github.com/jackc/pgx/v5"
+)
+
+// pgxRows сканирует строки pgx по одной; соединение освобождается в Close
+// или после последней строки.
+type pgxRows[T any] struct {
+	rows pgx.Rows
+	scan func(pgx.Rows) (T, error)
+	cur  T
+	err  error
+}
+
+func newRows[T any](rows pgx.Rows, scan func(pgx.Rows) (T, error)) repository.Rows[T] {
+	return &pgxRows[T]{rows: rows, scan: scan}
+}
+
+func (r *pgxRows[T]) Next() bool {
+	if r.err != nil || !r.rows.Next() {
+		return false
+	}
+	r.cur, r.err = r.scan(r.rows)
+	if r.err != nil {
+		r.rows.Close()
+		return false
+	}
+	return true
+}
+
+func (r *pgxRows[T]) Value() T {
+	return r.cur
+}
+
+func (r *pgxRows[T]) Err() error {
+	if r.err != nil {
+		return r.err
+	}
+	return r.rows.Err()
+}
+
+func (r *pgxRows[T]) Close() {
+	r.rows.Close()
+}
+
+// collectRows дочитывает Rows в срез.
+func collectRows[T any](rows repository.Rows[T], err error) ([]T, error) {
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []T
+	for rows.Next() {
+		res = append(res, rows.Value())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,88 @@
+package postgres
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/repository"
+	"context"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type teamLeadRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewTeamLeadRepository(pool *pgxpool.Pool) repository.TeamLeadRepository {
+	return &teamLeadRepository{pool: pool}
+}
+
+func (r *teamLeadRepository) List(ctx context.Context, teamName string) ([]api.TeamLead, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT team_name, user_id, granted_at
+		FROM team_leads
+		WHERE team_name = $1
+		ORDER BY user_id
+	`, teamName)
+	if err != nil {
+		return nil, err
+	}
+	return collectRows(newRows(rows, func(rows pgx.Rows) (api.TeamLead, error) {
+		lead, err := scanTeamLead(rows)
+		if err != nil {
+			return api.TeamLead{}, err
+		}
+		return *lead, nil
+	}), nil)
+}
+
+func (r *teamLeadRepository) Add(ctx context.Context, teamName, userID string) (*api.TeamLead, error) {
+	row := conn(ctx, r.pool).QueryRow(ctx, `
+		INSERT INTO team_leads (team_name, user_id)
+		VALUES ($1, $2)
+		ON CONFLICT (team_name, user_id) DO UPDATE
+			SET granted_at = team_leads.granted_at
+		RETURNING team_name, user_id, granted_at
+	`, teamName, userID)
+	return scanTeamLead(row)
+}
+
+func (r *teamLeadRepository) Remove(ctx context.Context, teamName, userID string) (*api.TeamLead, error) {
+	row := conn(ctx, r.pool).QueryRow(ctx, `
+		DELETE FROM team_leads
+		WHERE team_name = $1 AND user_id = $2
+		RETURNING team_name, user_id, granted_at
+	`, teamName, userID)
+	lead, err := scanTeamLead(row)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return lead, nil
+}
+
+func (r *teamLeadRepository) ListTeamsByUser(ctx context.Context, userID string) ([]string, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT team_name
+		FROM team_leads
+		WHERE user_id = $1
+		ORDER BY team_name
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+	return collectRows(newRows(rows, func(rows pgx.Rows) (string, error) {
+		var team string
+		err := rows.Scan(&team)
+		return team, err
+	}), nil)
+}
+
+func scanTeamLead(row pgx.Row) (*api.TeamLead, error) {
+	var lead api.TeamLead
+	if err := row.Scan(&lead.TeamName, &lead.UserId, &lead.GrantedAt); err != nil {
+		return nil, err
+	}
+	return &lead, nil
+}
@@ -1,11 +1,24 @@
 package postgres
 
 import (
+	"avito-autumn2025-internship/internal/api"
 	"avito-autumn2025-internship/internal/repository"
 	"context"
+	"encoding/json"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// latestTeamSettingsJoin подтягивает к команде t последнюю версию её настроек —
+// ту же, что возвращает teamSettingsRepository.Get.
+const latestTeamSettingsJoin = `LEFT JOIN LATERAL (
+		    SELECT ts.settings
+		    FROM team_settings ts
+		    WHERE ts.team_name = t.team_name
+		    ORDER BY ts.version DESC
+		    LIMIT 1
+		) s ON true`
+
 type teamRepository struct {
 	pool *pgxpool.Pool
 }
@@ -15,7 +28,7 @@ func NewTeamRepository(pool *pgxpool.Pool) repository.TeamRepository {
 }
 
 func (r *teamRepository) Create(ctx context.Context, teamName string) error {
-	_, err := r.pool.Exec(ctx, `
+	_, err := conn(ctx, r.pool).Exec(ctx, `
 		INSERT INTO teams (team_name) 
 		VALUES ($1)
 	`, teamName)
@@ -24,7 +37,7 @@ func (r *teamRepository) Create(ctx context.Context, teamName string) error {
 
 func (r *teamRepository) Exists(ctx context.Context, teamName string) (bool, error) {
 	var exists bool
-	err := r.pool.QueryRow(ctx, `
+	err := conn(ctx, r.pool).QueryRow(ctx, `
 		SELECT EXISTS(SELECT 1 FROM teams WHERE team_name = $1)
 	`, teamName).Scan(&exists)
 	if err != nil {
@@ -32,3 +45,196 @@ func (r *teamRepository) Exists(ctx context.Context, teamName string) (bool, err
 	}
 	return exists, nil
 }
+
+func (r *teamRepository) Rename(ctx context.Context, teamName, newTeamName string) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		UPDATE teams
+		SET team_name = $2
+		WHERE team_name = $1
+	`, teamName, newTeamName)
+	return err
+}
+
+// Delete удаляет команду; вложенные команды переходят к её родителю.
+func (r *teamRepository) Delete(ctx context.Context, teamName string) error {
+	tx, err := conn(ctx, r.pool).Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `
+		UPDATE teams c
+		SET parent_team_name = p.parent_team_name
+		FROM teams p
+		WHERE p.team_name = $1 AND c.parent_team_name = p.team_name
+	`, teamName)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `
+		DELETE FROM teams
+		WHERE team_name = $1
+	`, teamName)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *teamRepository) List(ctx context.Context, filter repository.TeamFilter) ([]api.TeamSummary, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT t.team_name, t.parent_team_name, t.created_at,
+		       (SELECT COUNT(*)
+		        FROM team_members tm
+		        WHERE tm.team_name = t.team_name),
+		       (SELECT COUNT(*)
+		        FROM team_members tm
+		        JOIN users u ON u.user_id = tm.user_id
+		        WHERE tm.team_name = t.team_name AND u.is_active),
+		       (SELECT COUNT(*)
+		        FROM pull_requests pr
+		        JOIN users a ON a.user_id = pr.author_id
+		        WHERE a.team_name = t.team_name AND pr.status = 'OPEN')
+		FROM teams t
+		WHERE ($1 = '' OR t.team_name LIKE $1 || '%')
+		  AND ($2 = '' OR t.team_name > $2)
+		ORDER BY t.team_name
+		LIMIT $3
+	`, escapeLike(filter.Prefix), filter.After, filter.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var teams []api.TeamSummary
+	for rows.Next() {
+		var t api.TeamSummary
+		err := rows.Scan(
+			&t.TeamName,
+			&t.ParentTeamName,
+			&t.CreatedAt,
+			&t.MemberCount,
+			&t.ActiveMemberCount,
+			&t.OpenPrCount,
+		)
+		if err != nil {
+			return nil, err
+		}
+		teams = append(teams, t)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return teams, nil
+}
+
+func (r *teamRepository) Get(ctx context.Context, teamName string) (*repository.TeamNode, error) {
+	row := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT t.team_name, t.parent_team_name, s.settings, 0
+		FROM teams t
+		`+latestTeamSettingsJoin+`
+		WHERE t.team_name = $1
+	`, teamName)
+
+	node, err := scanTeamNode(row)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return node, nil
+}
+
+func (r *teamRepository) SetParent(ctx context.Context, teamName string, parent *string) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		UPDATE teams
+		SET parent_team_name = $2
+		WHERE team_name = $1
+	`, teamName, parent)
+	return err
+}
+
+func (r *teamRepository) Ancestors(ctx context.Context, teamName string) ([]repository.TeamNode, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		WITH RECURSIVE chain AS (
+		    SELECT team_name, parent_team_name, 0 AS depth
+		    FROM teams
+		    WHERE team_name = $1
+		    UNION ALL
+		    SELECT t.team_name, t.parent_team_name, c.depth + 1
+		    FROM teams t
+		    JOIN chain c ON t.team_name = c.parent_team_name
+		    WHERE c.depth < $2
+		)
+		SELECT t.team_name, t.parent_team_name, s.settings, t.depth
+		FROM chain t
+		`+latestTeamSettingsJoin+`
+		ORDER BY t.depth
+	`, teamName, maxTeamDepth)
+	if err != nil {
+		return nil, err
+	}
+	return collectTeamNodes(rows)
+}
+
+func (r *teamRepository) Subtree(ctx context.Context, teamName string) ([]repository.TeamNode, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		WITH RECURSIVE tree AS (
+		    SELECT team_name, parent_team_name, 0 AS depth
+		    FROM teams
+		    WHERE ($1 = '' AND parent_team_name IS NULL) OR team_name = $1
+		    UNION ALL
+		    SELECT t.team_name, t.parent_team_name, tr.depth + 1
+		    FROM teams t
+		    JOIN tree tr ON t.parent_team_name = tr.team_name
+		    WHERE tr.depth < $2
+		)
+		SELECT t.team_name, t.parent_team_name, s.settings, t.depth
+		FROM tree t
+		`+latestTeamSettingsJoin+`
+		ORDER BY t.depth, t.team_name
+	`, teamName, maxTeamDepth)
+	if err != nil {
+		return nil, err
+	}
+	return collectTeamNodes(rows)
+}
+
+// maxTeamDepth ограничивает рекурсивные запросы на случай цикла в данных.
+const maxTeamDepth = 32
+
+func collectTeamNodes(rows pgx.Rows) ([]repository.TeamNode, error) {
+	defer rows.Close()
+
+	var nodes []repository.TeamNode
+	for rows.Next() {
+		node, err := scanTeamNode(rows)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, *node)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return nodes, nil
+}
+
+func scanTeamNode(row pgx.Row) (*repository.TeamNode, error) {
+	var node repository.TeamNode
+	var settings []byte
+	err := row.Scan(&node.Name, &node.Parent, &settings, &node.Depth)
+	if err != nil {
+		return nil, err
+	}
+	if settings != nil {
+		if err := json.Unmarshal(settings, &node.Policy); err != nil {
+			return nil, err
+		}
+	}
+	return &node, nil
+}
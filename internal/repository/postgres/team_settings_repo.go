@@ -0,0 +1,121 @@
+package postgres
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/repository"
+	"context"
+	"encoding/json"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const teamSettingsColumns = `team_name, version, settings, created_at, comment`
+
+type teamSettingsRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewTeamSettingsRepository(pool *pgxpool.Pool) repository.TeamSettingsRepository {
+	return &teamSettingsRepository{pool: pool}
+}
+
+func (r *teamSettingsRepository) Get(ctx context.Context, teamName string) (*api.TeamSettingsVersion, error) {
+	row := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT `+teamSettingsColumns+`
+		FROM team_settings
+		WHERE team_name = $1
+		ORDER BY version DESC
+		LIMIT 1
+	`, teamName)
+	return scanTeamSettingsOrNil(row)
+}
+
+func (r *teamSettingsRepository) GetVersion(
+	ctx context.Context,
+	teamName string,
+	version int,
+) (*api.TeamSettingsVersion, error) {
+	row := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT `+teamSettingsColumns+`
+		FROM team_settings
+		WHERE team_name = $1 AND version = $2
+	`, teamName, version)
+	return scanTeamSettingsOrNil(row)
+}
+
+func (r *teamSettingsRepository) ListVersions(ctx context.Context, teamName string) ([]api.TeamSettingsVersion, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT `+teamSettingsColumns+`
+		FROM team_settings
+		WHERE team_name = $1
+		ORDER BY version DESC
+	`, teamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var versions []api.TeamSettingsVersion
+	for rows.Next() {
+		v, err := scanTeamSettings(rows)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, *v)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return versions, nil
+}
+
+// Save вставляет версию MAX(version)+1. Если текущая версия не совпала с expectedVersion
+// или ту же версию успел вставить параллельный запрос, строка не вставляется.
+func (r *teamSettingsRepository) Save(
+	ctx context.Context,
+	teamName string,
+	settings api.TeamPolicy,
+	expectedVersion *int,
+	comment *string,
+) (*api.TeamSettingsVersion, error) {
+	raw, err := json.Marshal(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	row := conn(ctx, r.pool).QueryRow(ctx, `
+		INSERT INTO team_settings (team_name, version, settings, comment)
+		SELECT $1, cur.version + 1, $2::jsonb, $3
+		FROM (SELECT COALESCE(MAX(version), 0) AS version
+		      FROM team_settings
+		      WHERE team_name = $1) cur
+		WHERE $4::int IS NULL OR cur.version = $4
+		ON CONFLICT (team_name, version) DO NOTHING
+		RETURNING `+teamSettingsColumns+`
+	`, teamName, string(raw), comment, expectedVersion)
+	return scanTeamSettingsOrNil(row)
+}
+
+func scanTeamSettingsOrNil(row pgx.Row) (*api.TeamSettingsVersion, error) {
+	v, err := scanTeamSettings(row)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return v, nil
+}
+
+func scanTeamSettings(row pgx.Row) (*api.TeamSettingsVersion, error) {
+	var v api.TeamSettingsVersion
+	var settings []byte
+	err := row.Scan(&v.TeamName, &v.Version, &settings, &v.CreatedAt, &v.Comment)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(settings, &v.Settings); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
@@ -4,8 +4,13 @@ import (
 	"avito-autumn2025-internship/internal/api"
 	"avito-autumn2025-internship/internal/repository"
 	"context"
+	"errors"
+	"fmt"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"strings"
+	"time"
 )
 
 type userRepository struct {
@@ -21,7 +26,7 @@ func (r *userRepository) UpsertTeamMembers(
 	teamName string,
 	members []api.TeamMember,
 ) ([]api.User, error) {
-	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	tx, err := conn(ctx, r.pool).Begin(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -35,10 +40,18 @@ func (r *userRepository) UpsertTeamMembers(
 			INSERT INTO users (user_id, username, team_name, is_active)
 			VALUES ($1, $2, $3, $4)
 			ON CONFLICT (user_id) DO UPDATE
-			    SET username = EXCLUDED.username,
-			        team_name = EXCLUDED.team_name,
-			        is_active = EXCLUDED.is_active
-			RETURNING user_id, username, team_name, is_active
+			    SET username = CASE
+			            WHEN users.team_name IS NULL OR users.team_name = EXCLUDED.team_name
+			                THEN EXCLUDED.username
+			            ELSE users.username
+			        END,
+			        team_name = COALESCE(users.team_name, EXCLUDED.team_name),
+			        is_active = CASE
+			            WHEN users.team_name IS NULL OR users.team_name = EXCLUDED.team_name
+			                THEN EXCLUDED.is_active
+			            ELSE users.is_active
+			        END
+			RETURNING user_id, username, COALESCE(team_name, ''), is_active
 		`,
 			m.UserId,
 			m.Username,
@@ -48,6 +61,15 @@ func (r *userRepository) UpsertTeamMembers(
 		if err != nil {
 			return nil, err
 		}
+
+		_, err = tx.Exec(ctx, `
+			INSERT INTO team_members (team_name, user_id)
+			VALUES ($1, $2)
+			ON CONFLICT DO NOTHING
+		`, teamName, m.UserId)
+		if err != nil {
+			return nil, err
+		}
 		users = append(users, u)
 	}
 
@@ -58,11 +80,12 @@ func (r *userRepository) UpsertTeamMembers(
 }
 
 func (r *userRepository) ListByTeam(ctx context.Context, teamName string) ([]api.User, error) {
-	rows, err := r.pool.Query(ctx, `
-		SELECT user_id, username, team_name, is_active
-		FROM users
-		WHERE team_name = $1
-		ORDER BY user_id
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT u.user_id, u.username, COALESCE(u.team_name, ''), u.is_active
+		FROM team_members tm
+		JOIN users u ON u.user_id = tm.user_id
+		WHERE tm.team_name = $1
+		ORDER BY u.user_id
 	`, teamName)
 	if err != nil {
 		return nil, err
@@ -85,8 +108,8 @@ func (r *userRepository) ListByTeam(ctx context.Context, teamName string) ([]api
 
 func (r *userRepository) GetByID(ctx context.Context, userID string) (*api.User, error) {
 	var u api.User
-	err := r.pool.QueryRow(ctx, `
-		SELECT user_id, username, team_name, is_active
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT user_id, username, COALESCE(team_name, ''), is_active
 		FROM users
 		WHERE user_id = $1
 	`, userID).Scan(&u.UserId, &u.Username, &u.TeamName, &u.IsActive)
@@ -101,11 +124,11 @@ func (r *userRepository) GetByID(ctx context.Context, userID string) (*api.User,
 
 func (r *userRepository) SetIsActive(ctx context.Context, userID string, isActive bool) (*api.User, error) {
 	var u api.User
-	err := r.pool.QueryRow(ctx, `
+	err := conn(ctx, r.pool).QueryRow(ctx, `
 		UPDATE users
 		SET is_active = $2
 		WHERE user_id = $1
-		RETURNING user_id, username, team_name, is_active
+		RETURNING user_id, username, COALESCE(team_name, ''), is_active
 	`, userID, isActive).Scan(&u.UserId, &u.Username, &u.TeamName, &u.IsActive)
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -117,11 +140,12 @@ func (r *userRepository) SetIsActive(ctx context.Context, userID string, isActiv
 }
 
 func (r *userRepository) ListActiveByTeam(ctx context.Context, teamName string) ([]api.User, error) {
-	rows, err := r.pool.Query(ctx, `
-		SELECT user_id, username, team_name, is_active
-		FROM users
-		WHERE team_name = $1 AND is_active = TRUE
-		ORDER BY user_id
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT u.user_id, u.username, COALESCE(u.team_name, ''), u.is_active
+		FROM team_members tm
+		JOIN users u ON u.user_id = tm.user_id
+		WHERE tm.team_name = $1 AND u.is_active = TRUE
+		ORDER BY u.user_id
 	`, teamName)
 	if err != nil {
 		return nil, err
@@ -141,3 +165,416 @@ func (r *userRepository) ListActiveByTeam(ctx context.Context, teamName string)
 	}
 	return users, nil
 }
+
+// LinkExternalAccount выполняется в своей транзакции (или savepoint): NOT EXISTS
+// не защищает от одновременной привязки того же логина, и проигравший запрос
+// получает нарушение первичного ключа, которое не должно обрывать внешнюю транзакцию.
+func (r *userRepository) LinkExternalAccount(ctx context.Context, account api.ExternalAccount) (bool, error) {
+	tx, err := conn(ctx, r.pool).Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, `
+		INSERT INTO user_external_accounts (provider, login, user_id)
+		SELECT $1, $2, $3
+		WHERE NOT EXISTS (
+		    SELECT 1
+		    FROM user_external_accounts
+		    WHERE provider = $1 AND login = $2 AND user_id <> $3
+		)
+		ON CONFLICT (provider, user_id) DO UPDATE
+		    SET login = EXCLUDED.login
+	`, string(account.Provider), account.Login, account.UserId)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" &&
+			pgErr.ConstraintName == "user_external_accounts_pkey" {
+			return false, nil
+		}
+		return false, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+func (r *userRepository) GetByExternalLogin(
+	ctx context.Context,
+	provider api.ExternalAccountProvider,
+	login string,
+) (*api.User, error) {
+	// Привязанный логин однозначен; совпадение username учитывается, только
+	// если пользователь с таким именем ровно один, иначе автор неизвестен.
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT u.user_id, u.username, COALESCE(u.team_name, ''), u.is_active, TRUE
+		FROM users u
+		JOIN user_external_accounts a
+		  ON a.user_id = u.user_id AND a.provider = $1 AND a.login = $2
+		UNION ALL
+		SELECT u.user_id, u.username, COALESCE(u.team_name, ''), u.is_active, FALSE
+		FROM users u
+		WHERE u.username = $2
+		  AND NOT EXISTS (
+		      SELECT 1
+		      FROM user_external_accounts a
+		      WHERE a.user_id = u.user_id AND a.provider = $1
+		  )
+		ORDER BY 5 DESC
+		LIMIT 2
+	`, string(provider), login)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var (
+		candidates []api.User
+		linked     bool
+	)
+	for rows.Next() {
+		var (
+			u        api.User
+			isLinked bool
+		)
+		if err := rows.Scan(&u.UserId, &u.Username, &u.TeamName, &u.IsActive, &isLinked); err != nil {
+			return nil, err
+		}
+		if len(candidates) == 0 {
+			linked = isLinked
+		}
+		candidates = append(candidates, u)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	if len(candidates) == 0 || (!linked && len(candidates) > 1) {
+		return nil, nil
+	}
+	return &candidates[0], nil
+}
+
+func (r *userRepository) ListExternalLogins(
+	ctx context.Context,
+	provider api.ExternalAccountProvider,
+	userIDs []string,
+) (map[string]string, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT user_id, login
+		FROM user_external_accounts
+		WHERE provider = $1 AND user_id = ANY($2)
+	`, string(provider), userIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	logins := make(map[string]string, len(userIDs))
+	for rows.Next() {
+		var userID, login string
+		if err := rows.Scan(&userID, &login); err != nil {
+			return nil, err
+		}
+		logins[userID] = login
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return logins, nil
+}
+
+func (r *userRepository) ListTeams(ctx context.Context, userID string) ([]string, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT team_name
+		FROM team_members
+		WHERE user_id = $1
+		ORDER BY team_name
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var teams []string
+	for rows.Next() {
+		var team string
+		if err := rows.Scan(&team); err != nil {
+			return nil, err
+		}
+		teams = append(teams, team)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return teams, nil
+}
+
+func (r *userRepository) List(ctx context.Context, filter repository.UserFilter) ([]api.User, error) {
+	return collectRows(r.Stream(ctx, filter))
+}
+
+func (r *userRepository) Stream(ctx context.Context, filter repository.UserFilter) (repository.Rows[api.User], error) {
+	sortColumn := "u.user_id"
+	if filter.SortBy == repository.UserSortByUsername {
+		sortColumn = "u.username"
+	}
+	direction, cmp := "ASC", ">"
+	if filter.Desc {
+		direction, cmp = "DESC", "<"
+	}
+
+	var args []any
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	where := userFilterConditions(filter, arg)
+	if filter.After != nil {
+		where = append(where, fmt.Sprintf("(%s, u.user_id) %s (%s, %s)",
+			sortColumn, cmp, arg(filter.After.SortKey), arg(filter.After.UserID)))
+	}
+
+	query := `
+		SELECT u.user_id, u.username, COALESCE(u.team_name, ''), u.is_active,
+		       ARRAY(SELECT tm.team_name FROM team_members tm WHERE tm.user_id = u.user_id ORDER BY tm.team_name)
+		FROM users u
+		WHERE ` + strings.Join(where, " AND ") + fmt.Sprintf(`
+		ORDER BY %s %s, u.user_id %s`, sortColumn, direction, direction)
+	if filter.Limit > 0 {
+		query += " LIMIT " + arg(filter.Limit)
+	}
+	if filter.After == nil && filter.Offset > 0 {
+		query += " OFFSET " + arg(filter.Offset)
+	}
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return newRows(rows, func(rows pgx.Rows) (api.User, error) {
+		var u api.User
+		var teams []string
+		if err := rows.Scan(&u.UserId, &u.Username, &u.TeamName, &u.IsActive, &teams); err != nil {
+			return u, err
+		}
+		u.Teams = &teams
+		return u, nil
+	}), nil
+}
+
+func (r *userRepository) Count(ctx context.Context, filter repository.UserFilter) (int, error) {
+	var args []any
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	var cnt int
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT COUNT(*)
+		FROM users u
+		WHERE `+strings.Join(userFilterConditions(filter, arg), " AND "),
+		args...,
+	).Scan(&cnt)
+	return cnt, err
+}
+
+// userFilterConditions строит условия WHERE по полям фильтра, кроме курсора.
+func userFilterConditions(filter repository.UserFilter, arg func(v any) string) []string {
+	where := []string{"TRUE"}
+	if filter.TeamName != nil {
+		where = append(where, `EXISTS (
+		    SELECT 1 FROM team_members tm
+		    WHERE tm.user_id = u.user_id AND tm.team_name = `+arg(*filter.TeamName)+`)`)
+	}
+	if filter.IsActive != nil {
+		where = append(where, "u.is_active = "+arg(*filter.IsActive))
+	}
+	if filter.Query != "" {
+		where = append(where, `u.username ILIKE '%' || `+arg(escapeLike(filter.Query))+` || '%'`)
+	}
+	if filter.Username != nil {
+		where = append(where, "lower(u.username) = lower("+arg(*filter.Username)+")")
+	}
+	if filter.UserIDs != nil {
+		where = append(where, "u.user_id = ANY("+arg(filter.UserIDs)+")")
+	}
+	return where
+}
+
+func (r *userRepository) UpsertUser(ctx context.Context, userID, username string, isActive bool) (*api.User, error) {
+	var u api.User
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		INSERT INTO users (user_id, username, is_active)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id) DO UPDATE
+		    SET username = EXCLUDED.username,
+		        is_active = EXCLUDED.is_active
+		RETURNING user_id, username, COALESCE(team_name, ''), is_active
+	`, userID, username, isActive).Scan(&u.UserId, &u.Username, &u.TeamName, &u.IsActive)
+	if err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
+
+func (r *userRepository) Anonymize(ctx context.Context, userID, username string, reason *string) (time.Time, error) {
+	tx, err := conn(ctx, r.pool).Begin(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `
+		UPDATE users
+		SET username = $2, is_active = FALSE
+		WHERE user_id = $1
+	`, userID, username)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	_, err = tx.Exec(ctx, `
+		DELETE FROM user_external_accounts
+		WHERE user_id = $1
+	`, userID)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	_, err = tx.Exec(ctx, `
+		DELETE FROM team_leads
+		WHERE user_id = $1
+	`, userID)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var anonymizedAt time.Time
+	err = tx.QueryRow(ctx, `
+		INSERT INTO user_anonymizations (user_id, reason)
+		VALUES ($1, $2)
+		RETURNING anonymized_at
+	`, userID, reason).Scan(&anonymizedAt)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return time.Time{}, err
+	}
+	return anonymizedAt, nil
+}
+
+func (r *userRepository) GetAnonymizedAt(ctx context.Context, userID string) (*time.Time, error) {
+	var anonymizedAt time.Time
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT anonymized_at
+		FROM user_anonymizations
+		WHERE user_id = $1
+	`, userID).Scan(&anonymizedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &anonymizedAt, nil
+}
+
+// escapeLike экранирует спецсимволы шаблона LIKE.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+// DetachFromTeam исключает пользователей из команды. Если она была основной,
+// основной становится любая из оставшихся команд пользователя.
+func (r *userRepository) DetachFromTeam(ctx context.Context, teamName string, userIDs []string) error {
+	tx, err := conn(ctx, r.pool).Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `
+		DELETE FROM team_members
+		WHERE team_name = $1 AND user_id = ANY($2)
+	`, teamName, userIDs)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE users u
+		SET team_name = (
+		    SELECT tm.team_name
+		    FROM team_members tm
+		    WHERE tm.user_id = u.user_id
+		    ORDER BY tm.joined_at, tm.team_name
+		    LIMIT 1
+		)
+		WHERE u.team_name = $1 AND u.user_id = ANY($2)
+	`, teamName, userIDs)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// MoveToTeam делает команду основной для пользователя, исключая его из прежней основной.
+func (r *userRepository) MoveToTeam(ctx context.Context, userID, teamName string) (*api.User, error) {
+	tx, err := conn(ctx, r.pool).Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var u api.User
+	err = tx.QueryRow(ctx, `
+		WITH prev AS (
+		    SELECT user_id, team_name
+		    FROM users
+		    WHERE user_id = $1
+		)
+		UPDATE users u
+		SET team_name = $2
+		FROM prev
+		WHERE u.user_id = prev.user_id
+		RETURNING u.user_id, u.username, COALESCE(prev.team_name, ''), u.is_active
+	`, userID, teamName).Scan(&u.UserId, &u.Username, &u.TeamName, &u.IsActive)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if u.TeamName != "" && u.TeamName != teamName {
+		_, err = tx.Exec(ctx, `
+			DELETE FROM team_members
+			WHERE team_name = $1 AND user_id = $2
+		`, u.TeamName, userID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO team_members (team_name, user_id)
+		VALUES ($1, $2)
+		ON CONFLICT DO NOTHING
+	`, teamName, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	u.TeamName = teamName
+	return &u, nil
+}
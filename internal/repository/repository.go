@@ -6,23 +6,295 @@ import (
 	"time"
 )
 
+// Rows перебирает результат запроса по одной записи, не загружая его в память.
+// Close обязателен, если перебор прерван до конца.
+type Rows[T any] interface {
+	Next() bool
+	Value() T
+	Err() error
+	Close()
+}
+
+type sliceRows[T any] struct {
+	items []T
+	pos   int
+}
+
+// NewSliceRows отдаёт уже загруженные записи через интерфейс Rows.
+func NewSliceRows[T any](items []T) Rows[T] {
+	return &sliceRows[T]{items: items}
+}
+
+func (r *sliceRows[T]) Next() bool {
+	if r.pos >= len(r.items) {
+		return false
+	}
+	r.pos++
+	return true
+}
+
+func (r *sliceRows[T]) Value() T {
+	return r.items[r.pos-1]
+}
+
+func (r *sliceRows[T]) Err() error {
+	return nil
+}
+
+func (r *sliceRows[T]) Close() {
+	r.pos = len(r.items)
+}
+
 type ReviewerAssignmentsStat struct {
 	UserID string
-	Count  int64
+	// TeamName заполняется при группировке по команде.
+	TeamName *string
+	// WeekStart заполняется при группировке по неделе.
+	WeekStart *time.Time
+	Count     int64
+}
+
+// StatsFilter сужает выборку, по которой считается статистика назначений.
+type StatsFilter struct {
+	Repository *string
+	// Teams оставляет только ревьюверов, состоящих в одной из команд.
+	Teams []string
+	// From и To ограничивают created_at PR полуинтервалом [From, To).
+	From    *time.Time
+	To      *time.Time
+	Status  *api.PullRequestStatus
+	GroupBy StatsGroupBy
+}
+
+type StatsGroupBy string
+
+const (
+	StatsGroupByNone     StatsGroupBy = ""
+	StatsGroupByTeam     StatsGroupBy = "team"
+	StatsGroupByWeek     StatsGroupBy = "week"
+	StatsGroupByAuthor   StatsGroupBy = "author"
+	StatsGroupByReviewer StatsGroupBy = "reviewer"
+	// StatsGroupByAssignmentTeam относит каждое назначение ровно к одной команде:
+	// к основной команде автора PR, если ревьювер в ней состоит, иначе к основной
+	// команде ревьювера. Teams в этом режиме фильтрует по выбранной команде.
+	StatsGroupByAssignmentTeam StatsGroupBy = "assignment_team"
+)
+
+// TurnaroundFilter выбирает PR, смерженные в полуинтервале [From, To).
+type TurnaroundFilter struct {
+	From *time.Time
+	To   *time.Time
+	// GroupBy — StatsGroupByTeam, StatsGroupByAuthor или StatsGroupByReviewer.
+	GroupBy StatsGroupBy
+}
+
+// TurnaroundStat — распределение времени от создания до мержа PR в секундах.
+type TurnaroundStat struct {
+	Key           string
+	MergedCount   int64
+	MedianSeconds float64
+	P90Seconds    float64
+	MeanSeconds   float64
+}
+
+// ReviewGraphFilter выбирает PR для графа «автор → ревьювер».
+type ReviewGraphFilter struct {
+	// AuthorTeams оставляет только PR авторов, состоящих в одной из команд.
+	AuthorTeams []string
+	// From и To ограничивают created_at PR полуинтервалом [From, To).
+	From *time.Time
+	To   *time.Time
+}
+
+// ReviewEdge — число PR автора, на которые назначался ревьювер.
+type ReviewEdge struct {
+	AuthorID   string
+	ReviewerID string
+	Count      int64
+}
+
+// UserFilter задаёт выборку справочника пользователей.
+type UserFilter struct {
+	TeamName *string
+	IsActive *bool
+	// Query — подстрока имени пользователя без учёта регистра.
+	Query string
+	// Username — точное имя пользователя без учёта регистра.
+	Username *string
+	UserIDs  []string
+	SortBy   UserSortField
+	Desc     bool
+	// After — ключ последней записи предыдущей страницы.
+	After *UserCursor
+	// Offset пропускает записи с начала выборки; используется, когда After не задан.
+	Offset int
+	Limit  int
+}
+
+type UserSortField string
+
+const (
+	UserSortByID       UserSortField = "user_id"
+	UserSortByUsername UserSortField = "username"
+)
+
+// UserCursor — значение поля сортировки и user_id последней выданной записи.
+type UserCursor struct {
+	SortKey string
+	UserID  string
+}
+
+// TeamFilter задаёт страницу списка команд, упорядоченного по имени.
+type TeamFilter struct {
+	Prefix string
+	// After — имя последней команды предыдущей страницы.
+	After string
+	Limit int
+}
+
+// AuditFilter задаёт страницу журнала, упорядоченного от новых записей к старым.
+type AuditFilter struct {
+	// Actor совпадает с subject или user_id вызывающего.
+	Actor       *string
+	OperationID *string
+	Result      *api.AuditResult
+	From        *time.Time
+	To          *time.Time
+	// BeforeID — id последней записи предыдущей страницы.
+	BeforeID *int64
+	Limit    int
+}
+
+// TeamNode — команда в дереве подразделений с её собственными настройками.
+// Policy — последняя версия из team_settings: обход дерева при назначении
+// ревьюверов читает настройки всей цепочки одним запросом TeamRepository,
+// а не отдельным TeamSettingsRepository.Get на каждую команду.
+type TeamNode struct {
+	Name   string
+	Parent *string
+	Policy api.TeamPolicy
+	// Depth — расстояние от команды, с которой начат обход дерева.
+	Depth int
+}
+
+// ActivationScheduleFilter задаёт выборку запланированных изменений активности.
+type ActivationScheduleFilter struct {
+	UserID *string
+	Status api.ScheduledActivationStatus
+}
+
+// TxManager выполняет fn в одной транзакции; репозитории, вызванные с переданным
+// контекстом, работают внутри неё.
+type TxManager interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
 type TeamRepository interface {
 	Create(ctx context.Context, teamName string) error
 	Exists(ctx context.Context, teamName string) (bool, error)
+	Rename(ctx context.Context, teamName, newTeamName string) error
+	Delete(ctx context.Context, teamName string) error
+	List(ctx context.Context, filter TeamFilter) ([]api.TeamSummary, error)
+
+	Get(ctx context.Context, teamName string) (*TeamNode, error)
+	SetParent(ctx context.Context, teamName string, parent *string) error
+	// Ancestors возвращает команду и всех её предков, начиная с неё самой.
+	Ancestors(ctx context.Context, teamName string) ([]TeamNode, error)
+	// Subtree возвращает команду и всех её потомков; пустое имя — все деревья целиком.
+	Subtree(ctx context.Context, teamName string) ([]TeamNode, error)
+}
+
+// TeamSettingsRepository хранит версии собственных настроек команд;
+// действующей считается последняя версия.
+type TeamSettingsRepository interface {
+	// Get возвращает последнюю версию или nil, если настройки ещё не сохранялись.
+	Get(ctx context.Context, teamName string) (*api.TeamSettingsVersion, error)
+	GetVersion(ctx context.Context, teamName string, version int) (*api.TeamSettingsVersion, error)
+	// ListVersions возвращает версии от новых к старым.
+	ListVersions(ctx context.Context, teamName string) ([]api.TeamSettingsVersion, error)
+	// Save добавляет следующую версию. При заданном expectedVersion, не совпавшем
+	// с текущей версией, возвращает nil без ошибки.
+	Save(ctx context.Context, teamName string, settings api.TeamPolicy, expectedVersion *int, comment *string) (*api.TeamSettingsVersion, error)
 }
 
 type UserRepository interface {
+	// UpsertTeamMembers добавляет членство в teamName. Username и is_active
+	// обновляются только у пользователей без команды или с основной teamName:
+	// участник другой команды получает лишь дополнительное членство.
 	UpsertTeamMembers(ctx context.Context, teamName string, members []api.TeamMember) ([]api.User, error)
 
 	ListByTeam(ctx context.Context, teamName string) ([]api.User, error)
 	GetByID(ctx context.Context, userID string) (*api.User, error)
 	SetIsActive(ctx context.Context, userID string, isActive bool) (*api.User, error)
 	ListActiveByTeam(ctx context.Context, teamName string) ([]api.User, error)
+	DetachFromTeam(ctx context.Context, teamName string, userIDs []string) error
+	MoveToTeam(ctx context.Context, userID, teamName string) (*api.User, error)
+	ListTeams(ctx context.Context, userID string) ([]string, error)
+	// List возвращает пользователей вместе со списком их команд.
+	List(ctx context.Context, filter UserFilter) ([]api.User, error)
+	// Stream — то же, что List, но строки читаются из базы по мере перебора;
+	// нулевой Limit снимает ограничение.
+	Stream(ctx context.Context, filter UserFilter) (Rows[api.User], error)
+	// Count считает пользователей по фильтру без учёта After, Offset и Limit.
+	Count(ctx context.Context, filter UserFilter) (int, error)
+	// UpsertUser создаёт или обновляет пользователя, не меняя его команды.
+	UpsertUser(ctx context.Context, userID, username string, isActive bool) (*api.User, error)
+	// Anonymize заменяет имя, деактивирует пользователя, удаляет внешние логины
+	// и фиксирует факт обезличивания.
+	Anonymize(ctx context.Context, userID, username string, reason *string) (time.Time, error)
+	GetAnonymizedAt(ctx context.Context, userID string) (*time.Time, error)
+
+	// LinkExternalAccount привязывает логин; false, если он уже привязан к
+	// другому пользователю.
+	LinkExternalAccount(ctx context.Context, account api.ExternalAccount) (bool, error)
+	// GetByExternalLogin ищет пользователя по привязанному логину, а без привязки —
+	// по совпадению username, если такой пользователь ровно один.
+	GetByExternalLogin(ctx context.Context, provider api.ExternalAccountProvider, login string) (*api.User, error)
+	ListExternalLogins(ctx context.Context, provider api.ExternalAccountProvider, userIDs []string) (map[string]string, error)
+}
+
+// ActivationScheduleRepository хранит запланированные изменения is_active пользователей.
+type ActivationScheduleRepository interface {
+	Create(ctx context.Context, userID string, isActive bool, effectiveAt time.Time, comment *string) (*api.ScheduledActivation, error)
+	Get(ctx context.Context, id int64) (*api.ScheduledActivation, error)
+	// List возвращает изменения в порядке effective_at.
+	List(ctx context.Context, filter ActivationScheduleFilter) ([]api.ScheduledActivation, error)
+	// Cancel отменяет ожидающее изменение; nil, если его нет или оно уже не ожидает.
+	Cancel(ctx context.Context, id int64) (*api.ScheduledActivation, error)
+	// ClaimDue блокирует до конца транзакции самое раннее ожидающее изменение
+	// с effective_at не позже now; nil, если таких нет.
+	ClaimDue(ctx context.Context, now time.Time) (*api.ScheduledActivation, error)
+	MarkApplied(ctx context.Context, id int64, appliedAt time.Time) error
+	// MarkFailed переводит изменение в FAILED, чтобы ClaimDue его больше не выбирал.
+	MarkFailed(ctx context.Context, id int64, reason string) error
+}
+
+// APIKeyRepository хранит API-ключи; вместо секрета хранится его хеш.
+type APIKeyRepository interface {
+	Create(ctx context.Context, name, prefix, hash string, scopes []api.ApiKeyScope, expiresAt *time.Time) (*api.ApiKey, error)
+	// GetByHash возвращает ключ, в том числе отозванный или просроченный; nil, если хеша нет.
+	GetByHash(ctx context.Context, hash string) (*api.ApiKey, error)
+	// List возвращает ключи от новых к старым.
+	List(ctx context.Context, includeRevoked bool) ([]api.ApiKey, error)
+	// Revoke отзывает ключ, не меняя время уже состоявшегося отзыва; nil, если ключа нет.
+	Revoke(ctx context.Context, id int64, at time.Time) (*api.ApiKey, error)
+	TouchLastUsed(ctx context.Context, id int64, at time.Time) error
+}
+
+// TeamLeadRepository хранит руководителей команд.
+type TeamLeadRepository interface {
+	List(ctx context.Context, teamName string) ([]api.TeamLead, error)
+	// Add не меняет granted_at при повторном назначении.
+	Add(ctx context.Context, teamName, userID string) (*api.TeamLead, error)
+	// Remove возвращает nil, если пользователь не руководил командой.
+	Remove(ctx context.Context, teamName, userID string) (*api.TeamLead, error)
+	ListTeamsByUser(ctx context.Context, userID string) ([]string, error)
+}
+
+// AuditRepository хранит журнал изменяющих запросов.
+type AuditRepository interface {
+	Insert(ctx context.Context, rec api.AuditRecord) error
+	List(ctx context.Context, filter AuditFilter) ([]api.AuditRecord, error)
 }
 
 type PRRepository interface {
@@ -30,11 +302,33 @@ type PRRepository interface {
 	GetByID(ctx context.Context, prID string) (*api.PullRequest, error)
 
 	SetMerged(ctx context.Context, prID string, mergedAt time.Time) (*api.PullRequest, error)
+	SetStatus(ctx context.Context, prID string, status api.PullRequestStatus) (*api.PullRequest, error)
 	ReplaceReviewer(ctx context.Context, prID, oldReviewerID, newReviewerID string) error
 
 	ListReviewers(ctx context.Context, prID string) ([]string, error)
 	SetReviewers(ctx context.Context, prID string, reviewers []string) error
 
 	ListShortByReviewer(ctx context.Context, reviewerID string) ([]api.PullRequestShort, error)
-	GetReviewerAssignmentsStats(ctx context.Context) ([]ReviewerAssignmentsStat, error)
+	StreamShortByReviewer(ctx context.Context, reviewerID string) (Rows[api.PullRequestShort], error)
+	// ListOpenShortByReviewerInTeam возвращает открытые PR команды teamName, где
+	// reviewerID назначен ревьювером: автор PR в ней по основной команде или
+	// репозиторий PR принадлежит ей.
+	ListOpenShortByReviewerInTeam(ctx context.Context, reviewerID, teamName string) ([]api.PullRequestShort, error)
+	GetReviewerAssignmentsStats(ctx context.Context, filter StatsFilter) ([]ReviewerAssignmentsStat, error)
+	CountOpenAssignments(ctx context.Context, reviewerIDs []string) (map[string]int64, error)
+	GetTurnaroundStats(ctx context.Context, filter TurnaroundFilter) ([]TurnaroundStat, error)
+	// GetReviewGraph возвращает рёбра по убыванию веса.
+	GetReviewGraph(ctx context.Context, filter ReviewGraphFilter) ([]ReviewEdge, error)
+	// ListOpenByReviewer и ListOpenByAuthor возвращают открытые PR от самых старых.
+	ListOpenByReviewer(ctx context.Context, reviewerID string) ([]api.PullRequest, error)
+	ListOpenByAuthor(ctx context.Context, authorID string) ([]api.PullRequest, error)
+	// CountAssignments считает назначения ревьювера: все и на PR, созданные не раньше since.
+	CountAssignments(ctx context.Context, reviewerID string, since time.Time) (total, recent int64, err error)
+}
+
+type RepoRepository interface {
+	Upsert(ctx context.Context, repo api.Repository) (*api.Repository, error)
+	GetByID(ctx context.Context, repositoryID string) (*api.Repository, error)
+	CountByTeam(ctx context.Context, teamName string) (int64, error)
+	TransferTeam(ctx context.Context, fromTeam, toTeam string) error
 }
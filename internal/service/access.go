@@ -0,0 +1,103 @@
+package service
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/repository"
+	"context"
+	"slices"
+)
+
+type accessService struct {
+	leadRepo     repository.TeamLeadRepository
+	teamRepo     repository.TeamRepository
+	userRepo     repository.UserRepository
+	prRepo       repository.PRRepository
+	repoRepo     repository.RepoRepository
+	scheduleRepo repository.ActivationScheduleRepository
+}
+
+func (s *accessService) ListTeamLeads(ctx context.Context, teamName string) ([]api.TeamLead, error) {
+	exists, err := s.teamRepo.Exists(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrNotFound
+	}
+
+	leads, err := s.leadRepo.List(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	if leads == nil {
+		leads = []api.TeamLead{}
+	}
+	return leads, nil
+}
+
+func (s *accessService) AddTeamLead(ctx context.Context, body api.TeamLeadRequest) (*api.TeamLead, error) {
+	exists, err := s.teamRepo.Exists(ctx, body.TeamName)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrNotFound
+	}
+	user, err := s.userRepo.GetByID(ctx, body.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrNotFound
+	}
+	return s.leadRepo.Add(ctx, body.TeamName, body.UserId)
+}
+
+func (s *accessService) RemoveTeamLead(ctx context.Context, body api.TeamLeadRequest) (*api.TeamLead, error) {
+	lead, err := s.leadRepo.Remove(ctx, body.TeamName, body.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if lead == nil {
+		return nil, ErrNotFound
+	}
+	return lead, nil
+}
+
+func (s *accessService) LedTeams(ctx context.Context, userID string) ([]string, error) {
+	return s.leadRepo.ListTeamsByUser(ctx, userID)
+}
+
+func (s *accessService) UserTeams(ctx context.Context, userID string) ([]string, error) {
+	return s.userRepo.ListTeams(ctx, userID)
+}
+
+func (s *accessService) PullRequestTeams(ctx context.Context, prID string) ([]string, error) {
+	pr, err := s.prRepo.GetByID(ctx, prID)
+	if err != nil || pr == nil {
+		return nil, err
+	}
+
+	teams, err := s.userRepo.ListTeams(ctx, pr.AuthorId)
+	if err != nil {
+		return nil, err
+	}
+	if pr.Repository != nil {
+		repo, err := s.repoRepo.GetByID(ctx, *pr.Repository)
+		if err != nil {
+			return nil, err
+		}
+		if repo != nil && !slices.Contains(teams, repo.TeamName) {
+			teams = append(teams, repo.TeamName)
+		}
+	}
+	return teams, nil
+}
+
+func (s *accessService) ScheduledActivationTeams(ctx context.Context, id int64) ([]string, error) {
+	sched, err := s.scheduleRepo.Get(ctx, id)
+	if err != nil || sched == nil {
+		return nil, err
+	}
+	return s.userRepo.ListTeams(ctx, sched.UserId)
+}
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/repository"
+	"context"
+	"time"
+)
+
+func (s *userService) ScheduleActivation(
+	ctx context.Context,
+	body api.PostUsersScheduleActivationJSONRequestBody,
+) (*api.ScheduledActivation, error) {
+	if body.UserId == "" || !body.EffectiveAt.After(time.Now()) {
+		return nil, ErrInvalidArgument
+	}
+
+	user, err := s.userRepo.GetByID(ctx, body.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrNotFound
+	}
+
+	return s.scheduleRepo.Create(ctx, body.UserId, body.IsActive, body.EffectiveAt, body.Comment)
+}
+
+func (s *userService) ListScheduledActivations(
+	ctx context.Context,
+	params api.GetUsersScheduledActivationsParams,
+) ([]api.ScheduledActivation, error) {
+	filter := repository.ActivationScheduleFilter{
+		UserID: params.UserId,
+		Status: api.ScheduledActivationStatusPENDING,
+	}
+	if params.Status != nil {
+		filter.Status = api.ScheduledActivationStatus(*params.Status)
+	}
+
+	schedules, err := s.scheduleRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	if schedules == nil {
+		schedules = []api.ScheduledActivation{}
+	}
+	return schedules, nil
+}
+
+func (s *userService) CancelScheduledActivation(ctx context.Context, id int64) (*api.ScheduledActivation, error) {
+	cancelled, err := s.scheduleRepo.Cancel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if cancelled != nil {
+		return cancelled, nil
+	}
+
+	existing, err := s.scheduleRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, ErrNotFound
+	}
+	return nil, ErrScheduleNotPending
+}
+
+// ApplyDueActivations применяет все изменения с effective_at не позже now,
+// каждое в своей транзакции, и возвращает число применённых и неудавшихся.
+// Изменение, которое не удалось применить, помечается FAILED и не мешает
+// следующим: иначе ClaimDue снова и снова выбирал бы его первым.
+func (s *userService) ApplyDueActivations(ctx context.Context, now time.Time) (applied, failed int, err error) {
+	for {
+		done, ok := false, false
+		err := s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+			schedule, err := s.scheduleRepo.ClaimDue(ctx, now)
+			if err != nil {
+				return err
+			}
+			if schedule == nil {
+				done = true
+				return nil
+			}
+
+			// Вложенная транзакция (savepoint) откатывает частично применённое
+			// изменение, но оставляет блокировку строки для MarkFailed.
+			applyErr := s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+				return s.applyActivation(ctx, schedule.UserId, schedule.IsActive)
+			})
+			if applyErr != nil {
+				if ctx.Err() != nil {
+					return applyErr
+				}
+				return s.scheduleRepo.MarkFailed(ctx, schedule.Id, applyErr.Error())
+			}
+			ok = true
+			return s.scheduleRepo.MarkApplied(ctx, schedule.Id, now)
+		})
+		if err != nil {
+			return applied, failed, err
+		}
+		if done {
+			return applied, failed, nil
+		}
+		if ok {
+			applied++
+		} else {
+			failed++
+		}
+	}
+}
+
+// applyActivation меняет is_active; при деактивации открытые ревью переходят
+// к активным участникам команд пользователя.
+func (s *userService) applyActivation(ctx context.Context, userID string, isActive bool) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil || user.IsActive == isActive {
+		return nil
+	}
+
+	if _, err := s.userRepo.SetIsActive(ctx, userID, isActive); err != nil {
+		return err
+	}
+	if isActive {
+		return nil
+	}
+
+	teams, err := s.userRepo.ListTeams(ctx, userID)
+	if err != nil {
+		return err
+	}
+	candidates, err := activeTeammates(ctx, s.userRepo, userID, teams)
+	if err != nil {
+		return err
+	}
+	_, _, err = reassignOpenReviews(ctx, s.prRepo, userID, candidates)
+	return err
+}
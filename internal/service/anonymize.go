@@ -0,0 +1,91 @@
+package service
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// anonymizedUsername строит заглушку имени, не раскрывающую данных пользователя,
+// но одинаковую при повторных вызовах.
+func anonymizedUsername(userID string) string {
+	sum := sha256.Sum256([]byte(userID))
+	return "deleted-" + hex.EncodeToString(sum[:5])
+}
+
+func (s *userService) AnonymizeUser(
+	ctx context.Context,
+	body api.PostUsersAnonymizeJSONRequestBody,
+) (*api.AnonymizeResult, error) {
+	if body.UserId == "" {
+		return nil, ErrInvalidArgument
+	}
+
+	user, err := s.userRepo.GetByID(ctx, body.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrNotFound
+	}
+
+	anonymizedAt, err := s.userRepo.GetAnonymizedAt(ctx, body.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if anonymizedAt != nil {
+		return &api.AnonymizeResult{
+			User:         *user,
+			AnonymizedAt: *anonymizedAt,
+		}, nil
+	}
+
+	res := &api.AnonymizeResult{}
+	err = s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		teams, err := s.userRepo.ListTeams(ctx, body.UserId)
+		if err != nil {
+			return err
+		}
+
+		candidates, err := activeTeammates(ctx, s.userRepo, body.UserId, teams)
+		if err != nil {
+			return err
+		}
+
+		reassigned, notReassigned, err := reassignOpenReviews(ctx, s.prRepo, body.UserId, candidates)
+		if err != nil {
+			return err
+		}
+		res.Reassignment = api.ReviewReassignmentResult{
+			ReassignedCount:    reassigned,
+			NotReassignedCount: notReassigned,
+		}
+
+		for _, team := range teams {
+			if err := s.userRepo.DetachFromTeam(ctx, team, []string{body.UserId}); err != nil {
+				return err
+			}
+		}
+
+		at, err := s.userRepo.Anonymize(ctx, body.UserId, anonymizedUsername(body.UserId), body.Reason)
+		if err != nil {
+			return err
+		}
+		res.AnonymizedAt = at
+
+		updated, err := s.userRepo.GetByID(ctx, body.UserId)
+		if err != nil {
+			return err
+		}
+		if updated == nil {
+			return ErrNotFound
+		}
+		res.User = *updated
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
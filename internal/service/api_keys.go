@@ -0,0 +1,118 @@
+package service
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/repository"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	apiKeySecretPrefix = "prk_"
+	apiKeyPrefixLen    = 12
+	// apiKeyTouchInterval ограничивает частоту записи last_used_at.
+	apiKeyTouchInterval = time.Minute
+)
+
+type apiKeyService struct {
+	keyRepo repository.APIKeyRepository
+}
+
+func (s *apiKeyService) CreateKey(
+	ctx context.Context,
+	body api.PostAdminApiKeysJSONRequestBody,
+) (*api.ApiKey, string, error) {
+	name := strings.TrimSpace(body.Name)
+	if name == "" || len(body.Scopes) == 0 {
+		return nil, "", ErrInvalidArgument
+	}
+	for _, scope := range body.Scopes {
+		if scope != api.ApiKeyScopeRead && scope != api.ApiKeyScopeWrite && scope != api.ApiKeyScopeAdmin {
+			return nil, "", fmt.Errorf("%w: unknown scope %q", ErrInvalidArgument, scope)
+		}
+	}
+	if body.ExpiresAt != nil && !body.ExpiresAt.After(time.Now()) {
+		return nil, "", fmt.Errorf("%w: expires_at must be in the future", ErrInvalidArgument)
+	}
+
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, "", err
+	}
+	secret := apiKeySecretPrefix + base64.RawURLEncoding.EncodeToString(raw)
+
+	key, err := s.keyRepo.Create(ctx, name, secret[:apiKeyPrefixLen], hashAPIKey(secret), body.Scopes, body.ExpiresAt)
+	if err != nil {
+		return nil, "", err
+	}
+	return key, secret, nil
+}
+
+func (s *apiKeyService) ListKeys(ctx context.Context, includeRevoked bool) ([]api.ApiKey, error) {
+	keys, err := s.keyRepo.List(ctx, includeRevoked)
+	if err != nil {
+		return nil, err
+	}
+	if keys == nil {
+		keys = []api.ApiKey{}
+	}
+	return keys, nil
+}
+
+func (s *apiKeyService) RevokeKey(ctx context.Context, id int64) (*api.ApiKey, error) {
+	key, err := s.keyRepo.Revoke(ctx, id, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		return nil, ErrNotFound
+	}
+	return key, nil
+}
+
+func (s *apiKeyService) Authenticate(ctx context.Context, secret string) (*api.ApiKey, error) {
+	if !strings.HasPrefix(secret, apiKeySecretPrefix) {
+		return nil, ErrUnauthorized
+	}
+
+	key, err := s.keyRepo.GetByHash(ctx, hashAPIKey(secret))
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if key == nil || key.RevokedAt != nil || (key.ExpiresAt != nil && !now.Before(*key.ExpiresAt)) {
+		return nil, ErrUnauthorized
+	}
+
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= apiKeyTouchInterval {
+		if err := s.keyRepo.TouchLastUsed(ctx, key.Id, now); err != nil {
+			return nil, err
+		}
+		key.LastUsedAt = &now
+	}
+	return key, nil
+}
+
+// HasScope сообщает, покрывают ли выданные scope требуемый: admin включает
+// write, write включает read.
+func HasScope(granted []api.ApiKeyScope, required api.ApiKeyScope) bool {
+	rank := map[api.ApiKeyScope]int{api.ApiKeyScopeRead: 1, api.ApiKeyScopeWrite: 2, api.ApiKeyScopeAdmin: 3}
+	for _, scope := range granted {
+		if rank[scope] >= rank[required] {
+			return true
+		}
+	}
+	return false
+}
+
+// hashAPIKey — секрет случаен и длинен, поэтому достаточно SHA-256 без соли.
+func hashAPIKey(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
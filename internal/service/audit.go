@@ -0,0 +1,82 @@
+package service
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/repository"
+	"context"
+	"fmt"
+	"time"
+)
+
+type auditService struct {
+	auditRepo repository.AuditRepository
+}
+
+type auditCursor struct {
+	ID int64 `json:"i"`
+}
+
+func (s *auditService) Record(ctx context.Context, rec api.AuditRecord) error {
+	if rec.OccurredAt.IsZero() {
+		rec.OccurredAt = time.Now()
+	}
+	return s.auditRepo.Insert(ctx, rec)
+}
+
+func (s *auditService) List(ctx context.Context, params api.GetAdminAuditParams) (*api.AuditPage, error) {
+	filter := repository.AuditFilter{
+		Actor:       params.Actor,
+		OperationID: params.OperationId,
+		Result:      params.Result,
+		From:        params.From,
+		To:          params.To,
+		Limit:       defaultPageSize,
+	}
+	if params.Result != nil {
+		switch *params.Result {
+		case api.Success, api.Failure, api.Denied:
+		default:
+			return nil, fmt.Errorf("%w: unknown result %q", ErrInvalidArgument, *params.Result)
+		}
+	}
+	if params.From != nil && params.To != nil && !params.From.Before(*params.To) {
+		return nil, fmt.Errorf("%w: from must be before to", ErrInvalidArgument)
+	}
+	if params.Limit != nil {
+		if *params.Limit < 1 || *params.Limit > maxPageSize {
+			return nil, ErrInvalidArgument
+		}
+		filter.Limit = *params.Limit
+	}
+	if params.Cursor != nil && *params.Cursor != "" {
+		var cursor auditCursor
+		if err := decodeCursor(*params.Cursor, &cursor); err != nil {
+			return nil, err
+		}
+		if cursor.ID <= 0 {
+			return nil, ErrInvalidArgument
+		}
+		filter.BeforeID = &cursor.ID
+	}
+
+	pageSize := filter.Limit
+	filter.Limit++
+	records, err := s.auditRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &api.AuditPage{Records: records}
+	if page.Records == nil {
+		page.Records = []api.AuditRecord{}
+	}
+	if len(records) > pageSize {
+		page.Records = records[:pageSize]
+		next, err := encodeCursor(auditCursor{ID: page.Records[pageSize-1].Id})
+		if err != nil {
+			return nil, err
+		}
+		page.NextCursor = &next
+	}
+	return page, nil
+}
@@ -0,0 +1,31 @@
+package service
+
+import (
+	"encoding/base64"
+	"encoding/json"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 200
+)
+
+// encodeCursor упаковывает позицию страницы в непрозрачную строку.
+func encodeCursor(v any) (string, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func decodeCursor(s string, v any) error {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return ErrInvalidArgument
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		return ErrInvalidArgument
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/repository"
+	"context"
+	"fmt"
+	"math"
+	"sort"
+)
+
+const defaultFairnessTolerance = 0.5
+
+// GetFairness считает по каждой команде, насколько равномерно между участниками
+// распределены назначения в интервале. Назначение ревьювера из нескольких
+// команд учитывается только в одной из них, иначе суммы команд раздуваются.
+func (s *prService) GetFairness(ctx context.Context, params api.GetStatsFairnessParams) ([]api.TeamFairness, error) {
+	if params.From != nil && params.To != nil && !params.From.Before(*params.To) {
+		return nil, fmt.Errorf("%w: from must be before to", ErrInvalidArgument)
+	}
+	tolerance := defaultFairnessTolerance
+	if params.Tolerance != nil {
+		if *params.Tolerance < 0 || math.IsNaN(*params.Tolerance) {
+			return nil, fmt.Errorf("%w: tolerance must be non-negative", ErrInvalidArgument)
+		}
+		tolerance = *params.Tolerance
+	}
+
+	root := ""
+	if params.Team != nil {
+		root = *params.Team
+	}
+	subtree, err := s.teamRepo.Subtree(ctx, root)
+	if err != nil {
+		return nil, err
+	}
+	if root != "" && len(subtree) == 0 {
+		return nil, ErrNotFound
+	}
+	if len(subtree) == 0 {
+		return []api.TeamFairness{}, nil
+	}
+
+	filter := repository.StatsFilter{
+		From:    params.From,
+		To:      params.To,
+		GroupBy: repository.StatsGroupByAssignmentTeam,
+	}
+	for _, node := range subtree {
+		filter.Teams = append(filter.Teams, node.Name)
+	}
+	stats, err := s.prRepo.GetReviewerAssignmentsStats(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]map[string]int64, len(subtree))
+	for _, st := range stats {
+		if st.TeamName == nil {
+			continue
+		}
+		if counts[*st.TeamName] == nil {
+			counts[*st.TeamName] = make(map[string]int64)
+		}
+		counts[*st.TeamName][st.UserID] += st.Count
+	}
+
+	result := make([]api.TeamFairness, 0, len(subtree))
+	for _, node := range subtree {
+		teamCounts := counts[node.Name]
+		if teamCounts == nil {
+			teamCounts = make(map[string]int64)
+		}
+
+		members, err := s.userRepo.ListByTeam(ctx, node.Name)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range members {
+			if _, ok := teamCounts[m.UserId]; !ok && m.IsActive {
+				teamCounts[m.UserId] = 0
+			}
+		}
+
+		result = append(result, teamFairness(node.Name, teamCounts, tolerance))
+	}
+	return result, nil
+}
+
+// teamFairness собирает метрики команды по числу назначений каждого участника.
+func teamFairness(teamName string, counts map[string]int64, tolerance float64) api.TeamFairness {
+	res := api.TeamFairness{
+		TeamName:    teamName,
+		MemberCount: len(counts),
+		Members:     make([]api.MemberFairness, 0, len(counts)),
+	}
+	if len(counts) == 0 {
+		return res
+	}
+
+	ids := make([]string, 0, len(counts))
+	values := make([]int64, 0, len(counts))
+	for id, c := range counts {
+		ids = append(ids, id)
+		values = append(values, c)
+		res.TotalAssignments += c
+	}
+	sort.Strings(ids)
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+
+	n := float64(len(values))
+	res.Min = values[0]
+	res.Max = values[len(values)-1]
+	res.Mean = float64(res.TotalAssignments) / n
+
+	var sqDiff, weighted float64
+	for i, v := range values {
+		d := float64(v) - res.Mean
+		sqDiff += d * d
+		weighted += float64(i+1) * float64(v)
+	}
+	res.Stddev = math.Sqrt(sqDiff / n)
+	if res.TotalAssignments > 0 {
+		res.Gini = 2*weighted/(n*float64(res.TotalAssignments)) - (n+1)/n
+	}
+
+	equalShare := 1 / n
+	for _, id := range ids {
+		m := api.MemberFairness{
+			UserId:        id,
+			AssignedCount: counts[id],
+			EqualShare:    equalShare,
+			Ratio:         1,
+			Load:          api.Balanced,
+		}
+		if res.TotalAssignments > 0 {
+			m.Share = float64(m.AssignedCount) / float64(res.TotalAssignments)
+			m.Ratio = m.Share / equalShare
+		}
+		switch {
+		case m.Ratio > 1+tolerance:
+			m.Load = api.Over
+		case m.Ratio < 1-tolerance:
+			m.Load = api.Under
+		}
+		res.Members = append(res.Members, m)
+	}
+	return res
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/repository"
+	"context"
+	"errors"
+	"fmt"
+)
+
+const (
+	gitLabObjectKindMergeRequest = "merge_request"
+
+	gitLabActionOpen   = "open"
+	gitLabActionReopen = "reopen"
+	gitLabActionUpdate = "update"
+	gitLabActionMerge  = "merge"
+	gitLabActionClose  = "close"
+)
+
+type gitLabService struct {
+	prService PRService
+	userRepo  repository.UserRepository
+}
+
+// GitLabPullRequestID строит идентификатор PR сервиса для merge request'а GitLab.
+func GitLabPullRequestID(projectPath string, iid int64) string {
+	return fmt.Sprintf("gitlab:%s!%d", projectPath, iid)
+}
+
+func (s *gitLabService) HandleMergeRequestEvent(
+	ctx context.Context,
+	event api.GitLabMergeRequestEvent,
+) (*api.WebhookResult, error) {
+	if event.ObjectKind != gitLabObjectKindMergeRequest {
+		return ignored("", "unsupported object_kind "+event.ObjectKind), nil
+	}
+
+	attrs := event.ObjectAttributes
+	prID := GitLabPullRequestID(event.Project.PathWithNamespace, attrs.Iid)
+
+	action := ""
+	if attrs.Action != nil {
+		action = *attrs.Action
+	}
+
+	var err error
+	switch action {
+	case gitLabActionOpen:
+		if isDraft(event) {
+			return ignored(prID, "draft merge request"), nil
+		}
+		err = s.openPR(ctx, prID, event)
+	case gitLabActionReopen:
+		if isDraft(event) {
+			return ignored(prID, "draft merge request"), nil
+		}
+		_, err = s.prService.ReopenPR(ctx, prID)
+		if errors.Is(err, ErrNotFound) {
+			err = s.openPR(ctx, prID, event)
+		}
+	case gitLabActionUpdate:
+		if !markedReady(event) {
+			return ignored(prID, "no reviewer-relevant changes"), nil
+		}
+		err = s.openPR(ctx, prID, event)
+	case gitLabActionMerge:
+		_, err = s.prService.MergePR(ctx, api.PostPullRequestMergeJSONRequestBody{PullRequestId: prID})
+	case gitLabActionClose:
+		_, err = s.prService.ClosePR(ctx, prID)
+	default:
+		return ignored(prID, "unsupported action "+action), nil
+	}
+
+	if err != nil {
+		var domainErr DomainError
+		if errors.As(err, &domainErr) {
+			return ignored(prID, err.Error()), nil
+		}
+		return nil, err
+	}
+
+	return &api.WebhookResult{
+		Status:        api.Processed,
+		PullRequestId: &prID,
+	}, nil
+}
+
+func (s *gitLabService) openPR(ctx context.Context, prID string, event api.GitLabMergeRequestEvent) error {
+	author, err := s.userRepo.GetByExternalLogin(ctx, api.Gitlab, event.User.Username)
+	if err != nil {
+		return err
+	}
+	if author == nil {
+		return NewError(fmt.Sprintf("gitlab user %q is not mapped to a service user", event.User.Username))
+	}
+
+	_, err = s.prService.CreatePR(ctx, api.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   prID,
+		PullRequestName: event.ObjectAttributes.Title,
+		AuthorId:        author.UserId,
+	})
+	if errors.Is(err, ErrPRExists) {
+		_, err = s.prService.ReopenPR(ctx, prID)
+	}
+	return err
+}
+
+func isDraft(event api.GitLabMergeRequestEvent) bool {
+	attrs := event.ObjectAttributes
+	if attrs.Draft != nil {
+		return *attrs.Draft
+	}
+	return attrs.WorkInProgress != nil && *attrs.WorkInProgress
+}
+
+// markedReady сообщает, что update-событие снимает с MR статус черновика.
+func markedReady(event api.GitLabMergeRequestEvent) bool {
+	if event.Changes == nil || event.Changes.Draft == nil {
+		return false
+	}
+	change := event.Changes.Draft
+	return change.Previous != nil && *change.Previous &&
+		change.Current != nil && !*change.Current
+}
+
+func ignored(prID, reason string) *api.WebhookResult {
+	res := &api.WebhookResult{
+		Status: api.Ignored,
+		Reason: &reason,
+	}
+	if prID != "" {
+		res.PullRequestId = &prID
+	}
+	return res
+}
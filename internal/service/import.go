@@ -0,0 +1,347 @@
+package service
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// importRow — один участник команды из импортируемого документа.
+type importRow struct {
+	line   int
+	team   string
+	member api.TeamMember
+}
+
+type importTeamDoc struct {
+	TeamName string      `yaml:"team_name"`
+	Members  []yaml.Node `yaml:"members"`
+}
+
+type importMemberDoc struct {
+	UserID   string `yaml:"user_id"`
+	Username string `yaml:"username"`
+	IsActive *bool  `yaml:"is_active"`
+}
+
+// ImportTeams проверяет документ целиком и, если ошибок нет и это не dry run,
+// применяет его в одной транзакции.
+func (s *teamService) ImportTeams(
+	ctx context.Context,
+	format api.PostAdminImportParamsFormat,
+	data []byte,
+	dryRun bool,
+) (*api.ImportResult, error) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, ErrInvalidArgument
+	}
+
+	var (
+		teams []string
+		rows  []importRow
+		errs  []api.ImportError
+	)
+	switch format {
+	case api.PostAdminImportParamsFormatCsv:
+		teams, rows, errs = parseImportCSV(data)
+	case api.PostAdminImportParamsFormatYaml:
+		teams, rows, errs = parseImportYAML(data)
+	default:
+		return nil, ErrInvalidArgument
+	}
+	errs = append(errs, validateImportRows(rows)...)
+
+	res := &api.ImportResult{
+		DryRun: dryRun,
+		Errors: errs,
+	}
+	if len(errs) > 0 {
+		return res, nil
+	}
+
+	members := make(map[string][]api.TeamMember, len(teams))
+	for _, row := range rows {
+		members[row.team] = append(members[row.team], row.member)
+	}
+	res.MembersUpserted = len(rows)
+
+	if dryRun {
+		for _, team := range teams {
+			exists, err := s.teamRepo.Exists(ctx, team)
+			if err != nil {
+				return nil, err
+			}
+			if !exists {
+				res.TeamsCreated++
+			}
+		}
+		res.Errors = []api.ImportError{}
+		return res, nil
+	}
+
+	err := s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		for _, team := range teams {
+			exists, err := s.teamRepo.Exists(ctx, team)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				if err := s.teamRepo.Create(ctx, team); err != nil {
+					return err
+				}
+				res.TeamsCreated++
+			}
+			if len(members[team]) == 0 {
+				continue
+			}
+			if _, err := s.userRepo.UpsertTeamMembers(ctx, team, members[team]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res.Applied = true
+	res.Errors = []api.ImportError{}
+	return res, nil
+}
+
+func importError(line int, field, msg string) api.ImportError {
+	e := api.ImportError{Line: line, Message: msg}
+	if field != "" {
+		e.Field = &field
+	}
+	return e
+}
+
+// parseImportCSV разбирает CSV с заголовком team_name,user_id,username[,is_active].
+func parseImportCSV(data []byte) ([]string, []importRow, []api.ImportError) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, nil, []api.ImportError{csvReadError(err)}
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	var errs []api.ImportError
+	for _, name := range []string{"team_name", "user_id", "username"} {
+		if _, ok := columns[name]; !ok {
+			errs = append(errs, importError(1, name, "missing column"))
+		}
+	}
+	if len(errs) > 0 {
+		return nil, nil, errs
+	}
+
+	var (
+		teams []string
+		rows  []importRow
+		seen  = map[string]struct{}{}
+	)
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			errs = append(errs, csvReadError(err))
+			break
+		}
+		line, _ := r.FieldPos(0)
+
+		if len(record) != len(header) {
+			errs = append(errs, importError(line, "", fmt.Sprintf(
+				"expected %d fields, got %d", len(header), len(record))))
+			continue
+		}
+
+		get := func(name string) string {
+			i, ok := columns[name]
+			if !ok {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		row := importRow{
+			line: line,
+			team: get("team_name"),
+			member: api.TeamMember{
+				UserId:   get("user_id"),
+				Username: get("username"),
+				IsActive: true,
+			},
+		}
+		if raw := get("is_active"); raw != "" {
+			v, err := strconv.ParseBool(raw)
+			if err != nil {
+				errs = append(errs, importError(line, "is_active", "must be true or false"))
+				continue
+			}
+			row.member.IsActive = v
+		}
+
+		if _, ok := seen[row.team]; !ok && row.team != "" {
+			seen[row.team] = struct{}{}
+			teams = append(teams, row.team)
+		}
+		rows = append(rows, row)
+	}
+
+	return teams, rows, errs
+}
+
+func csvReadError(err error) api.ImportError {
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return importError(parseErr.Line, "", parseErr.Err.Error())
+	}
+	if err == io.EOF {
+		return importError(1, "", "missing header")
+	}
+	return importError(1, "", err.Error())
+}
+
+// parseImportYAML разбирает документ вида teams: [{team_name, members: [...]}].
+func parseImportYAML(data []byte) ([]string, []importRow, []api.ImportError) {
+	var doc struct {
+		Teams []yaml.Node `yaml:"teams"`
+	}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, nil, []api.ImportError{yamlError(err)}
+	}
+	if len(doc.Teams) == 0 {
+		return nil, nil, []api.ImportError{importError(1, "teams", "no teams in document")}
+	}
+
+	var (
+		teams []string
+		rows  []importRow
+		errs  []api.ImportError
+		seen  = map[string]int{}
+	)
+	for i := range doc.Teams {
+		node := &doc.Teams[i]
+
+		var team importTeamDoc
+		if err := node.Decode(&team); err != nil {
+			errs = append(errs, importError(node.Line, "", err.Error()))
+			continue
+		}
+		team.TeamName = strings.TrimSpace(team.TeamName)
+		if team.TeamName == "" {
+			errs = append(errs, importError(node.Line, "team_name", "required"))
+			continue
+		}
+		if prev, ok := seen[team.TeamName]; ok {
+			errs = append(errs, importError(node.Line, "team_name",
+				fmt.Sprintf("duplicate team, first defined on line %d", prev)))
+			continue
+		}
+		seen[team.TeamName] = node.Line
+		teams = append(teams, team.TeamName)
+
+		for j := range team.Members {
+			memberNode := &team.Members[j]
+
+			var m importMemberDoc
+			if err := memberNode.Decode(&m); err != nil {
+				errs = append(errs, importError(memberNode.Line, "", err.Error()))
+				continue
+			}
+			row := importRow{
+				line: memberNode.Line,
+				team: team.TeamName,
+				member: api.TeamMember{
+					UserId:   strings.TrimSpace(m.UserID),
+					Username: strings.TrimSpace(m.Username),
+					IsActive: true,
+				},
+			}
+			if m.IsActive != nil {
+				row.member.IsActive = *m.IsActive
+			}
+			rows = append(rows, row)
+		}
+	}
+
+	return teams, rows, errs
+}
+
+func yamlError(err error) api.ImportError {
+	msg := err.Error()
+	var typeErr *yaml.TypeError
+	if errors.As(err, &typeErr) && len(typeErr.Errors) > 0 {
+		msg = typeErr.Errors[0]
+	}
+	// yaml.v3 не экспортирует номер строки ошибки, он есть только в тексте.
+	line := 1
+	if _, scanErr := fmt.Sscanf(strings.TrimPrefix(msg, "yaml: "), "line %d:", &line); scanErr != nil {
+		line = 1
+	}
+	return importError(line, "", msg)
+}
+
+// validateImportRows проверяет обязательные поля и согласованность данных
+// одного пользователя между строками.
+func validateImportRows(rows []importRow) []api.ImportError {
+	var errs []api.ImportError
+	firstByUser := make(map[string]importRow, len(rows))
+	memberOf := make(map[[2]string]int, len(rows))
+
+	for _, row := range rows {
+		missing := false
+		for _, f := range []struct{ name, value string }{
+			{"team_name", row.team},
+			{"user_id", row.member.UserId},
+			{"username", row.member.Username},
+		} {
+			if f.value == "" {
+				errs = append(errs, importError(row.line, f.name, "required"))
+				missing = true
+			}
+		}
+		if missing {
+			continue
+		}
+
+		key := [2]string{row.team, row.member.UserId}
+		if prev, ok := memberOf[key]; ok {
+			errs = append(errs, importError(row.line, "user_id",
+				fmt.Sprintf("duplicate member of team %q, first listed on line %d", row.team, prev)))
+			continue
+		}
+		memberOf[key] = row.line
+
+		first, ok := firstByUser[row.member.UserId]
+		if !ok {
+			firstByUser[row.member.UserId] = row
+			continue
+		}
+		if first.member.Username != row.member.Username {
+			errs = append(errs, importError(row.line, "username",
+				fmt.Sprintf("conflicts with line %d", first.line)))
+		}
+		if first.member.IsActive != row.member.IsActive {
+			errs = append(errs, importError(row.line, "is_active",
+				fmt.Sprintf("conflicts with line %d", first.line)))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"context"
+	"errors"
+)
+
+// instrumentedPRService сообщает метрикам о созданных PR и переназначениях.
+type instrumentedPRService struct {
+	PRService
+	metrics DomainMetrics
+}
+
+func NewInstrumentedPRService(inner PRService, metrics DomainMetrics) PRService {
+	return &instrumentedPRService{
+		PRService: inner,
+		metrics:   metrics,
+	}
+}
+
+func (s *instrumentedPRService) CreatePR(
+	ctx context.Context,
+	body api.PostPullRequestCreateJSONRequestBody,
+) (*api.PullRequest, error) {
+	pr, err := s.PRService.CreatePR(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+	s.metrics.PRCreated(len(pr.AssignedReviewers))
+	return pr, nil
+}
+
+func (s *instrumentedPRService) ReassignReviewer(
+	ctx context.Context,
+	body api.PostPullRequestReassignJSONRequestBody,
+) (*api.PullRequest, string, error) {
+	pr, newID, err := s.PRService.ReassignReviewer(ctx, body)
+	if err != nil {
+		if errors.Is(err, ErrNoCandidate) {
+			s.metrics.NoCandidate()
+		}
+		return nil, "", err
+	}
+	s.metrics.ReviewerReassigned()
+	return pr, newID, nil
+}
+
+// instrumentedUserService сообщает метрикам об итогах массовой деактивации.
+type instrumentedUserService struct {
+	UserService
+	metrics DomainMetrics
+}
+
+func NewInstrumentedUserService(inner UserService, metrics DomainMetrics) UserService {
+	return &instrumentedUserService{
+		UserService: inner,
+		metrics:     metrics,
+	}
+}
+
+func (s *instrumentedUserService) MassDeactivateTeamUsers(
+	ctx context.Context,
+	teamName string,
+	userIDs []string,
+) (*api.MassDeactivateResult, error) {
+	res, err := s.UserService.MassDeactivateTeamUsers(ctx, teamName, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	s.metrics.MassDeactivation(*res)
+	return res, nil
+}
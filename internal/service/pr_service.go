@@ -4,13 +4,161 @@ import (
 	"avito-autumn2025-internship/internal/api"
 	"avito-autumn2025-internship/internal/repository"
 	"context"
+	"fmt"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 	"math/rand"
+	"sort"
 	"time"
 )
 
+const defaultReviewerCount = 2
+
 type prService struct {
 	prRepo   repository.PRRepository
 	userRepo repository.UserRepository
+	repoRepo repository.RepoRepository
+	teamRepo repository.TeamRepository
+}
+
+// assignmentPolicy описывает, сколько и откуда назначать ревьюверов.
+type assignmentPolicy struct {
+	reviewerCount int
+	strategy      api.AssignmentStrategy
+	// reviewerTeam — команда, из которой берутся кандидаты (по умолчанию команда автора).
+	reviewerTeam string
+	// siblingFallback разрешает добирать кандидатов из соседних и родительских команд.
+	siblingFallback bool
+	// chain — reviewerTeam и её предки, начиная с неё самой.
+	chain []repository.TeamNode
+}
+
+// assignmentPolicy собирает политику: умолчания, затем настройки команды
+// с наследованием по дереву, затем явные настройки репозитория.
+func (s *prService) assignmentPolicy(
+	ctx context.Context,
+	repositoryID *string,
+	authorTeam string,
+) (assignmentPolicy, error) {
+	policy := assignmentPolicy{reviewerTeam: authorTeam}
+
+	var repo *api.Repository
+	if repositoryID != nil && *repositoryID != "" {
+		var err error
+		repo, err = s.repoRepo.GetByID(ctx, *repositoryID)
+		if err != nil {
+			return policy, err
+		}
+		if repo == nil {
+			return policy, ErrNotFound
+		}
+		if repo.ReviewerSource == api.OwnerTeam {
+			policy.reviewerTeam = repo.TeamName
+		}
+	}
+
+	if policy.reviewerTeam != "" {
+		chain, err := s.teamRepo.Ancestors(ctx, policy.reviewerTeam)
+		if err != nil {
+			return policy, err
+		}
+		policy.chain = chain
+	}
+
+	team := effectiveTeamPolicy(policy.chain)
+	policy.reviewerCount = *team.ReviewerCount
+	policy.strategy = *team.AssignmentStrategy
+	policy.siblingFallback = *team.SiblingFallback
+
+	if repo != nil {
+		if repo.ReviewerCount != nil {
+			policy.reviewerCount = *repo.ReviewerCount
+		}
+		if repo.AssignmentStrategy != nil {
+			policy.strategy = *repo.AssignmentStrategy
+		}
+	}
+	return policy, nil
+}
+
+// fallbackCandidates поднимается по дереву от команды chain[0]: на каждом уровне
+// берёт активных участников соседних команд, затем родительской, пока не наберёт need.
+func (s *prService) fallbackCandidates(
+	ctx context.Context,
+	chain []repository.TeamNode,
+	exclude map[string]struct{},
+	need int,
+) ([]api.User, error) {
+	seen := make(map[string]struct{}, len(exclude))
+	for id := range exclude {
+		seen[id] = struct{}{}
+	}
+
+	var res []api.User
+	collect := func(teamName string) error {
+		members, err := s.userRepo.ListActiveByTeam(ctx, teamName)
+		if err != nil {
+			return err
+		}
+		for _, u := range members {
+			if _, skip := seen[u.UserId]; skip {
+				continue
+			}
+			seen[u.UserId] = struct{}{}
+			res = append(res, u)
+		}
+		return nil
+	}
+
+	for _, node := range chain {
+		if node.Parent == nil || len(res) >= need {
+			break
+		}
+
+		siblings, err := s.teamRepo.Subtree(ctx, *node.Parent)
+		if err != nil {
+			return nil, err
+		}
+		for _, sibling := range siblings {
+			if sibling.Depth != 1 || sibling.Name == node.Name {
+				continue
+			}
+			if err := collect(sibling.Name); err != nil {
+				return nil, err
+			}
+		}
+		if err := collect(*node.Parent); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (s *prService) pickReviewers(
+	ctx context.Context,
+	candidates []api.User,
+	max int,
+	strategy api.AssignmentStrategy,
+) ([]string, error) {
+	if strategy != api.LeastLoaded || len(candidates) <= max {
+		return chooseRandomReviewers(candidates, max), nil
+	}
+
+	ids := make([]string, 0, len(candidates))
+	for _, u := range candidates {
+		ids = append(ids, u.UserId)
+	}
+	load, err := s.prRepo.CountOpenAssignments(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	rand.Shuffle(len(ids), func(i, j int) { ids[i], ids[j] = ids[j], ids[i] })
+	sort.SliceStable(ids, func(i, j int) bool { return load[ids[i]] < load[ids[j]] })
+
+	if len(ids) > max {
+		ids = ids[:max]
+	}
+	return ids, nil
 }
 
 func (s *prService) CreatePR(ctx context.Context, body api.PostPullRequestCreateJSONRequestBody) (*api.PullRequest, error) {
@@ -34,7 +182,12 @@ func (s *prService) CreatePR(ctx context.Context, body api.PostPullRequestCreate
 		return nil, ErrNotFound
 	}
 
-	teamName := author.TeamName
+	policy, err := s.assignmentPolicy(ctx, body.Repository, author.TeamName)
+	if err != nil {
+		return nil, err
+	}
+
+	teamName := policy.reviewerTeam
 	if teamName == "" {
 		return nil, ErrNotFound
 	}
@@ -52,7 +205,28 @@ func (s *prService) CreatePR(ctx context.Context, body api.PostPullRequestCreate
 		candidates = append(candidates, u)
 	}
 
-	assigned := chooseRandomReviewers(candidates, 2)
+	assigned, err := s.pickReviewers(ctx, candidates, policy.reviewerCount, policy.strategy)
+	if err != nil {
+		return nil, err
+	}
+
+	if policy.siblingFallback && len(assigned) < policy.reviewerCount {
+		exclude := map[string]struct{}{author.UserId: {}}
+		for _, id := range assigned {
+			exclude[id] = struct{}{}
+		}
+		need := policy.reviewerCount - len(assigned)
+
+		extra, err := s.fallbackCandidates(ctx, policy.chain, exclude, need)
+		if err != nil {
+			return nil, err
+		}
+		more, err := s.pickReviewers(ctx, extra, need, policy.strategy)
+		if err != nil {
+			return nil, err
+		}
+		assigned = append(assigned, more...)
+	}
 
 	now := time.Now().UTC()
 	var mergedAt *time.Time
@@ -65,6 +239,7 @@ func (s *prService) CreatePR(ctx context.Context, body api.PostPullRequestCreate
 		CreatedAt:         &now,
 		MergedAt:          mergedAt,
 		AssignedReviewers: assigned,
+		Repository:        body.Repository,
 	}
 
 	if err := s.prRepo.Create(ctx, pr); err != nil {
@@ -117,6 +292,37 @@ func (s *prService) MergePR(ctx context.Context, body api.PostPullRequestMergeJS
 	return updated, nil
 }
 
+func (s *prService) ClosePR(ctx context.Context, prID string) (*api.PullRequest, error) {
+	return s.changeStatus(ctx, prID, api.PullRequestStatusCLOSED)
+}
+
+func (s *prService) ReopenPR(ctx context.Context, prID string) (*api.PullRequest, error) {
+	return s.changeStatus(ctx, prID, api.PullRequestStatusOPEN)
+}
+
+func (s *prService) changeStatus(ctx context.Context, prID string, status api.PullRequestStatus) (*api.PullRequest, error) {
+	if prID == "" {
+		return nil, ErrNotFound
+	}
+
+	pr, err := s.prRepo.GetByID(ctx, prID)
+	if err != nil {
+		return nil, err
+	}
+	if pr == nil {
+		return nil, ErrNotFound
+	}
+
+	if pr.Status == api.PullRequestStatusMERGED {
+		return nil, ErrPRMerged
+	}
+	if pr.Status == status {
+		return pr, nil
+	}
+
+	return s.prRepo.SetStatus(ctx, prID, status)
+}
+
 func (s *prService) ReassignReviewer(
 	ctx context.Context,
 	body api.PostPullRequestReassignJSONRequestBody,
@@ -136,6 +342,9 @@ func (s *prService) ReassignReviewer(
 	if pr.Status == api.PullRequestStatusMERGED {
 		return nil, "", ErrPRMerged
 	}
+	if pr.Status == api.PullRequestStatusCLOSED {
+		return nil, "", ErrPRClosed
+	}
 
 	reviewers := pr.AssignedReviewers
 	found := false
@@ -156,7 +365,24 @@ func (s *prService) ReassignReviewer(
 	if oldUser == nil {
 		return nil, "", ErrNotFound
 	}
-	teamName := oldUser.TeamName
+
+	var authorTeam string
+	author, err := s.userRepo.GetByID(ctx, pr.AuthorId)
+	if err != nil {
+		return nil, "", err
+	}
+	if author != nil {
+		authorTeam = author.TeamName
+	}
+
+	policy, err := s.assignmentPolicy(ctx, pr.Repository, authorTeam)
+	if err != nil {
+		return nil, "", err
+	}
+	teamName, err := s.replacementTeam(ctx, policy, oldUser)
+	if err != nil {
+		return nil, "", err
+	}
 	if teamName == "" {
 		return nil, "", ErrNotFound
 	}
@@ -181,11 +407,29 @@ func (s *prService) ReassignReviewer(
 		candidates = append(candidates, u)
 	}
 
+	if len(candidates) == 0 && policy.siblingFallback {
+		chain := policy.chain
+		if teamName != policy.reviewerTeam {
+			chain, err = s.teamRepo.Ancestors(ctx, teamName)
+			if err != nil {
+				return nil, "", err
+			}
+		}
+		candidates, err = s.fallbackCandidates(ctx, chain, exclude, 1)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
 	if len(candidates) == 0 {
 		return nil, "", ErrNoCandidate
 	}
 
-	newID := chooseRandomReviewers(candidates, 1)[0]
+	picked, err := s.pickReviewers(ctx, candidates, 1, policy.strategy)
+	if err != nil {
+		return nil, "", err
+	}
+	newID := picked[0]
 
 	if err := s.prRepo.ReplaceReviewer(ctx, pr.PullRequestId, body.OldUserId, newID); err != nil {
 		return nil, "", err
@@ -201,17 +445,127 @@ func (s *prService) ReassignReviewer(
 	return pr, newID, nil
 }
 
-func (s *prService) GetReviewerAssignments(ctx context.Context) ([]api.ReviewerStat, error) {
-	stats, err := s.prRepo.GetReviewerAssignmentsStats(ctx)
+// replacementTeam выбирает команду, из которой ищется замена ревьюверу: команду,
+// из которой назначались ревьюверы PR, если ревьювер в ней состоит, иначе его основную.
+func (s *prService) replacementTeam(
+	ctx context.Context,
+	policy assignmentPolicy,
+	oldUser *api.User,
+) (string, error) {
+	reviewerTeam := policy.reviewerTeam
+	if reviewerTeam == "" || reviewerTeam == oldUser.TeamName {
+		return oldUser.TeamName, nil
+	}
+
+	teams, err := s.userRepo.ListTeams(ctx, oldUser.UserId)
+	if err != nil {
+		return "", err
+	}
+	for _, team := range teams {
+		if team == reviewerTeam {
+			return reviewerTeam, nil
+		}
+	}
+	return oldUser.TeamName, nil
+}
+
+func (s *prService) GetReviewerAssignments(
+	ctx context.Context,
+	params api.GetStatsReviewerAssignmentsParams,
+) ([]api.ReviewerStat, error) {
+	if params.From != nil && params.To != nil && !params.From.Before(*params.To) {
+		return nil, fmt.Errorf("%w: from must be before to", ErrInvalidArgument)
+	}
+
+	filter := repository.StatsFilter{
+		Repository: params.Repository,
+		From:       params.From,
+		To:         params.To,
+	}
+	if params.Status != nil {
+		status := api.PullRequestStatus(*params.Status)
+		if status != api.PullRequestStatusOPEN && status != api.PullRequestStatusMERGED &&
+			status != api.PullRequestStatusCLOSED {
+			return nil, fmt.Errorf("%w: unknown status %q", ErrInvalidArgument, status)
+		}
+		filter.Status = &status
+	}
+	if params.GroupBy != nil {
+		switch *params.GroupBy {
+		case api.GetStatsReviewerAssignmentsParamsGroupByTeam:
+			filter.GroupBy = repository.StatsGroupByTeam
+		case api.GetStatsReviewerAssignmentsParamsGroupByWeek:
+			filter.GroupBy = repository.StatsGroupByWeek
+		default:
+			return nil, fmt.Errorf("%w: unknown group_by %q", ErrInvalidArgument, *params.GroupBy)
+		}
+	}
+	if params.Team != nil && *params.Team != "" {
+		subtree, err := s.teamRepo.Subtree(ctx, *params.Team)
+		if err != nil {
+			return nil, err
+		}
+		if len(subtree) == 0 {
+			return nil, ErrNotFound
+		}
+		for _, node := range subtree {
+			filter.Teams = append(filter.Teams, node.Name)
+		}
+	}
+
+	stats, err := s.prRepo.GetReviewerAssignmentsStats(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
 
 	result := make([]api.ReviewerStat, 0, len(stats))
 	for _, st := range stats {
-		result = append(result, api.ReviewerStat{
+		stat := api.ReviewerStat{
 			UserId:        st.UserID,
 			AssignedCount: st.Count,
+			TeamName:      st.TeamName,
+		}
+		if st.WeekStart != nil {
+			stat.WeekStart = &openapi_types.Date{Time: *st.WeekStart}
+		}
+		result = append(result, stat)
+	}
+	return result, nil
+}
+
+func (s *prService) GetTurnaround(
+	ctx context.Context,
+	params api.GetStatsTurnaroundParams,
+) ([]api.TurnaroundStat, error) {
+	if params.From != nil && params.To != nil && !params.From.Before(*params.To) {
+		return nil, fmt.Errorf("%w: from must be before to", ErrInvalidArgument)
+	}
+
+	filter := repository.TurnaroundFilter{From: params.From, To: params.To}
+	switch params.GroupBy {
+	case api.GetStatsTurnaroundParamsGroupByTeam:
+		filter.GroupBy = repository.StatsGroupByTeam
+	case api.GetStatsTurnaroundParamsGroupByAuthor:
+		filter.GroupBy = repository.StatsGroupByAuthor
+	case api.GetStatsTurnaroundParamsGroupByReviewer:
+		filter.GroupBy = repository.StatsGroupByReviewer
+	default:
+		return nil, fmt.Errorf("%w: unknown group_by %q", ErrInvalidArgument, params.GroupBy)
+	}
+
+	stats, err := s.prRepo.GetTurnaroundStats(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]api.TurnaroundStat, 0, len(stats))
+	for _, st := range stats {
+		result = append(result, api.TurnaroundStat{
+			Key:           st.Key,
+			MergedCount:   st.MergedCount,
+			MedianSeconds: st.MedianSeconds,
+			P90Seconds:    st.P90Seconds,
+			MeanSeconds:   st.MeanSeconds,
 		})
 	}
 	return result, nil
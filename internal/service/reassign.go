@@ -0,0 +1,107 @@
+package service
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/repository"
+	"context"
+)
+
+// reassignOpenReviews передаёт открытые ревью reviewerID случайным кандидатам,
+// исключая автора PR и уже назначенных ревьюверов.
+func reassignOpenReviews(
+	ctx context.Context,
+	prRepo repository.PRRepository,
+	reviewerID string,
+	candidates []api.User,
+) (reassigned, notReassigned int, err error) {
+	prs, err := prRepo.ListShortByReviewer(ctx, reviewerID)
+	if err != nil {
+		return 0, 0, err
+	}
+	return reassignReviews(ctx, prRepo, reviewerID, prs, candidates)
+}
+
+// reassignReviews передаёт кандидатам ревью reviewerID в открытых PR из prs.
+func reassignReviews(
+	ctx context.Context,
+	prRepo repository.PRRepository,
+	reviewerID string,
+	prs []api.PullRequestShort,
+	candidates []api.User,
+) (reassigned, notReassigned int, err error) {
+	for _, short := range prs {
+		if short.Status != api.PullRequestShortStatusOPEN {
+			continue
+		}
+
+		pr, err := prRepo.GetByID(ctx, short.PullRequestId)
+		if err != nil {
+			return 0, 0, err
+		}
+		if pr == nil {
+			continue
+		}
+
+		found := false
+		for _, r := range pr.AssignedReviewers {
+			if r == reviewerID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			continue
+		}
+		exclude := make(map[string]struct{}, len(pr.AssignedReviewers)+1)
+		exclude[pr.AuthorId] = struct{}{}
+		for _, r := range pr.AssignedReviewers {
+			exclude[r] = struct{}{}
+		}
+
+		localCandidates := make([]api.User, 0, len(candidates))
+		for _, u := range candidates {
+			if _, skip := exclude[u.UserId]; skip {
+				continue
+			}
+			localCandidates = append(localCandidates, u)
+		}
+
+		newIDs := chooseRandomReviewers(localCandidates, 1)
+		if len(newIDs) == 0 {
+			notReassigned++
+			continue
+		}
+
+		if err := prRepo.ReplaceReviewer(ctx, pr.PullRequestId, reviewerID, newIDs[0]); err != nil {
+			return 0, 0, err
+		}
+		reassigned++
+	}
+
+	return reassigned, notReassigned, nil
+}
+
+// activeTeammates возвращает активных участников перечисленных команд, кроме самого userID.
+func activeTeammates(
+	ctx context.Context,
+	userRepo repository.UserRepository,
+	userID string,
+	teams []string,
+) ([]api.User, error) {
+	seen := map[string]struct{}{userID: {}}
+	var candidates []api.User
+	for _, team := range teams {
+		members, err := userRepo.ListActiveByTeam(ctx, team)
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range members {
+			if _, ok := seen[u.UserId]; ok {
+				continue
+			}
+			seen[u.UserId] = struct{}{}
+			candidates = append(candidates, u)
+		}
+	}
+	return candidates, nil
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/repository"
+	"context"
+)
+
+const maxReviewerCount = 10
+
+type repositoryService struct {
+	repoRepo repository.RepoRepository
+	teamRepo repository.TeamRepository
+}
+
+func (s *repositoryService) UpsertRepository(
+	ctx context.Context,
+	body api.PostRepositoryUpsertJSONRequestBody,
+) (*api.Repository, error) {
+	if body.RepositoryId == "" || body.TeamName == "" {
+		return nil, ErrInvalidArgument
+	}
+	if body.ReviewerSource == "" {
+		body.ReviewerSource = api.AuthorTeam
+	}
+	if body.ReviewerSource != api.AuthorTeam && body.ReviewerSource != api.OwnerTeam {
+		return nil, ErrInvalidArgument
+	}
+	if body.AssignmentStrategy != nil &&
+		*body.AssignmentStrategy != api.Random && *body.AssignmentStrategy != api.LeastLoaded {
+		return nil, ErrInvalidArgument
+	}
+	if body.ReviewerCount != nil && (*body.ReviewerCount < 0 || *body.ReviewerCount > maxReviewerCount) {
+		return nil, ErrInvalidArgument
+	}
+
+	exists, err := s.teamRepo.Exists(ctx, body.TeamName)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrNotFound
+	}
+
+	return s.repoRepo.Upsert(ctx, body)
+}
+
+func (s *repositoryService) GetRepository(ctx context.Context, repositoryID string) (*api.Repository, error) {
+	if repositoryID == "" {
+		return nil, ErrNotFound
+	}
+
+	repo, err := s.repoRepo.GetByID(ctx, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	if repo == nil {
+		return nil, ErrNotFound
+	}
+	return repo, nil
+}
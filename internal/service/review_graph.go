@@ -0,0 +1,75 @@
+package service
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/repository"
+	"context"
+	"fmt"
+	"sort"
+)
+
+// GetReviewGraph строит граф «автор → ревьювер» и отмечает авторов команд,
+// чьи PR за период ревьюил только один человек.
+func (s *prService) GetReviewGraph(ctx context.Context, params api.GetStatsReviewGraphParams) (*api.ReviewGraph, error) {
+	if params.From != nil && params.To != nil && !params.From.Before(*params.To) {
+		return nil, fmt.Errorf("%w: from must be before to", ErrInvalidArgument)
+	}
+
+	root := ""
+	if params.Team != nil {
+		root = *params.Team
+	}
+	subtree, err := s.teamRepo.Subtree(ctx, root)
+	if err != nil {
+		return nil, err
+	}
+	if root != "" && len(subtree) == 0 {
+		return nil, ErrNotFound
+	}
+
+	filter := repository.ReviewGraphFilter{From: params.From, To: params.To}
+	if root != "" {
+		for _, node := range subtree {
+			filter.AuthorTeams = append(filter.AuthorTeams, node.Name)
+		}
+	}
+	edges, err := s.prRepo.GetReviewGraph(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	graph := &api.ReviewGraph{
+		Edges:     make([]api.ReviewGraphEdge, 0, len(edges)),
+		BusFactor: []api.BusFactorHint{},
+	}
+	byAuthor := make(map[string][]repository.ReviewEdge)
+	for _, e := range edges {
+		graph.Edges = append(graph.Edges, api.ReviewGraphEdge{
+			AuthorId:   e.AuthorID,
+			ReviewerId: e.ReviewerID,
+			Weight:     e.Count,
+		})
+		byAuthor[e.AuthorID] = append(byAuthor[e.AuthorID], e)
+	}
+
+	for _, node := range subtree {
+		members, err := s.userRepo.ListByTeam(ctx, node.Name)
+		if err != nil {
+			return nil, err
+		}
+		sort.Slice(members, func(i, j int) bool { return members[i].UserId < members[j].UserId })
+		for _, m := range members {
+			authorEdges := byAuthor[m.UserId]
+			if len(authorEdges) != 1 {
+				continue
+			}
+			graph.BusFactor = append(graph.BusFactor, api.BusFactorHint{
+				TeamName:     node.Name,
+				AuthorId:     m.UserId,
+				ReviewerId:   authorEdges[0].ReviewerID,
+				PullRequests: authorEdges[0].Count,
+			})
+		}
+	}
+	return graph, nil
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+const reviewerSyncTimeout = 30 * time.Second
+
+// reviewerSyncPRService дублирует выбор ревьюверов во внешний хостинг кода.
+// Источником истины остаётся БД сервиса, поэтому ошибки коннектора только логируются.
+// Синхронизации одного PR выполняются по очереди в порядке вызовов, чтобы
+// снятие ревьювера не обогнало его запрос.
+type reviewerSyncPRService struct {
+	PRService
+	connector ReviewerConnector
+	wg        *sync.WaitGroup
+
+	mu     sync.Mutex
+	queues map[string][]reviewerSyncTask
+}
+
+type reviewerSyncTask struct {
+	ctx context.Context
+	fn  func(ctx context.Context) error
+}
+
+// NewReviewerSyncPRService учитывает фоновые синхронизации в wg, чтобы
+// при остановке их можно было дождаться.
+func NewReviewerSyncPRService(inner PRService, connector ReviewerConnector, wg *sync.WaitGroup) PRService {
+	return &reviewerSyncPRService{
+		PRService: inner,
+		connector: connector,
+		wg:        wg,
+		queues:    make(map[string][]reviewerSyncTask),
+	}
+}
+
+func (s *reviewerSyncPRService) CreatePR(
+	ctx context.Context,
+	body api.PostPullRequestCreateJSONRequestBody,
+) (*api.PullRequest, error) {
+	pr, err := s.PRService.CreatePR(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pr.AssignedReviewers) > 0 {
+		reviewers := append([]string(nil), pr.AssignedReviewers...)
+		s.sync(ctx, pr.PullRequestId, func(ctx context.Context) error {
+			return s.connector.RequestReviewers(ctx, pr.PullRequestId, reviewers)
+		})
+	}
+	return pr, nil
+}
+
+func (s *reviewerSyncPRService) ReassignReviewer(
+	ctx context.Context,
+	body api.PostPullRequestReassignJSONRequestBody,
+) (*api.PullRequest, string, error) {
+	pr, newID, err := s.PRService.ReassignReviewer(ctx, body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	s.sync(ctx, pr.PullRequestId, func(ctx context.Context) error {
+		if err := s.connector.RequestReviewers(ctx, body.PullRequestId, []string{newID}); err != nil {
+			return err
+		}
+		return s.connector.RemoveReviewers(ctx, body.PullRequestId, []string{body.OldUserId})
+	})
+	return pr, newID, nil
+}
+
+func (s *reviewerSyncPRService) sync(ctx context.Context, prID string, fn func(ctx context.Context) error) {
+	s.mu.Lock()
+	queue, running := s.queues[prID]
+	s.queues[prID] = append(queue, reviewerSyncTask{ctx: context.WithoutCancel(ctx), fn: fn})
+	if !running {
+		s.wg.Add(1)
+		go s.drain(prID)
+	}
+	s.mu.Unlock()
+}
+
+// drain выполняет очередь PR, пока в ней есть задачи.
+func (s *reviewerSyncPRService) drain(prID string) {
+	defer s.wg.Done()
+	for {
+		s.mu.Lock()
+		queue := s.queues[prID]
+		if len(queue) == 0 {
+			delete(s.queues, prID)
+			s.mu.Unlock()
+			return
+		}
+		task := queue[0]
+		s.queues[prID] = queue[1:]
+		s.mu.Unlock()
+
+		ctx, cancel := context.WithTimeout(task.ctx, reviewerSyncTimeout)
+		if err := task.fn(ctx); err != nil {
+			log.Printf("reviewer sync for %s failed: %v", prID, err)
+		}
+		cancel()
+	}
+}
@@ -0,0 +1,414 @@
+package service
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/repository"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	scimUserSchema  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	scimGroupSchema = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	scimListSchema  = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+
+	scimDefaultCount = 100
+	scimMaxCount     = 200
+)
+
+type scimService struct {
+	teamSvc   TeamService
+	userRepo  repository.UserRepository
+	teamRepo  repository.TeamRepository
+	prRepo    repository.PRRepository
+	txManager repository.TxManager
+}
+
+func (s *scimService) ListUsers(ctx context.Context, params api.GetScimV2UsersParams) (*api.ScimUserList, error) {
+	terms, err := parseSCIMFilter(params.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := repository.UserFilter{SortBy: repository.UserSortByID}
+	for _, t := range terms {
+		switch t.attr {
+		case "username":
+			v := t.value
+			filter.Username = &v
+		case "active":
+			v, err := parseSCIMBool(t.value)
+			if err != nil {
+				return nil, err
+			}
+			filter.IsActive = &v
+		default:
+			return nil, fmt.Errorf("%w: unsupported filter attribute %q", ErrInvalidArgument, t.attr)
+		}
+	}
+
+	startIndex, count := scimPage(params.StartIndex, params.Count)
+	filter.Offset = startIndex - 1
+	filter.Limit = count
+
+	total, err := s.userRepo.Count(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var users []api.User
+	if count > 0 {
+		users, err = s.userRepo.List(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res := &api.ScimUserList{
+		Schemas:      []string{scimListSchema},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(users),
+		Resources:    make([]api.ScimUser, 0, len(users)),
+	}
+	for _, u := range users {
+		var teams []string
+		if u.Teams != nil {
+			teams = *u.Teams
+		}
+		res.Resources = append(res.Resources, scimUser(u, teams))
+	}
+	return res, nil
+}
+
+func (s *scimService) GetUser(ctx context.Context, id string) (*api.ScimUser, error) {
+	user, err := s.userRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrNotFound
+	}
+
+	teams, err := s.userRepo.ListTeams(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	res := scimUser(*user, teams)
+	return &res, nil
+}
+
+func (s *scimService) CreateUser(ctx context.Context, body api.ScimUser) (*api.ScimUser, error) {
+	userName := strings.TrimSpace(body.UserName)
+	if userName == "" {
+		return nil, ErrInvalidArgument
+	}
+	userID := userName
+	if body.ExternalId != nil && *body.ExternalId != "" {
+		userID = *body.ExternalId
+	}
+
+	existing, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, ErrUserExists
+	}
+	if err := s.ensureUserNameFree(ctx, userID, userName); err != nil {
+		return nil, err
+	}
+
+	active := body.Active == nil || *body.Active
+	if _, err := s.userRepo.UpsertUser(ctx, userID, userName, active); err != nil {
+		return nil, err
+	}
+	return s.GetUser(ctx, userID)
+}
+
+func (s *scimService) ReplaceUser(ctx context.Context, id string, body api.ScimUser) (*api.ScimUser, error) {
+	active := body.Active == nil || *body.Active
+	return s.updateUser(ctx, id, body.UserName, active)
+}
+
+// PatchUser применяет операции PATCH к userName и active; остальные атрибуты
+// SCIM сервис не хранит и пропускает.
+func (s *scimService) PatchUser(ctx context.Context, id string, body api.ScimPatchRequest) (*api.ScimUser, error) {
+	user, err := s.userRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrNotFound
+	}
+
+	userName, active := user.Username, user.IsActive
+	set := func(attr string, value any) error {
+		switch strings.ToLower(attr) {
+		case "username":
+			v, ok := value.(string)
+			if !ok {
+				return fmt.Errorf("%w: userName must be a string", ErrInvalidArgument)
+			}
+			userName = v
+		case "active":
+			v, err := scimBoolValue(value)
+			if err != nil {
+				return err
+			}
+			active = v
+		}
+		return nil
+	}
+
+	for _, op := range body.Operations {
+		path := ""
+		if op.Path != nil {
+			path = *op.Path
+		}
+		var value any
+		if op.Value != nil {
+			value = *op.Value
+		}
+
+		switch strings.ToLower(op.Op) {
+		case "add", "replace":
+			if path != "" {
+				if err := set(path, value); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			attrs, ok := value.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("%w: value must be an object", ErrInvalidArgument)
+			}
+			for attr, v := range attrs {
+				if err := set(attr, v); err != nil {
+					return nil, err
+				}
+			}
+		case "remove":
+			switch strings.ToLower(path) {
+			case "username", "active":
+				return nil, fmt.Errorf("%w: %s cannot be removed", ErrInvalidArgument, path)
+			}
+		default:
+			return nil, fmt.Errorf("%w: unsupported op %q", ErrInvalidArgument, op.Op)
+		}
+	}
+
+	return s.updateUser(ctx, id, userName, active)
+}
+
+// DeactivateUser отвечает на DELETE: пользователь остаётся в базе ради истории PR.
+func (s *scimService) DeactivateUser(ctx context.Context, id string) error {
+	user, err := s.userRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return ErrNotFound
+	}
+	_, err = s.updateUser(ctx, id, user.Username, false)
+	return err
+}
+
+// updateUser сохраняет имя и активность; при деактивации открытые ревью
+// переходят к активным участникам команд пользователя, как в /team/massDeactivate.
+func (s *scimService) updateUser(ctx context.Context, id, userName string, active bool) (*api.ScimUser, error) {
+	userName = strings.TrimSpace(userName)
+	if userName == "" {
+		return nil, ErrInvalidArgument
+	}
+
+	user, err := s.userRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrNotFound
+	}
+	if !strings.EqualFold(userName, user.Username) {
+		if err := s.ensureUserNameFree(ctx, id, userName); err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if _, err := s.userRepo.UpsertUser(ctx, id, userName, active); err != nil {
+			return err
+		}
+		if active || !user.IsActive {
+			return nil
+		}
+
+		teams, err := s.userRepo.ListTeams(ctx, id)
+		if err != nil {
+			return err
+		}
+		candidates, err := activeTeammates(ctx, s.userRepo, id, teams)
+		if err != nil {
+			return err
+		}
+		_, _, err = reassignOpenReviews(ctx, s.prRepo, id, candidates)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetUser(ctx, id)
+}
+
+// ensureUserNameFree проверяет уникальность userName, которую требует SCIM.
+func (s *scimService) ensureUserNameFree(ctx context.Context, userID, userName string) error {
+	same, err := s.userRepo.List(ctx, repository.UserFilter{
+		Username: &userName,
+		SortBy:   repository.UserSortByID,
+		Limit:    2,
+	})
+	if err != nil {
+		return err
+	}
+	for _, u := range same {
+		if u.UserId != userID {
+			return ErrUserExists
+		}
+	}
+	return nil
+}
+
+func scimUser(u api.User, teams []string) api.ScimUser {
+	id := u.UserId
+	active := u.IsActive
+	location := "/scim/v2/Users/" + u.UserId
+	groups := make([]api.ScimMemberRef, 0, len(teams))
+	for _, team := range teams {
+		groups = append(groups, api.ScimMemberRef{Value: team})
+	}
+	return api.ScimUser{
+		Schemas:  []string{scimUserSchema},
+		Id:       &id,
+		UserName: u.Username,
+		Active:   &active,
+		Groups:   &groups,
+		Meta: &api.ScimMeta{
+			ResourceType: api.ScimMetaResourceTypeUser,
+			Location:     &location,
+		},
+	}
+}
+
+// scimPage приводит startIndex и count к допустимым значениям, как разрешает RFC 7644.
+func scimPage(startIndex, count *int) (int, int) {
+	start, n := 1, scimDefaultCount
+	if startIndex != nil && *startIndex > 1 {
+		start = *startIndex
+	}
+	if count != nil {
+		n = *count
+	}
+	if n < 0 {
+		n = 0
+	}
+	if n > scimMaxCount {
+		n = scimMaxCount
+	}
+	return start, n
+}
+
+type scimFilterTerm struct {
+	attr  string
+	value string
+}
+
+// parseSCIMFilter разбирает подмножество фильтров SCIM: `attr eq value`,
+// объединённые через `and`. Имена атрибутов приводятся к нижнему регистру.
+func parseSCIMFilter(filter *string) ([]scimFilterTerm, error) {
+	if filter == nil || strings.TrimSpace(*filter) == "" {
+		return nil, nil
+	}
+
+	tokens, err := scimFilterTokens(*filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var terms []scimFilterTerm
+	for i := 0; i < len(tokens); {
+		if i+3 > len(tokens) || !strings.EqualFold(tokens[i+1], "eq") {
+			return nil, fmt.Errorf("%w: only `attr eq value` filters are supported", ErrInvalidArgument)
+		}
+		terms = append(terms, scimFilterTerm{
+			attr:  strings.ToLower(tokens[i]),
+			value: tokens[i+2],
+		})
+		i += 3
+		if i == len(tokens) {
+			break
+		}
+		if !strings.EqualFold(tokens[i], "and") {
+			return nil, fmt.Errorf("%w: only `and` is supported in filters", ErrInvalidArgument)
+		}
+		i++
+		if i == len(tokens) {
+			return nil, fmt.Errorf("%w: dangling `and` in filter", ErrInvalidArgument)
+		}
+	}
+	return terms, nil
+}
+
+// scimFilterTokens делит фильтр на слова, снимая кавычки со строковых значений.
+func scimFilterTokens(filter string) ([]string, error) {
+	var tokens []string
+	for i := 0; i < len(filter); {
+		switch c := filter[i]; {
+		case c == ' ' || c == '\t':
+			i++
+		case c == '"':
+			end := i + 1
+			var sb strings.Builder
+			for ; end < len(filter) && filter[end] != '"'; end++ {
+				if filter[end] == '\\' && end+1 < len(filter) {
+					end++
+				}
+				sb.WriteByte(filter[end])
+			}
+			if end >= len(filter) {
+				return nil, fmt.Errorf("%w: unterminated string in filter", ErrInvalidArgument)
+			}
+			tokens = append(tokens, sb.String())
+			i = end + 1
+		default:
+			end := i
+			for end < len(filter) && filter[end] != ' ' && filter[end] != '\t' {
+				end++
+			}
+			tokens = append(tokens, filter[i:end])
+			i = end
+		}
+	}
+	return tokens, nil
+}
+
+func parseSCIMBool(s string) (bool, error) {
+	v, err := strconv.ParseBool(strings.ToLower(s))
+	if err != nil {
+		return false, fmt.Errorf("%w: %q is not a boolean", ErrInvalidArgument, s)
+	}
+	return v, nil
+}
+
+// scimBoolValue принимает и JSON-булево, и строку: некоторые IdP присылают "False".
+func scimBoolValue(v any) (bool, error) {
+	switch b := v.(type) {
+	case bool:
+		return b, nil
+	case string:
+		return parseSCIMBool(b)
+	default:
+		return false, fmt.Errorf("%w: active must be a boolean", ErrInvalidArgument)
+	}
+}
@@ -0,0 +1,344 @@
+package service
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+func (s *scimService) ListGroups(ctx context.Context, params api.GetScimV2GroupsParams) (*api.ScimGroupList, error) {
+	terms, err := parseSCIMFilter(params.Filter)
+	if err != nil {
+		return nil, err
+	}
+	var displayName *string
+	for _, t := range terms {
+		if t.attr != "displayname" {
+			return nil, fmt.Errorf("%w: unsupported filter attribute %q", ErrInvalidArgument, t.attr)
+		}
+		v := t.value
+		displayName = &v
+	}
+
+	nodes, err := s.teamRepo.Subtree(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		if displayName != nil && node.Name != *displayName {
+			continue
+		}
+		names = append(names, node.Name)
+	}
+	sort.Strings(names)
+
+	startIndex, count := scimPage(params.StartIndex, params.Count)
+	page := names[min(startIndex-1, len(names)):]
+	page = page[:min(count, len(page))]
+
+	res := &api.ScimGroupList{
+		Schemas:      []string{scimListSchema},
+		TotalResults: len(names),
+		StartIndex:   startIndex,
+		ItemsPerPage: len(page),
+		Resources:    make([]api.ScimGroup, 0, len(page)),
+	}
+	for _, name := range page {
+		group, err := s.GetGroup(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		res.Resources = append(res.Resources, *group)
+	}
+	return res, nil
+}
+
+func (s *scimService) GetGroup(ctx context.Context, id string) (*api.ScimGroup, error) {
+	exists, err := s.teamRepo.Exists(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrNotFound
+	}
+
+	members, err := s.userRepo.ListByTeam(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	refs := make([]api.ScimMemberRef, 0, len(members))
+	for _, u := range members {
+		display := u.Username
+		refs = append(refs, api.ScimMemberRef{Value: u.UserId, Display: &display})
+	}
+	sort.Slice(refs, func(i, j int) bool { return refs[i].Value < refs[j].Value })
+
+	name := id
+	location := "/scim/v2/Groups/" + id
+	return &api.ScimGroup{
+		Schemas:     []string{scimGroupSchema},
+		Id:          &name,
+		DisplayName: id,
+		Members:     &refs,
+		Meta: &api.ScimMeta{
+			ResourceType: api.ScimMetaResourceTypeGroup,
+			Location:     &location,
+		},
+	}, nil
+}
+
+func (s *scimService) CreateGroup(ctx context.Context, body api.ScimGroup) (*api.ScimGroup, error) {
+	name := strings.TrimSpace(body.DisplayName)
+	if name == "" {
+		return nil, ErrInvalidArgument
+	}
+
+	var ids []string
+	if body.Members != nil {
+		for _, m := range *body.Members {
+			ids = append(ids, m.Value)
+		}
+	}
+	members, err := s.existingMembers(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.teamSvc.AddTeam(ctx, api.PostTeamAddJSONRequestBody{
+		TeamName: name,
+		Members:  members,
+	}, MembershipSecondary); err != nil {
+		return nil, err
+	}
+	return s.GetGroup(ctx, name)
+}
+
+// PatchGroup меняет состав команды и её имя. Исключение участников идёт через
+// /team/update, поэтому их открытые ревью переназначаются.
+func (s *scimService) PatchGroup(ctx context.Context, id string, body api.ScimPatchRequest) (*api.ScimGroup, error) {
+	exists, err := s.teamRepo.Exists(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrNotFound
+	}
+	current, err := s.userRepo.ListByTeam(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	before := make(map[string]struct{}, len(current))
+	members := make(map[string]struct{}, len(current))
+	for _, u := range current {
+		before[u.UserId] = struct{}{}
+		members[u.UserId] = struct{}{}
+	}
+	newName := id
+
+	for _, op := range body.Operations {
+		path := ""
+		if op.Path != nil {
+			path = strings.TrimSpace(*op.Path)
+		}
+		var value any
+		if op.Value != nil {
+			value = *op.Value
+		}
+		kind := strings.ToLower(op.Op)
+
+		if path == "" && (kind == "add" || kind == "replace") {
+			attrs, ok := value.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("%w: value must be an object", ErrInvalidArgument)
+			}
+			for attr, v := range attrs {
+				if err := applyGroupOp(kind, attr, v, members, &newName); err != nil {
+					return nil, err
+				}
+			}
+			continue
+		}
+		if kind != "add" && kind != "replace" && kind != "remove" {
+			return nil, fmt.Errorf("%w: unsupported op %q", ErrInvalidArgument, op.Op)
+		}
+		if err := applyGroupOp(kind, path, value, members, &newName); err != nil {
+			return nil, err
+		}
+	}
+
+	var toAdd, toRemove []string
+	for userID := range members {
+		if _, ok := before[userID]; !ok {
+			toAdd = append(toAdd, userID)
+		}
+	}
+	for userID := range before {
+		if _, ok := members[userID]; !ok {
+			toRemove = append(toRemove, userID)
+		}
+	}
+	sort.Strings(toAdd)
+	sort.Strings(toRemove)
+
+	added, err := s.existingMembers(ctx, toAdd)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		secondary := true
+		update := api.PatchTeamUpdateJSONRequestBody{TeamName: id, AllowSecondaryTeam: &secondary}
+		if len(added) > 0 {
+			update.AddMembers = &added
+		}
+		if len(toRemove) > 0 {
+			update.RemoveMembers = &toRemove
+		}
+		if _, _, err := s.teamSvc.UpdateTeam(ctx, update); err != nil {
+			return err
+		}
+		if newName == id {
+			return nil
+		}
+		_, err := s.teamSvc.RenameTeam(ctx, api.PostTeamRenameJSONRequestBody{
+			TeamName:    id,
+			NewTeamName: newName,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetGroup(ctx, newName)
+}
+
+// DeleteGroup удаляет команду как /team/delete с policy=refuse.
+func (s *scimService) DeleteGroup(ctx context.Context, id string) error {
+	_, err := s.teamSvc.DeleteTeam(ctx, api.DeleteTeamParams{
+		TeamName: api.TeamNameQuery(id),
+		Policy:   api.Refuse,
+	})
+	return err
+}
+
+// applyGroupOp применяет одну операцию PATCH к набору участников и имени группы.
+func applyGroupOp(kind, path string, value any, members map[string]struct{}, name *string) error {
+	attr, selected, err := parseSCIMMemberPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch attr {
+	case "displayname":
+		if kind == "remove" {
+			return fmt.Errorf("%w: displayName cannot be removed", ErrInvalidArgument)
+		}
+		v, ok := value.(string)
+		if !ok || strings.TrimSpace(v) == "" {
+			return fmt.Errorf("%w: displayName must be a non-empty string", ErrInvalidArgument)
+		}
+		*name = strings.TrimSpace(v)
+	case "members":
+		if selected != "" {
+			if kind != "remove" {
+				return fmt.Errorf("%w: member filter is supported only for remove", ErrInvalidArgument)
+			}
+			delete(members, selected)
+			return nil
+		}
+		ids, err := scimMemberIDs(value)
+		if err != nil {
+			return err
+		}
+		switch kind {
+		case "replace":
+			for id := range members {
+				delete(members, id)
+			}
+			fallthrough
+		case "add":
+			for _, id := range ids {
+				members[id] = struct{}{}
+			}
+		case "remove":
+			if value == nil {
+				for id := range members {
+					delete(members, id)
+				}
+			}
+			for _, id := range ids {
+				delete(members, id)
+			}
+		}
+	}
+	return nil
+}
+
+// parseSCIMMemberPath разбирает путь вида `members[value eq "u1"]`.
+func parseSCIMMemberPath(path string) (attr, selected string, err error) {
+	open := strings.IndexByte(path, '[')
+	if open < 0 {
+		return strings.ToLower(path), "", nil
+	}
+	if !strings.HasSuffix(path, "]") {
+		return "", "", fmt.Errorf("%w: invalid path %q", ErrInvalidArgument, path)
+	}
+	expr := path[open+1 : len(path)-1]
+	terms, err := parseSCIMFilter(&expr)
+	if err != nil {
+		return "", "", err
+	}
+	if len(terms) != 1 || terms[0].attr != "value" {
+		return "", "", fmt.Errorf("%w: invalid path %q", ErrInvalidArgument, path)
+	}
+	return strings.ToLower(path[:open]), terms[0].value, nil
+}
+
+// scimMemberIDs достаёт user_id из значения вида [{"value": "u1"}, ...].
+func scimMemberIDs(value any) ([]string, error) {
+	if value == nil {
+		return nil, nil
+	}
+	items, ok := value.([]any)
+	if !ok {
+		items = []any{value}
+	}
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		ref, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%w: member must be an object", ErrInvalidArgument)
+		}
+		id, ok := ref["value"].(string)
+		if !ok || id == "" {
+			return nil, fmt.Errorf("%w: member value is required", ErrInvalidArgument)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// existingMembers превращает user_id в участников команды с текущими данными;
+// участники группы SCIM должны быть созданы заранее.
+func (s *scimService) existingMembers(ctx context.Context, ids []string) ([]api.TeamMember, error) {
+	members := make([]api.TeamMember, 0, len(ids))
+	for _, id := range ids {
+		u, err := s.userRepo.GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if u == nil {
+			return nil, fmt.Errorf("%w: unknown member %q", ErrInvalidArgument, id)
+		}
+		members = append(members, api.TeamMember{
+			UserId:   u.UserId,
+			Username: u.Username,
+			IsActive: u.IsActive,
+		})
+	}
+	return members, nil
+}
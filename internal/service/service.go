@@ -4,16 +4,39 @@ import (
 	"avito-autumn2025-internship/internal/api"
 	"avito-autumn2025-internship/internal/repository"
 	"context"
+	"time"
 )
 
 var (
 	ErrTeamExists          = NewError("already exists")
+	ErrUserExists          = NewError("user already exists")
 	ErrPRExists            = NewError("PR id already exists")
 	ErrPRMerged            = NewError("cannot reassign on merged PR")
+	ErrPRClosed            = NewError("cannot reassign on closed PR")
 	ErrReviewerNotAssigned = NewError("reviewer not assigned to this PR")
 	ErrNoCandidate         = NewError("no replacement candidate")
 	ErrNotFound            = NewError("resource not found")
+	ErrInvalidArgument     = NewError("invalid argument")
 	ErrUnauthorized        = NewError("unauthorized")
+	ErrForbidden           = NewError("forbidden")
+	ErrTeamInUse           = NewError("team members have open reviews or team owns repositories")
+	ErrSettingsConflict    = NewError("team settings version conflict")
+	ErrScheduleNotPending  = NewError("scheduled change is already applied or cancelled")
+	ErrLoginTaken          = NewError("external login is already linked to another user")
+	ErrUserInOtherTeam     = NewError("user belongs to another team; pass allow_secondary_team or allow_team_move")
+)
+
+// MembershipMode задаёт, что делать с добавляемым в команду участником, у которого
+// основная команда другая.
+type MembershipMode int
+
+const (
+	// MembershipRefuse отклоняет добавление с ErrUserInOtherTeam.
+	MembershipRefuse MembershipMode = iota
+	// MembershipSecondary добавляет команду дополнительной.
+	MembershipSecondary
+	// MembershipMove делает команду основной, прежняя основная покидается.
+	MembershipMove
 )
 
 type DomainError struct {
@@ -27,40 +50,218 @@ func NewError(msg string) error {
 }
 
 type TeamService interface {
-	AddTeam(ctx context.Context, body api.PostTeamAddJSONRequestBody) (*api.Team, error)
+	AddTeam(ctx context.Context, body api.PostTeamAddJSONRequestBody, mode MembershipMode) (*api.Team, error)
 	GetTeam(ctx context.Context, teamName string) (*api.Team, error)
+	UpdateTeam(ctx context.Context, body api.PatchTeamUpdateJSONRequestBody) (*api.Team, *api.ReviewReassignmentResult, error)
+	RenameTeam(ctx context.Context, body api.PostTeamRenameJSONRequestBody) (*api.Team, error)
+	DeleteTeam(ctx context.Context, params api.DeleteTeamParams) (*api.TeamDeleteResult, error)
+	MoveUser(ctx context.Context, body api.PostUsersMoveTeamJSONRequestBody) (*api.MoveTeamResult, error)
+	SetParent(ctx context.Context, body api.PostTeamSetParentJSONRequestBody) (*api.Team, error)
+	GetTree(ctx context.Context, params api.GetTeamTreeParams) ([]api.TeamTreeNode, error)
+	ListTeams(ctx context.Context, params api.GetTeamListParams) (*api.TeamListPage, error)
+	ImportTeams(ctx context.Context, format api.PostAdminImportParamsFormat, data []byte, dryRun bool) (*api.ImportResult, error)
+	GetSettings(ctx context.Context, params api.GetTeamSettingsParams) (*api.TeamSettings, error)
+	UpdateSettings(ctx context.Context, body api.PutTeamSettingsJSONRequestBody) (*api.TeamSettings, error)
+	SettingsHistory(ctx context.Context, teamName string) ([]api.TeamSettingsVersion, error)
+	RollbackSettings(ctx context.Context, body api.PostTeamSettingsRollbackJSONRequestBody) (*api.TeamSettings, error)
 }
 
 type UserService interface {
 	SetIsActive(ctx context.Context, body api.PostUsersSetIsActiveJSONRequestBody) (*api.User, error)
 	GetReviews(ctx context.Context, userID string) ([]api.PullRequestShort, error)
+	ExportReviews(ctx context.Context, userID string) (repository.Rows[api.PullRequestShort], error)
 	MassDeactivateTeamUsers(ctx context.Context, teamName string, userIDs []string) (*api.MassDeactivateResult, error)
+	LinkExternalAccount(ctx context.Context, body api.PostUsersLinkExternalAccountJSONRequestBody) (*api.ExternalAccount, error)
+	GetUser(ctx context.Context, userID string) (*api.User, error)
+	GetDashboard(ctx context.Context, userID string) (*api.UserDashboard, error)
+	ListUsers(ctx context.Context, params api.GetUsersListParams) (*api.UserListPage, error)
+	ExportUsers(ctx context.Context, params api.GetUsersListParams) (repository.Rows[api.User], error)
+	AnonymizeUser(ctx context.Context, body api.PostUsersAnonymizeJSONRequestBody) (*api.AnonymizeResult, error)
+	ScheduleActivation(ctx context.Context, body api.PostUsersScheduleActivationJSONRequestBody) (*api.ScheduledActivation, error)
+	ListScheduledActivations(ctx context.Context, params api.GetUsersScheduledActivationsParams) ([]api.ScheduledActivation, error)
+	CancelScheduledActivation(ctx context.Context, id int64) (*api.ScheduledActivation, error)
+	// ApplyDueActivations вызывается планировщиком приложения.
+	ApplyDueActivations(ctx context.Context, now time.Time) (applied, failed int, err error)
 }
 
 type PRService interface {
 	CreatePR(ctx context.Context, body api.PostPullRequestCreateJSONRequestBody) (*api.PullRequest, error)
 	MergePR(ctx context.Context, body api.PostPullRequestMergeJSONRequestBody) (*api.PullRequest, error)
 	ReassignReviewer(ctx context.Context, body api.PostPullRequestReassignJSONRequestBody) (*api.PullRequest, string, error)
-	GetReviewerAssignments(ctx context.Context) ([]api.ReviewerStat, error)
+	GetReviewerAssignments(ctx context.Context, params api.GetStatsReviewerAssignmentsParams) ([]api.ReviewerStat, error)
+	GetTurnaround(ctx context.Context, params api.GetStatsTurnaroundParams) ([]api.TurnaroundStat, error)
+	GetFairness(ctx context.Context, params api.GetStatsFairnessParams) ([]api.TeamFairness, error)
+	GetReviewGraph(ctx context.Context, params api.GetStatsReviewGraphParams) (*api.ReviewGraph, error)
+	ClosePR(ctx context.Context, prID string) (*api.PullRequest, error)
+	ReopenPR(ctx context.Context, prID string) (*api.PullRequest, error)
 }
 
-func NewTeamService(teamRepo repository.TeamRepository, userRepo repository.UserRepository) TeamService {
+type RepositoryService interface {
+	UpsertRepository(ctx context.Context, body api.PostRepositoryUpsertJSONRequestBody) (*api.Repository, error)
+	GetRepository(ctx context.Context, repositoryID string) (*api.Repository, error)
+}
+
+// ReviewerConnector сообщает хостингу кода об изменении списка ревьюверов PR.
+type ReviewerConnector interface {
+	RequestReviewers(ctx context.Context, prID string, userIDs []string) error
+	RemoveReviewers(ctx context.Context, prID string, userIDs []string) error
+}
+
+// DomainMetrics учитывает доменные события для мониторинга.
+type DomainMetrics interface {
+	PRCreated(reviewers int)
+	ReviewerReassigned()
+	NoCandidate()
+	MassDeactivation(res api.MassDeactivateResult)
+}
+
+// SCIMService отображает ресурсы SCIM 2.0: User — на пользователя, Group — на команду.
+type SCIMService interface {
+	ListUsers(ctx context.Context, params api.GetScimV2UsersParams) (*api.ScimUserList, error)
+	GetUser(ctx context.Context, id string) (*api.ScimUser, error)
+	CreateUser(ctx context.Context, body api.ScimUser) (*api.ScimUser, error)
+	ReplaceUser(ctx context.Context, id string, body api.ScimUser) (*api.ScimUser, error)
+	PatchUser(ctx context.Context, id string, body api.ScimPatchRequest) (*api.ScimUser, error)
+	DeactivateUser(ctx context.Context, id string) error
+
+	ListGroups(ctx context.Context, params api.GetScimV2GroupsParams) (*api.ScimGroupList, error)
+	GetGroup(ctx context.Context, id string) (*api.ScimGroup, error)
+	CreateGroup(ctx context.Context, body api.ScimGroup) (*api.ScimGroup, error)
+	PatchGroup(ctx context.Context, id string, body api.ScimPatchRequest) (*api.ScimGroup, error)
+	DeleteGroup(ctx context.Context, id string) error
+}
+
+// AccessService назначает руководителей команд и определяет, каких команд
+// касается операция. Пустой список команд означает, что объекта нет.
+type AccessService interface {
+	ListTeamLeads(ctx context.Context, teamName string) ([]api.TeamLead, error)
+	AddTeamLead(ctx context.Context, body api.TeamLeadRequest) (*api.TeamLead, error)
+	RemoveTeamLead(ctx context.Context, body api.TeamLeadRequest) (*api.TeamLead, error)
+	// LedTeams возвращает команды, которыми руководит пользователь.
+	LedTeams(ctx context.Context, userID string) ([]string, error)
+	UserTeams(ctx context.Context, userID string) ([]string, error)
+	// PullRequestTeams — команды автора PR и команда-владелец его репозитория.
+	PullRequestTeams(ctx context.Context, prID string) ([]string, error)
+	ScheduledActivationTeams(ctx context.Context, id int64) ([]string, error)
+}
+
+type GitLabService interface {
+	HandleMergeRequestEvent(ctx context.Context, event api.GitLabMergeRequestEvent) (*api.WebhookResult, error)
+}
+
+type APIKeyService interface {
+	// CreateKey возвращает выпущенный ключ и его секрет.
+	CreateKey(ctx context.Context, body api.PostAdminApiKeysJSONRequestBody) (*api.ApiKey, string, error)
+	ListKeys(ctx context.Context, includeRevoked bool) ([]api.ApiKey, error)
+	RevokeKey(ctx context.Context, id int64) (*api.ApiKey, error)
+	// Authenticate находит ключ по секрету; ErrUnauthorized, если ключ неизвестен,
+	// отозван или просрочен.
+	Authenticate(ctx context.Context, secret string) (*api.ApiKey, error)
+}
+
+type AuditService interface {
+	Record(ctx context.Context, rec api.AuditRecord) error
+	List(ctx context.Context, params api.GetAdminAuditParams) (*api.AuditPage, error)
+}
+
+func NewTeamService(
+	teamRepo repository.TeamRepository,
+	userRepo repository.UserRepository,
+	prRepo repository.PRRepository,
+	repoRepo repository.RepoRepository,
+	settingsRepo repository.TeamSettingsRepository,
+	txManager repository.TxManager,
+) TeamService {
 	return &teamService{
-		teamRepo: teamRepo,
-		userRepo: userRepo,
+		teamRepo:     teamRepo,
+		userRepo:     userRepo,
+		prRepo:       prRepo,
+		repoRepo:     repoRepo,
+		settingsRepo: settingsRepo,
+		txManager:    txManager,
 	}
 }
 
-func NewUserService(userRepo repository.UserRepository, prRepo repository.PRRepository) UserService {
+func NewUserService(
+	userRepo repository.UserRepository,
+	prRepo repository.PRRepository,
+	scheduleRepo repository.ActivationScheduleRepository,
+	txManager repository.TxManager,
+) UserService {
 	return &userService{
-		userRepo: userRepo,
-		prRepo:   prRepo,
+		userRepo:     userRepo,
+		prRepo:       prRepo,
+		scheduleRepo: scheduleRepo,
+		txManager:    txManager,
 	}
 }
 
-func NewPRService(prRepo repository.PRRepository, userRepo repository.UserRepository) PRService {
+func NewPRService(
+	prRepo repository.PRRepository,
+	userRepo repository.UserRepository,
+	repoRepo repository.RepoRepository,
+	teamRepo repository.TeamRepository,
+) PRService {
 	return &prService{
 		prRepo:   prRepo,
 		userRepo: userRepo,
+		repoRepo: repoRepo,
+		teamRepo: teamRepo,
+	}
+}
+
+func NewRepositoryService(repoRepo repository.RepoRepository, teamRepo repository.TeamRepository) RepositoryService {
+	return &repositoryService{
+		repoRepo: repoRepo,
+		teamRepo: teamRepo,
+	}
+}
+
+func NewSCIMService(
+	teamSvc TeamService,
+	userRepo repository.UserRepository,
+	teamRepo repository.TeamRepository,
+	prRepo repository.PRRepository,
+	txManager repository.TxManager,
+) SCIMService {
+	return &scimService{
+		teamSvc:   teamSvc,
+		userRepo:  userRepo,
+		teamRepo:  teamRepo,
+		prRepo:    prRepo,
+		txManager: txManager,
 	}
 }
+
+func NewGitLabService(prSvc PRService, userRepo repository.UserRepository) GitLabService {
+	return &gitLabService{
+		prService: prSvc,
+		userRepo:  userRepo,
+	}
+}
+
+func NewAccessService(
+	leadRepo repository.TeamLeadRepository,
+	teamRepo repository.TeamRepository,
+	userRepo repository.UserRepository,
+	prRepo repository.PRRepository,
+	repoRepo repository.RepoRepository,
+	scheduleRepo repository.ActivationScheduleRepository,
+) AccessService {
+	return &accessService{
+		leadRepo:     leadRepo,
+		teamRepo:     teamRepo,
+		userRepo:     userRepo,
+		prRepo:       prRepo,
+		repoRepo:     repoRepo,
+		scheduleRepo: scheduleRepo,
+	}
+}
+
+func NewAPIKeyService(keyRepo repository.APIKeyRepository) APIKeyService {
+	return &apiKeyService{keyRepo: keyRepo}
+}
+
+func NewAuditService(auditRepo repository.AuditRepository) AuditService {
+	return &auditService{auditRepo: auditRepo}
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/repository"
+	"context"
+)
+
+type teamCursor struct {
+	TeamName string `json:"t"`
+}
+
+func (s *teamService) ListTeams(ctx context.Context, params api.GetTeamListParams) (*api.TeamListPage, error) {
+	filter := repository.TeamFilter{Limit: defaultPageSize}
+	if params.Prefix != nil {
+		filter.Prefix = *params.Prefix
+	}
+	if params.Limit != nil {
+		if *params.Limit < 1 || *params.Limit > maxPageSize {
+			return nil, ErrInvalidArgument
+		}
+		filter.Limit = *params.Limit
+	}
+	if params.Cursor != nil && *params.Cursor != "" {
+		var cursor teamCursor
+		if err := decodeCursor(*params.Cursor, &cursor); err != nil {
+			return nil, err
+		}
+		if cursor.TeamName == "" {
+			return nil, ErrInvalidArgument
+		}
+		filter.After = cursor.TeamName
+	}
+
+	pageSize := filter.Limit
+	filter.Limit++
+	teams, err := s.teamRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &api.TeamListPage{Teams: teams}
+	if page.Teams == nil {
+		page.Teams = []api.TeamSummary{}
+	}
+	if len(teams) > pageSize {
+		page.Teams = teams[:pageSize]
+		next, err := encodeCursor(teamCursor{TeamName: page.Teams[pageSize-1].TeamName})
+		if err != nil {
+			return nil, err
+		}
+		page.NextCursor = &next
+	}
+	return page, nil
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/repository"
+)
+
+// effectiveTeamPolicy сводит настройки цепочки команд (от команды к корню):
+// побеждает ближайшая заданная настройка, незаданные берутся по умолчанию.
+func effectiveTeamPolicy(chain []repository.TeamNode) api.TeamPolicy {
+	var res api.TeamPolicy
+	for _, node := range chain {
+		p := node.Policy
+		if res.ReviewerCount == nil && p.ReviewerCount != nil {
+			res.ReviewerCount = p.ReviewerCount
+		}
+		if res.AssignmentStrategy == nil && p.AssignmentStrategy != nil {
+			res.AssignmentStrategy = p.AssignmentStrategy
+		}
+		if res.ReviewSlaHours == nil && p.ReviewSlaHours != nil {
+			res.ReviewSlaHours = p.ReviewSlaHours
+		}
+		if res.SiblingFallback == nil && p.SiblingFallback != nil {
+			res.SiblingFallback = p.SiblingFallback
+		}
+	}
+
+	if res.ReviewerCount == nil {
+		count := defaultReviewerCount
+		res.ReviewerCount = &count
+	}
+	if res.AssignmentStrategy == nil {
+		strategy := api.Random
+		res.AssignmentStrategy = &strategy
+	}
+	if res.SiblingFallback == nil {
+		fallback := false
+		res.SiblingFallback = &fallback
+	}
+	return res
+}
+
+func validateTeamPolicy(p api.TeamPolicy) error {
+	if p.ReviewerCount != nil && (*p.ReviewerCount < 0 || *p.ReviewerCount > maxReviewerCount) {
+		return ErrInvalidArgument
+	}
+	if p.AssignmentStrategy != nil &&
+		*p.AssignmentStrategy != api.Random && *p.AssignmentStrategy != api.LeastLoaded {
+		return ErrInvalidArgument
+	}
+	if p.ReviewSlaHours != nil && *p.ReviewSlaHours <= 0 {
+		return ErrInvalidArgument
+	}
+	return nil
+}
@@ -4,47 +4,91 @@ import (
 	"avito-autumn2025-internship/internal/api"
 	"avito-autumn2025-internship/internal/repository"
 	"context"
+	"slices"
 )
 
 type teamService struct {
-	teamRepo repository.TeamRepository
-	userRepo repository.UserRepository
+	teamRepo     repository.TeamRepository
+	userRepo     repository.UserRepository
+	prRepo       repository.PRRepository
+	repoRepo     repository.RepoRepository
+	settingsRepo repository.TeamSettingsRepository
+	txManager    repository.TxManager
 }
 
-func (s *teamService) AddTeam(ctx context.Context, body api.PostTeamAddJSONRequestBody) (*api.Team, error) {
+func (s *teamService) AddTeam(
+	ctx context.Context,
+	body api.PostTeamAddJSONRequestBody,
+	mode MembershipMode,
+) (*api.Team, error) {
 	if body.TeamName == "" {
 		return nil, ErrNotFound
 	}
 
-	exists, err := s.teamRepo.Exists(ctx, body.TeamName)
-	if err != nil {
-		return nil, err
-	}
-	if exists {
-		return nil, ErrTeamExists
+	if body.Policy != nil {
+		if err := validateTeamPolicy(*body.Policy); err != nil {
+			return nil, err
+		}
 	}
 
-	if err := s.teamRepo.Create(ctx, body.TeamName); err != nil {
-		return nil, ErrTeamExists
-	}
+	// Команда создаётся целиком или не создаётся вовсе: иначе после ошибки
+	// в составе остаётся пустая команда и повтор получает TEAM_EXISTS.
+	var users []api.User
+	err := s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		exists, err := s.teamRepo.Exists(ctx, body.TeamName)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return ErrTeamExists
+		}
+		if body.ParentTeamName != nil && *body.ParentTeamName != "" {
+			exists, err := s.teamRepo.Exists(ctx, *body.ParentTeamName)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				return ErrNotFound
+			}
+		}
 
-	users, err := s.userRepo.UpsertTeamMembers(ctx, body.TeamName, body.Members)
+		if mode == MembershipRefuse {
+			if err := s.checkNotInOtherTeam(ctx, body.TeamName, body.Members); err != nil {
+				return err
+			}
+		}
+
+		if err := s.teamRepo.Create(ctx, body.TeamName); err != nil {
+			return ErrTeamExists
+		}
+		if body.ParentTeamName != nil && *body.ParentTeamName != "" {
+			if err := s.teamRepo.SetParent(ctx, body.TeamName, body.ParentTeamName); err != nil {
+				return err
+			}
+		}
+		if body.Policy != nil {
+			if err := s.savePolicy(ctx, body.TeamName, *body.Policy); err != nil {
+				return err
+			}
+		}
+
+		users, err = s.addMembers(ctx, body.TeamName, body.Members, mode)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	members := make([]api.TeamMember, 0, len(users))
 	for _, u := range users {
-		members = append(members, api.TeamMember{
-			UserId:   u.UserId,
-			Username: u.Username,
-			IsActive: u.IsActive,
-		})
+		members = append(members, teamMember(u, body.TeamName))
 	}
 
 	team := &api.Team{
-		TeamName: body.TeamName,
-		Members:  members,
+		TeamName:       body.TeamName,
+		ParentTeamName: body.ParentTeamName,
+		Policy:         body.Policy,
+		Members:        members,
 	}
 
 	return team, nil
@@ -55,11 +99,11 @@ func (s *teamService) GetTeam(ctx context.Context, teamName string) (*api.Team,
 		return nil, ErrNotFound
 	}
 
-	exists, err := s.teamRepo.Exists(ctx, teamName)
+	node, err := s.teamRepo.Get(ctx, teamName)
 	if err != nil {
 		return nil, err
 	}
-	if !exists {
+	if node == nil {
 		return nil, ErrNotFound
 	}
 
@@ -70,16 +114,488 @@ func (s *teamService) GetTeam(ctx context.Context, teamName string) (*api.Team,
 
 	members := make([]api.TeamMember, 0, len(users))
 	for _, u := range users {
-		members = append(members, api.TeamMember{
-			UserId:   u.UserId,
-			Username: u.Username,
-			IsActive: u.IsActive,
-		})
+		members = append(members, teamMember(u, teamName))
 	}
 
 	team := &api.Team{
-		TeamName: teamName,
-		Members:  members,
+		TeamName:       teamName,
+		ParentTeamName: node.Parent,
+		Policy:         &node.Policy,
+		Members:        members,
 	}
 	return team, nil
 }
+
+func (s *teamService) UpdateTeam(
+	ctx context.Context,
+	body api.PatchTeamUpdateJSONRequestBody,
+) (*api.Team, *api.ReviewReassignmentResult, error) {
+	if body.TeamName == "" {
+		return nil, nil, ErrInvalidArgument
+	}
+
+	exists, err := s.teamRepo.Exists(ctx, body.TeamName)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !exists {
+		return nil, nil, ErrNotFound
+	}
+
+	mode := MembershipRefuse
+	switch {
+	case body.AllowTeamMove != nil && *body.AllowTeamMove:
+		mode = MembershipMove
+	case body.AllowSecondaryTeam != nil && *body.AllowSecondaryTeam:
+		mode = MembershipSecondary
+	}
+	if body.AddMembers != nil && mode == MembershipRefuse {
+		if err := s.checkNotInOtherTeam(ctx, body.TeamName, *body.AddMembers); err != nil {
+			return nil, nil, err
+		}
+	}
+	if body.Policy != nil {
+		if err := validateTeamPolicy(*body.Policy); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	res := &api.ReviewReassignmentResult{}
+	err = s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if body.Policy != nil {
+			if err := s.savePolicy(ctx, body.TeamName, *body.Policy); err != nil {
+				return err
+			}
+		}
+
+		if body.AddMembers != nil && len(*body.AddMembers) > 0 {
+			if _, err := s.addMembers(ctx, body.TeamName, *body.AddMembers, mode); err != nil {
+				return err
+			}
+		}
+
+		if body.RemoveMembers == nil || len(*body.RemoveMembers) == 0 {
+			return nil
+		}
+		removed := *body.RemoveMembers
+
+		members, err := s.userRepo.ListByTeam(ctx, body.TeamName)
+		if err != nil {
+			return err
+		}
+		memberSet := make(map[string]struct{}, len(members))
+		for _, u := range members {
+			memberSet[u.UserId] = struct{}{}
+		}
+		for _, id := range removed {
+			if _, ok := memberSet[id]; !ok {
+				return ErrNotFound
+			}
+		}
+
+		// Ревью в других командах участника остаются за ним; PR команды
+		// собираются до отвязки, пока основная команда авторов не изменилась.
+		teamPRs := make(map[string][]api.PullRequestShort, len(removed))
+		for _, id := range removed {
+			prs, err := s.prRepo.ListOpenShortByReviewerInTeam(ctx, id, body.TeamName)
+			if err != nil {
+				return err
+			}
+			teamPRs[id] = prs
+		}
+
+		if err := s.userRepo.DetachFromTeam(ctx, body.TeamName, removed); err != nil {
+			return err
+		}
+
+		candidates, err := s.userRepo.ListActiveByTeam(ctx, body.TeamName)
+		if err != nil {
+			return err
+		}
+		for _, id := range removed {
+			reassigned, notReassigned, err := reassignReviews(ctx, s.prRepo, id, teamPRs[id], candidates)
+			if err != nil {
+				return err
+			}
+			res.ReassignedCount += reassigned
+			res.NotReassignedCount += notReassigned
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	team, err := s.GetTeam(ctx, body.TeamName)
+	if err != nil {
+		return nil, nil, err
+	}
+	return team, res, nil
+}
+
+func (s *teamService) RenameTeam(ctx context.Context, body api.PostTeamRenameJSONRequestBody) (*api.Team, error) {
+	if body.TeamName == "" || body.NewTeamName == "" {
+		return nil, ErrInvalidArgument
+	}
+
+	exists, err := s.teamRepo.Exists(ctx, body.TeamName)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrNotFound
+	}
+
+	if body.NewTeamName != body.TeamName {
+		taken, err := s.teamRepo.Exists(ctx, body.NewTeamName)
+		if err != nil {
+			return nil, err
+		}
+		if taken {
+			return nil, ErrTeamExists
+		}
+
+		if err := s.teamRepo.Rename(ctx, body.TeamName, body.NewTeamName); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.GetTeam(ctx, body.NewTeamName)
+}
+
+func (s *teamService) DeleteTeam(ctx context.Context, params api.DeleteTeamParams) (*api.TeamDeleteResult, error) {
+	teamName := string(params.TeamName)
+	if teamName == "" {
+		return nil, ErrInvalidArgument
+	}
+
+	var targetTeam string
+	switch params.Policy {
+	case api.Refuse:
+	case api.Reassign:
+		if params.TargetTeam == nil || *params.TargetTeam == "" || *params.TargetTeam == teamName {
+			return nil, ErrInvalidArgument
+		}
+		targetTeam = *params.TargetTeam
+	default:
+		return nil, ErrInvalidArgument
+	}
+
+	exists, err := s.teamRepo.Exists(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrNotFound
+	}
+	if targetTeam != "" {
+		exists, err := s.teamRepo.Exists(ctx, targetTeam)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, ErrNotFound
+		}
+	}
+
+	res := &api.TeamDeleteResult{TeamName: teamName}
+	err = s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		members, err := s.userRepo.ListByTeam(ctx, teamName)
+		if err != nil {
+			return err
+		}
+		memberIDs := make([]string, 0, len(members))
+		for _, u := range members {
+			memberIDs = append(memberIDs, u.UserId)
+		}
+
+		if params.Policy == api.Refuse {
+			repoCount, err := s.repoRepo.CountByTeam(ctx, teamName)
+			if err != nil {
+				return err
+			}
+			if repoCount > 0 {
+				return ErrTeamInUse
+			}
+			for _, id := range memberIDs {
+				prs, err := s.prRepo.ListOpenShortByReviewerInTeam(ctx, id, teamName)
+				if err != nil {
+					return err
+				}
+				if len(prs) > 0 {
+					return ErrTeamInUse
+				}
+			}
+		} else {
+			candidates, err := s.userRepo.ListActiveByTeam(ctx, targetTeam)
+			if err != nil {
+				return err
+			}
+			for _, id := range memberIDs {
+				prs, err := s.prRepo.ListOpenShortByReviewerInTeam(ctx, id, teamName)
+				if err != nil {
+					return err
+				}
+				reassigned, notReassigned, err := reassignReviews(ctx, s.prRepo, id, prs, candidates)
+				if err != nil {
+					return err
+				}
+				res.ReassignedCount += reassigned
+				res.NotReassignedCount += notReassigned
+			}
+			if err := s.repoRepo.TransferTeam(ctx, teamName, targetTeam); err != nil {
+				return err
+			}
+		}
+
+		if len(memberIDs) > 0 {
+			if err := s.userRepo.DetachFromTeam(ctx, teamName, memberIDs); err != nil {
+				return err
+			}
+		}
+		res.DetachedCount = len(memberIDs)
+
+		return s.teamRepo.Delete(ctx, teamName)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// addMembers добавляет участников в команду. Для состоящих в другой команде она
+// становится дополнительной, а при MembershipMove — основной; MembershipRefuse
+// вызывающий проверяет заранее через checkNotInOtherTeam.
+func (s *teamService) addMembers(
+	ctx context.Context,
+	teamName string,
+	members []api.TeamMember,
+	mode MembershipMode,
+) ([]api.User, error) {
+	users, err := s.userRepo.UpsertTeamMembers(ctx, teamName, members)
+	if err != nil {
+		return nil, err
+	}
+	if mode != MembershipMove {
+		return users, nil
+	}
+
+	for i, u := range users {
+		if u.TeamName == teamName {
+			continue
+		}
+		moved, err := s.userRepo.MoveToTeam(ctx, u.UserId, teamName)
+		if err != nil {
+			return nil, err
+		}
+		if moved != nil {
+			users[i] = *moved
+		}
+	}
+	return users, nil
+}
+
+// checkNotInOtherTeam не даёт /team/add и /team/update молча менять состав
+// другой команды. Новые пользователи, участники без команды и уже состоящие
+// в teamName проходят.
+func (s *teamService) checkNotInOtherTeam(ctx context.Context, teamName string, members []api.TeamMember) error {
+	for _, m := range members {
+		u, err := s.userRepo.GetByID(ctx, m.UserId)
+		if err != nil {
+			return err
+		}
+		if u == nil || u.TeamName == "" || u.TeamName == teamName {
+			continue
+		}
+		teams, err := s.userRepo.ListTeams(ctx, m.UserId)
+		if err != nil {
+			return err
+		}
+		if !slices.Contains(teams, teamName) {
+			return ErrUserInOtherTeam
+		}
+	}
+	return nil
+}
+
+func teamMember(u api.User, teamName string) api.TeamMember {
+	isPrimary := u.TeamName == teamName
+	return api.TeamMember{
+		UserId:    u.UserId,
+		Username:  u.Username,
+		IsActive:  u.IsActive,
+		IsPrimary: &isPrimary,
+	}
+}
+
+func (s *teamService) MoveUser(
+	ctx context.Context,
+	body api.PostUsersMoveTeamJSONRequestBody,
+) (*api.MoveTeamResult, error) {
+	if body.UserId == "" || body.TeamName == "" {
+		return nil, ErrInvalidArgument
+	}
+
+	user, err := s.userRepo.GetByID(ctx, body.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrNotFound
+	}
+
+	exists, err := s.teamRepo.Exists(ctx, body.TeamName)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrNotFound
+	}
+
+	prevTeam := user.TeamName
+	res := &api.MoveTeamResult{
+		User:             *user,
+		PreviousTeamName: prevTeam,
+		OpenReviews:      []api.PullRequestShort{},
+	}
+	if prevTeam == body.TeamName {
+		return res, nil
+	}
+
+	err = s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		// Только ревью на PR прежней команды: в остальных командах пользователь
+		// может и дальше состоять.
+		if prevTeam != "" {
+			prs, err := s.prRepo.ListOpenShortByReviewerInTeam(ctx, body.UserId, prevTeam)
+			if err != nil {
+				return err
+			}
+			res.OpenReviews = append(res.OpenReviews, prs...)
+		}
+
+		moved, err := s.userRepo.MoveToTeam(ctx, body.UserId, body.TeamName)
+		if err != nil {
+			return err
+		}
+		if moved == nil {
+			return ErrNotFound
+		}
+		res.User = *moved
+
+		if body.ReassignReviews == nil || !*body.ReassignReviews || prevTeam == "" {
+			return nil
+		}
+
+		candidates, err := s.userRepo.ListActiveByTeam(ctx, prevTeam)
+		if err != nil {
+			return err
+		}
+		reassigned, notReassigned, err := reassignReviews(ctx, s.prRepo, body.UserId, res.OpenReviews, candidates)
+		if err != nil {
+			return err
+		}
+		res.Reassignment = &api.ReviewReassignmentResult{
+			ReassignedCount:    reassigned,
+			NotReassignedCount: notReassigned,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *teamService) SetParent(ctx context.Context, body api.PostTeamSetParentJSONRequestBody) (*api.Team, error) {
+	if body.TeamName == "" {
+		return nil, ErrInvalidArgument
+	}
+
+	exists, err := s.teamRepo.Exists(ctx, body.TeamName)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrNotFound
+	}
+
+	var parent *string
+	if body.ParentTeamName != nil && *body.ParentTeamName != "" {
+		chain, err := s.teamRepo.Ancestors(ctx, *body.ParentTeamName)
+		if err != nil {
+			return nil, err
+		}
+		if len(chain) == 0 {
+			return nil, ErrNotFound
+		}
+		// Родитель не может быть самой командой или её потомком.
+		for _, node := range chain {
+			if node.Name == body.TeamName {
+				return nil, ErrInvalidArgument
+			}
+		}
+		parent = body.ParentTeamName
+	}
+
+	if err := s.teamRepo.SetParent(ctx, body.TeamName, parent); err != nil {
+		return nil, err
+	}
+	return s.GetTeam(ctx, body.TeamName)
+}
+
+func (s *teamService) GetTree(ctx context.Context, params api.GetTeamTreeParams) ([]api.TeamTreeNode, error) {
+	var root string
+	var inherited []repository.TeamNode
+	if params.TeamName != nil && *params.TeamName != "" {
+		root = *params.TeamName
+		chain, err := s.teamRepo.Ancestors(ctx, root)
+		if err != nil {
+			return nil, err
+		}
+		if len(chain) == 0 {
+			return nil, ErrNotFound
+		}
+		inherited = chain[1:]
+	}
+
+	nodes, err := s.teamRepo.Subtree(ctx, root)
+	if err != nil {
+		return nil, err
+	}
+
+	// Узлы идут по возрастанию глубины, поэтому цепочка родителя уже посчитана.
+	chains := make(map[string][]repository.TeamNode, len(nodes))
+	children := make(map[string][]repository.TeamNode, len(nodes))
+	var roots []repository.TeamNode
+	for _, node := range nodes {
+		parentChain := inherited
+		if node.Depth > 0 && node.Parent != nil {
+			parentChain = chains[*node.Parent]
+			children[*node.Parent] = append(children[*node.Parent], node)
+		} else {
+			roots = append(roots, node)
+		}
+		chains[node.Name] = append([]repository.TeamNode{node}, parentChain...)
+	}
+
+	var build func(node repository.TeamNode) api.TeamTreeNode
+	build = func(node repository.TeamNode) api.TeamTreeNode {
+		res := api.TeamTreeNode{
+			TeamName:        node.Name,
+			ParentTeamName:  node.Parent,
+			Policy:          node.Policy,
+			EffectivePolicy: effectiveTeamPolicy(chains[node.Name]),
+			Children:        make([]api.TeamTreeNode, 0, len(children[node.Name])),
+		}
+		for _, child := range children[node.Name] {
+			res.Children = append(res.Children, build(child))
+		}
+		return res
+	}
+
+	tree := make([]api.TeamTreeNode, 0, len(roots))
+	for _, node := range roots {
+		tree = append(tree, build(node))
+	}
+	return tree, nil
+}
@@ -0,0 +1,154 @@
+package service
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/repository"
+	"context"
+	"fmt"
+)
+
+func (s *teamService) GetSettings(ctx context.Context, params api.GetTeamSettingsParams) (*api.TeamSettings, error) {
+	chain, err := s.teamChain(ctx, string(params.TeamName))
+	if err != nil {
+		return nil, err
+	}
+
+	var current *api.TeamSettingsVersion
+	if params.Version != nil {
+		current, err = s.settingsRepo.GetVersion(ctx, string(params.TeamName), *params.Version)
+		if err != nil {
+			return nil, err
+		}
+		if current == nil {
+			return nil, ErrNotFound
+		}
+	} else {
+		current, err = s.settingsRepo.Get(ctx, string(params.TeamName))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return teamSettings(chain, current), nil
+}
+
+func (s *teamService) UpdateSettings(
+	ctx context.Context,
+	body api.PutTeamSettingsJSONRequestBody,
+) (*api.TeamSettings, error) {
+	if body.TeamName == "" {
+		return nil, ErrInvalidArgument
+	}
+	if err := validateTeamPolicy(body.Settings); err != nil {
+		return nil, err
+	}
+	return s.saveSettings(ctx, body.TeamName, body.Settings, body.ExpectedVersion, body.Comment)
+}
+
+func (s *teamService) SettingsHistory(ctx context.Context, teamName string) ([]api.TeamSettingsVersion, error) {
+	if _, err := s.teamChain(ctx, teamName); err != nil {
+		return nil, err
+	}
+
+	versions, err := s.settingsRepo.ListVersions(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	if versions == nil {
+		versions = []api.TeamSettingsVersion{}
+	}
+	return versions, nil
+}
+
+// RollbackSettings сохраняет содержимое прошлой версии как новую версию.
+func (s *teamService) RollbackSettings(
+	ctx context.Context,
+	body api.PostTeamSettingsRollbackJSONRequestBody,
+) (*api.TeamSettings, error) {
+	if body.TeamName == "" || body.Version < 1 {
+		return nil, ErrInvalidArgument
+	}
+
+	target, err := s.settingsRepo.GetVersion(ctx, body.TeamName, body.Version)
+	if err != nil {
+		return nil, err
+	}
+	if target == nil {
+		return nil, ErrNotFound
+	}
+
+	comment := body.Comment
+	if comment == nil {
+		v := fmt.Sprintf("rollback to version %d", body.Version)
+		comment = &v
+	}
+	return s.saveSettings(ctx, body.TeamName, target.Settings, body.ExpectedVersion, comment)
+}
+
+func (s *teamService) saveSettings(
+	ctx context.Context,
+	teamName string,
+	settings api.TeamPolicy,
+	expectedVersion *int,
+	comment *string,
+) (*api.TeamSettings, error) {
+	chain, err := s.teamChain(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	saved, err := s.settingsRepo.Save(ctx, teamName, settings, expectedVersion, comment)
+	if err != nil {
+		return nil, err
+	}
+	if saved == nil {
+		return nil, ErrSettingsConflict
+	}
+	return teamSettings(chain, saved), nil
+}
+
+// savePolicy сохраняет policy из /team/add и /team/update новой версией. Save
+// без expectedVersion возвращает nil, только если версию одновременно занял
+// другой запрос.
+func (s *teamService) savePolicy(ctx context.Context, teamName string, policy api.TeamPolicy) error {
+	saved, err := s.settingsRepo.Save(ctx, teamName, policy, nil, nil)
+	if err != nil {
+		return err
+	}
+	if saved == nil {
+		return ErrSettingsConflict
+	}
+	return nil
+}
+
+// teamChain возвращает команду и её предков; ErrNotFound, если команды нет.
+func (s *teamService) teamChain(ctx context.Context, teamName string) ([]repository.TeamNode, error) {
+	if teamName == "" {
+		return nil, ErrNotFound
+	}
+	chain, err := s.teamRepo.Ancestors(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	if len(chain) == 0 {
+		return nil, ErrNotFound
+	}
+	return chain, nil
+}
+
+// teamSettings собирает ответ по версии настроек; действующие настройки считаются
+// так, будто эта версия сейчас применена к команде.
+func teamSettings(chain []repository.TeamNode, v *api.TeamSettingsVersion) *api.TeamSettings {
+	res := &api.TeamSettings{TeamName: chain[0].Name}
+	own := api.TeamPolicy{}
+	if v != nil {
+		own = v.Settings
+		res.Version = v.Version
+		res.UpdatedAt = &v.CreatedAt
+		res.Comment = v.Comment
+	}
+	res.Settings = own
+
+	effective := append([]repository.TeamNode{{Name: chain[0].Name, Policy: own}}, chain[1:]...)
+	res.Effective = effectiveTeamPolicy(effective)
+	return res
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/repository"
+	"context"
+	"time"
+)
+
+// dashboardRecentWindow — период для числа недавних назначений в сводке.
+const dashboardRecentWindow = 30 * 24 * time.Hour
+
+func (s *userService) GetDashboard(ctx context.Context, userID string) (*api.UserDashboard, error) {
+	user, err := s.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	dashboard := &api.UserDashboard{
+		User:         *user,
+		Availability: api.UserAvailability{IsActive: user.IsActive},
+	}
+
+	pending, err := s.scheduleRepo.List(ctx, repository.ActivationScheduleFilter{
+		UserID: &userID,
+		Status: api.ScheduledActivationStatusPENDING,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(pending) > 0 {
+		dashboard.Availability.NextChange = &pending[0]
+	}
+
+	dashboard.OpenReviews, err = s.prRepo.ListOpenByReviewer(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if dashboard.OpenReviews == nil {
+		dashboard.OpenReviews = []api.PullRequest{}
+	}
+
+	dashboard.Authored, err = s.authoredPullRequests(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	total, recent, err := s.prRepo.CountAssignments(ctx, userID, time.Now().Add(-dashboardRecentWindow))
+	if err != nil {
+		return nil, err
+	}
+	dashboard.ReviewCounts = api.ReviewCounts{AllTime: total, Last30Days: recent}
+
+	return dashboard, nil
+}
+
+// authoredPullRequests возвращает открытые PR автора с активностью их ревьюверов.
+func (s *userService) authoredPullRequests(ctx context.Context, authorID string) ([]api.AuthoredPullRequest, error) {
+	prs, err := s.prRepo.ListOpenByAuthor(ctx, authorID)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]struct{})
+	reviewerIDs := []string{}
+	for _, pr := range prs {
+		for _, id := range pr.AssignedReviewers {
+			if _, ok := seen[id]; !ok {
+				seen[id] = struct{}{}
+				reviewerIDs = append(reviewerIDs, id)
+			}
+		}
+	}
+
+	reviewers := make(map[string]api.User, len(reviewerIDs))
+	if len(reviewerIDs) > 0 {
+		users, err := s.userRepo.List(ctx, repository.UserFilter{UserIDs: reviewerIDs, SortBy: repository.UserSortByID})
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range users {
+			reviewers[u.UserId] = u
+		}
+	}
+
+	res := make([]api.AuthoredPullRequest, 0, len(prs))
+	for _, pr := range prs {
+		item := api.AuthoredPullRequest{
+			PullRequest: pr,
+			Reviewers:   make([]api.ReviewerState, 0, len(pr.AssignedReviewers)),
+		}
+		for _, id := range pr.AssignedReviewers {
+			u := reviewers[id]
+			item.Reviewers = append(item.Reviewers, api.ReviewerState{
+				UserId:   id,
+				Username: u.Username,
+				IsActive: u.IsActive,
+			})
+		}
+		res = append(res, item)
+	}
+	return res, nil
+}
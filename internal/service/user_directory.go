@@ -0,0 +1,136 @@
+package service
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/repository"
+	"context"
+)
+
+// userCursor — содержимое непрозрачного курсора справочника пользователей.
+type userCursor struct {
+	Sort    repository.UserSortField `json:"s"`
+	Desc    bool                     `json:"d,omitempty"`
+	SortKey string                   `json:"k"`
+	UserID  string                   `json:"id"`
+}
+
+func (s *userService) GetUser(ctx context.Context, userID string) (*api.User, error) {
+	if userID == "" {
+		return nil, ErrNotFound
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrNotFound
+	}
+
+	teams, err := s.userRepo.ListTeams(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if teams == nil {
+		teams = []string{}
+	}
+	user.Teams = &teams
+	return user, nil
+}
+
+func (s *userService) ListUsers(ctx context.Context, params api.GetUsersListParams) (*api.UserListPage, error) {
+	filter, err := userListFilter(params)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := filter.Limit
+	filter.Limit++
+	users, err := s.userRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &api.UserListPage{Users: users}
+	if page.Users == nil {
+		page.Users = []api.User{}
+	}
+	if len(users) > pageSize {
+		page.Users = users[:pageSize]
+		last := page.Users[pageSize-1]
+
+		cursor := userCursor{Sort: filter.SortBy, Desc: filter.Desc, SortKey: last.UserId, UserID: last.UserId}
+		if filter.SortBy == repository.UserSortByUsername {
+			cursor.SortKey = last.Username
+		}
+		next, err := encodeCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+		page.NextCursor = &next
+	}
+	return page, nil
+}
+
+// ExportUsers отдаёт всю выборку справочника без разбиения на страницы:
+// limit не учитывается, курсор задаёт начало выгрузки.
+func (s *userService) ExportUsers(ctx context.Context, params api.GetUsersListParams) (repository.Rows[api.User], error) {
+	filter, err := userListFilter(params)
+	if err != nil {
+		return nil, err
+	}
+	filter.Limit = 0
+	return s.userRepo.Stream(ctx, filter)
+}
+
+// userListFilter переводит параметры справочника в фильтр репозитория.
+func userListFilter(params api.GetUsersListParams) (repository.UserFilter, error) {
+	filter := repository.UserFilter{
+		TeamName: params.TeamName,
+		IsActive: params.IsActive,
+		SortBy:   repository.UserSortByID,
+		Limit:    defaultPageSize,
+	}
+	if params.Q != nil {
+		filter.Query = *params.Q
+	}
+	if params.Sort != nil {
+		switch *params.Sort {
+		case api.UserId:
+		case api.Username:
+			filter.SortBy = repository.UserSortByUsername
+		default:
+			return filter, ErrInvalidArgument
+		}
+	}
+	if params.Order != nil {
+		switch *params.Order {
+		case api.Asc:
+		case api.Desc:
+			filter.Desc = true
+		default:
+			return filter, ErrInvalidArgument
+		}
+	}
+	if params.Limit != nil {
+		if *params.Limit < 1 || *params.Limit > maxPageSize {
+			return filter, ErrInvalidArgument
+		}
+		filter.Limit = *params.Limit
+	}
+	if params.Cursor != nil && *params.Cursor != "" {
+		var cursor userCursor
+		if err := decodeCursor(*params.Cursor, &cursor); err != nil {
+			return filter, err
+		}
+		if cursor.UserID == "" {
+			return filter, ErrInvalidArgument
+		}
+		// Курсор действителен только для той же сортировки, по которой выдан.
+		if cursor.Sort != filter.SortBy || cursor.Desc != filter.Desc {
+			return filter, ErrInvalidArgument
+		}
+		filter.After = &repository.UserCursor{SortKey: cursor.SortKey, UserID: cursor.UserID}
+	}
+	return filter, nil
+}
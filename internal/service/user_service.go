@@ -7,8 +7,10 @@ import (
 )
 
 type userService struct {
-	userRepo repository.UserRepository
-	prRepo   repository.PRRepository
+	userRepo     repository.UserRepository
+	prRepo       repository.PRRepository
+	scheduleRepo repository.ActivationScheduleRepository
+	txManager    repository.TxManager
 }
 
 func (s *userService) SetIsActive(ctx context.Context, body api.PostUsersSetIsActiveJSONRequestBody) (*api.User, error) {
@@ -32,6 +34,33 @@ func (s *userService) SetIsActive(ctx context.Context, body api.PostUsersSetIsAc
 	return user, nil
 }
 
+func (s *userService) LinkExternalAccount(
+	ctx context.Context,
+	body api.PostUsersLinkExternalAccountJSONRequestBody,
+) (*api.ExternalAccount, error) {
+	if body.UserId == "" || body.Login == "" {
+		return nil, ErrNotFound
+	}
+
+	user, err := s.userRepo.GetByID(ctx, body.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrNotFound
+	}
+
+	account := api.ExternalAccount(body)
+	linked, err := s.userRepo.LinkExternalAccount(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	if !linked {
+		return nil, ErrLoginTaken
+	}
+	return &account, nil
+}
+
 func (s *userService) GetReviews(ctx context.Context, userID string) ([]api.PullRequestShort, error) {
 	if userID == "" {
 		return nil, ErrNotFound
@@ -45,6 +74,13 @@ func (s *userService) GetReviews(ctx context.Context, userID string) ([]api.Pull
 	return prs, nil
 }
 
+func (s *userService) ExportReviews(ctx context.Context, userID string) (repository.Rows[api.PullRequestShort], error) {
+	if userID == "" {
+		return nil, ErrNotFound
+	}
+	return s.prRepo.StreamShortByReviewer(ctx, userID)
+}
+
 func (s *userService) MassDeactivateTeamUsers(
 	ctx context.Context,
 	teamName string,
@@ -120,66 +156,12 @@ func (s *userService) MassDeactivateTeamUsers(
 		return res, nil
 	}
 	for _, deactivatedID := range targets {
-		prs, err := s.prRepo.ListShortByReviewer(ctx, deactivatedID)
+		reassigned, notReassigned, err := reassignOpenReviews(ctx, s.prRepo, deactivatedID, activeCandidates)
 		if err != nil {
 			return nil, err
 		}
-
-		for _, short := range prs {
-			if short.Status != api.PullRequestShortStatusOPEN {
-				continue
-			}
-
-			pr, err := s.prRepo.GetByID(ctx, short.PullRequestId)
-			if err != nil {
-				return nil, err
-			}
-			if pr == nil {
-				continue
-			}
-
-			found := false
-			for _, r := range pr.AssignedReviewers {
-				if r == deactivatedID {
-					found = true
-					break
-				}
-			}
-			if !found {
-				continue
-			}
-			exclude := make(map[string]struct{}, len(pr.AssignedReviewers)+1)
-			exclude[pr.AuthorId] = struct{}{}
-			for _, r := range pr.AssignedReviewers {
-				exclude[r] = struct{}{}
-			}
-
-			localCandidates := make([]api.User, 0, len(activeCandidates))
-			for _, u := range activeCandidates {
-				if _, skip := exclude[u.UserId]; skip {
-					continue
-				}
-				localCandidates = append(localCandidates, u)
-			}
-
-			if len(localCandidates) == 0 {
-				res.NotReassignedCount++
-				continue
-			}
-
-			newIDs := chooseRandomReviewers(localCandidates, 1)
-			if len(newIDs) == 0 {
-				res.NotReassignedCount++
-				continue
-			}
-			newID := newIDs[0]
-
-			if err := s.prRepo.ReplaceReviewer(ctx, pr.PullRequestId, deactivatedID, newID); err != nil {
-				return nil, err
-			}
-
-			res.ReassignedCount++
-		}
+		res.ReassignedCount += reassigned
+		res.NotReassignedCount += notReassigned
 	}
 
 	return res, nil
@@ -0,0 +1,14 @@
+CREATE TABLE user_activation_schedules
+(
+    id           BIGSERIAL PRIMARY KEY,
+    user_id      TEXT        NOT NULL REFERENCES users (user_id) ON DELETE CASCADE,
+    is_active    BOOLEAN     NOT NULL,
+    effective_at TIMESTAMPTZ NOT NULL,
+    status       TEXT        NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'APPLIED', 'CANCELLED')),
+    comment      TEXT,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT now(),
+    applied_at   TIMESTAMPTZ
+);
+
+CREATE INDEX idx_activation_schedules_due ON user_activation_schedules (effective_at) WHERE status = 'PENDING';
+CREATE INDEX idx_activation_schedules_user ON user_activation_schedules (user_id);
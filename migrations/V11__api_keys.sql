@@ -0,0 +1,12 @@
+CREATE TABLE api_keys
+(
+    id           BIGSERIAL PRIMARY KEY,
+    name         TEXT        NOT NULL,
+    prefix       TEXT        NOT NULL,
+    key_hash     TEXT        NOT NULL UNIQUE,
+    scopes       TEXT[]      NOT NULL CHECK (scopes <@ ARRAY ['read', 'write', 'admin'] AND cardinality(scopes) > 0),
+    expires_at   TIMESTAMPTZ,
+    last_used_at TIMESTAMPTZ,
+    revoked_at   TIMESTAMPTZ,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT now()
+);
@@ -0,0 +1,9 @@
+CREATE TABLE team_leads
+(
+    team_name  TEXT        NOT NULL REFERENCES teams (team_name) ON UPDATE CASCADE ON DELETE CASCADE,
+    user_id    TEXT        NOT NULL REFERENCES users (user_id) ON DELETE CASCADE,
+    granted_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (team_name, user_id)
+);
+
+CREATE INDEX idx_team_leads_user ON team_leads (user_id);
@@ -0,0 +1,20 @@
+CREATE TABLE audit_log
+(
+    id            BIGSERIAL PRIMARY KEY,
+    occurred_at   TIMESTAMPTZ NOT NULL DEFAULT now(),
+    operation_id  TEXT        NOT NULL,
+    method        TEXT        NOT NULL,
+    path          TEXT        NOT NULL,
+    status        INT         NOT NULL,
+    result        TEXT        NOT NULL CHECK (result IN ('success', 'failure', 'denied')),
+    actor_kind    TEXT,
+    actor         TEXT,
+    actor_user_id TEXT,
+    client_ip     TEXT        NOT NULL,
+    payload       JSONB       NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_audit_log_occurred_at ON audit_log (occurred_at);
+CREATE INDEX idx_audit_log_operation ON audit_log (operation_id, id);
+CREATE INDEX idx_audit_log_actor ON audit_log (actor, id);
+CREATE INDEX idx_audit_log_actor_user ON audit_log (actor_user_id, id);
@@ -0,0 +1,7 @@
+ALTER TABLE user_activation_schedules
+    DROP CONSTRAINT user_activation_schedules_status_check,
+    ADD CONSTRAINT user_activation_schedules_status_check
+        CHECK (status IN ('PENDING', 'APPLIED', 'CANCELLED', 'FAILED')),
+    ADD COLUMN failure_reason TEXT;
+
+COMMENT ON COLUMN user_activation_schedules.failure_reason IS 'Ошибка применения для статуса FAILED: такое изменение планировщик больше не берёт.';
@@ -0,0 +1,14 @@
+ALTER TABLE pull_requests
+    DROP CONSTRAINT pull_requests_status_check;
+
+ALTER TABLE pull_requests
+    ADD CONSTRAINT pull_requests_status_check CHECK (status IN ('OPEN', 'MERGED', 'CLOSED'));
+
+CREATE TABLE user_external_accounts
+(
+    provider TEXT NOT NULL CHECK (provider IN ('gitlab', 'github')),
+    login    TEXT NOT NULL,
+    user_id  TEXT NOT NULL REFERENCES users (user_id) ON DELETE CASCADE,
+    PRIMARY KEY (provider, login),
+    UNIQUE (provider, user_id)
+);
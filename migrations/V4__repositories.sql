@@ -0,0 +1,17 @@
+CREATE TABLE repositories
+(
+    repository_id       TEXT PRIMARY KEY,
+    team_name           TEXT        NOT NULL REFERENCES teams (team_name) ON DELETE RESTRICT,
+    reviewer_count      INT CHECK (reviewer_count BETWEEN 0 AND 10),
+    assignment_strategy TEXT CHECK (assignment_strategy IN ('random', 'least_loaded')),
+    reviewer_source     TEXT        NOT NULL DEFAULT 'author_team'
+        CHECK (reviewer_source IN ('author_team', 'owner_team')),
+    created_at          TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_repositories_team ON repositories (team_name);
+
+ALTER TABLE pull_requests
+    ADD COLUMN repository_id TEXT REFERENCES repositories (repository_id) ON DELETE RESTRICT;
+
+CREATE INDEX idx_pr_repository ON pull_requests (repository_id);
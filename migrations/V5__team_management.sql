@@ -0,0 +1,12 @@
+ALTER TABLE users
+    ALTER COLUMN team_name DROP NOT NULL;
+
+ALTER TABLE users
+    DROP CONSTRAINT users_team_name_fkey,
+    ADD CONSTRAINT users_team_name_fkey
+        FOREIGN KEY (team_name) REFERENCES teams (team_name) ON UPDATE CASCADE ON DELETE RESTRICT;
+
+ALTER TABLE repositories
+    DROP CONSTRAINT repositories_team_name_fkey,
+    ADD CONSTRAINT repositories_team_name_fkey
+        FOREIGN KEY (team_name) REFERENCES teams (team_name) ON UPDATE CASCADE ON DELETE RESTRICT;
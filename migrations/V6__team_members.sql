@@ -0,0 +1,16 @@
+CREATE TABLE team_members
+(
+    team_name TEXT        NOT NULL REFERENCES teams (team_name) ON UPDATE CASCADE ON DELETE CASCADE,
+    user_id   TEXT        NOT NULL REFERENCES users (user_id) ON DELETE CASCADE,
+    joined_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (team_name, user_id)
+);
+
+CREATE INDEX idx_team_members_user ON team_members (user_id);
+
+INSERT INTO team_members (team_name, user_id)
+SELECT team_name, user_id
+FROM users
+WHERE team_name IS NOT NULL;
+
+COMMENT ON COLUMN users.team_name IS 'Основная команда: к ней относятся PR, созданные пользователем. Всегда присутствует в team_members.';
@@ -0,0 +1,11 @@
+ALTER TABLE teams
+    ADD COLUMN parent_team_name    TEXT REFERENCES teams (team_name) ON UPDATE CASCADE ON DELETE RESTRICT,
+    ADD COLUMN reviewer_count      INT CHECK (reviewer_count BETWEEN 0 AND 10),
+    ADD COLUMN assignment_strategy TEXT CHECK (assignment_strategy IN ('random', 'least_loaded')),
+    ADD COLUMN review_sla_hours    INT CHECK (review_sla_hours > 0),
+    ADD COLUMN sibling_fallback    BOOLEAN,
+    ADD CONSTRAINT teams_parent_not_self CHECK (parent_team_name <> team_name);
+
+CREATE INDEX idx_teams_parent ON teams (parent_team_name);
+
+COMMENT ON COLUMN teams.reviewer_count IS 'NULL — наследуется от родительской команды';
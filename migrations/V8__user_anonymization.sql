@@ -0,0 +1,6 @@
+CREATE TABLE user_anonymizations
+(
+    user_id       TEXT PRIMARY KEY REFERENCES users (user_id) ON DELETE CASCADE,
+    anonymized_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    reason        TEXT
+);
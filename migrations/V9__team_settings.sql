@@ -0,0 +1,33 @@
+CREATE TABLE team_settings
+(
+    team_name  TEXT        NOT NULL REFERENCES teams (team_name) ON UPDATE CASCADE ON DELETE CASCADE,
+    version    INT         NOT NULL CHECK (version > 0),
+    settings   JSONB       NOT NULL,
+    comment    TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (team_name, version)
+);
+
+INSERT INTO team_settings (team_name, version, settings, comment)
+SELECT team_name,
+       1,
+       jsonb_strip_nulls(jsonb_build_object(
+               'reviewer_count', reviewer_count,
+               'assignment_strategy', assignment_strategy,
+               'review_sla_hours', review_sla_hours,
+               'sibling_fallback', sibling_fallback
+           )),
+       'migrated from teams'
+FROM teams
+WHERE reviewer_count IS NOT NULL
+   OR assignment_strategy IS NOT NULL
+   OR review_sla_hours IS NOT NULL
+   OR sibling_fallback IS NOT NULL;
+
+ALTER TABLE teams
+    DROP COLUMN reviewer_count,
+    DROP COLUMN assignment_strategy,
+    DROP COLUMN review_sla_hours,
+    DROP COLUMN sibling_fallback;
+
+COMMENT ON TABLE team_settings IS 'Версии собственных настроек команды; действующая — с наибольшим version';
@@ -0,0 +1,127 @@
+package tests
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/service"
+	"context"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestTeamService_ImportTeams_CSVCreatesTeamsAndMembers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := newTeamManagementFixture()
+
+	doc := "team_name,user_id,username,is_active\n" +
+		"payments,u_pay1,pay1,true\n" +
+		"payments,u_pay2,pay2,false\n" +
+		"backend,u_pay1,pay1,\n"
+
+	res, err := f.svc.ImportTeams(ctx, api.PostAdminImportParamsFormatCsv, []byte(doc), false)
+	require.NoError(t, err)
+	require.Empty(t, res.Errors)
+	require.True(t, res.Applied)
+	require.Equal(t, 1, res.TeamsCreated)
+	require.Equal(t, 3, res.MembersUpserted)
+
+	exists, err := f.teamRepo.Exists(ctx, "payments")
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	pay2, err := f.userRepo.GetByID(ctx, "u_pay2")
+	require.NoError(t, err)
+	require.False(t, pay2.IsActive)
+
+	teams, err := f.userRepo.ListTeams(ctx, "u_pay1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"backend", "payments"}, teams)
+}
+
+func TestTeamService_ImportTeams_ReportsRowErrorsWithoutApplying(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := newTeamManagementFixture()
+
+	doc := "team_name,user_id,username,is_active\n" +
+		"payments,u_pay1,pay1,true\n" +
+		"payments,,pay2,true\n" +
+		"payments,u_pay3,pay3,maybe\n" +
+		"mobile,u_pay1,other,true\n"
+
+	res, err := f.svc.ImportTeams(ctx, api.PostAdminImportParamsFormatCsv, []byte(doc), false)
+	require.NoError(t, err)
+	require.False(t, res.Applied)
+	require.Len(t, res.Errors, 3)
+
+	lines := make([]int, 0, len(res.Errors))
+	for _, e := range res.Errors {
+		lines = append(lines, e.Line)
+	}
+	require.ElementsMatch(t, []int{3, 4, 5}, lines)
+
+	exists, err := f.teamRepo.Exists(ctx, "payments")
+	require.NoError(t, err)
+	require.False(t, exists, "при ошибках ничего не записывается")
+	_, ok := f.userRepo.users["u_pay1"]
+	require.False(t, ok)
+}
+
+func TestTeamService_ImportTeams_YAMLDryRun(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := newTeamManagementFixture()
+
+	doc := `teams:
+  - team_name: payments
+    members:
+      - user_id: u_pay1
+        username: pay1
+      - user_id: u_pay1
+        username: pay1
+  - team_name: platform
+    members:
+      - user_id: u_plat
+        username: plat
+`
+
+	res, err := f.svc.ImportTeams(ctx, api.PostAdminImportParamsFormatYaml, []byte(doc), true)
+	require.NoError(t, err)
+	require.Len(t, res.Errors, 1)
+	require.Equal(t, 6, res.Errors[0].Line)
+
+	doc = `teams:
+  - team_name: payments
+    members:
+      - user_id: u_pay1
+        username: pay1
+        is_active: false
+  - team_name: platform
+    members:
+      - user_id: u_plat
+        username: plat
+`
+	res, err = f.svc.ImportTeams(ctx, api.PostAdminImportParamsFormatYaml, []byte(doc), true)
+	require.NoError(t, err)
+	require.Empty(t, res.Errors)
+	require.True(t, res.DryRun)
+	require.False(t, res.Applied)
+	require.Equal(t, 1, res.TeamsCreated)
+	require.Equal(t, 2, res.MembersUpserted)
+
+	exists, err := f.teamRepo.Exists(ctx, "payments")
+	require.NoError(t, err)
+	require.False(t, exists, "dry run ничего не меняет")
+}
+
+func TestTeamService_ImportTeams_RejectsEmptyDocument(t *testing.T) {
+	t.Parallel()
+
+	f := newTeamManagementFixture()
+
+	_, err := f.svc.ImportTeams(context.Background(), api.PostAdminImportParamsFormatCsv, []byte("  \n"), false)
+	require.ErrorIs(t, err, service.ErrInvalidArgument)
+}
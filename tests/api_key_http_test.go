@@ -0,0 +1,148 @@
+package tests
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	nethttp "avito-autumn2025-internship/internal/http"
+	"avito-autumn2025-internship/internal/http/handlers"
+	"avito-autumn2025-internship/internal/service"
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func doWithToken(t *testing.T, method, url, token string, body any) *http.Response {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if body != nil {
+		require.NoError(t, json.NewEncoder(&buf).Encode(body))
+	}
+	req, err := http.NewRequest(method, url, &buf)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = resp.Body.Close() })
+	return resp
+}
+
+func TestHTTP_APIKeys_ScopeEnforcement(t *testing.T) {
+	t.Parallel()
+
+	const adminToken = "secret-admin"
+
+	userRepo := newFakeUserRepo()
+	userRepo.AddUser(api.User{UserId: "u1", Username: "Alice", TeamName: "backend", IsActive: true})
+	prRepo := newFakePRRepo()
+	userSvc := service.NewUserService(userRepo, prRepo, newFakeActivationScheduleRepo(), fakeTxManager{})
+	keySvc := service.NewAPIKeyService(newFakeAPIKeyRepo())
+
+	ts := httptest.NewServer(nethttp.NewRouter(
+		newPRServiceStub(), newTeamServiceStub(), userSvc, newRepositoryServiceStub(), adminToken,
+		handlers.WithAPIKeys(keySvc),
+		handlers.WithRequireAuth(),
+	))
+	defer ts.Close()
+
+	resp := doWithToken(t, http.MethodGet, ts.URL+"/users/get?user_id=u1", "", nil)
+	require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	resp = doWithToken(t, http.MethodPost, ts.URL+"/admin/apiKeys", adminToken, map[string]any{
+		"name":   "dashboard",
+		"scopes": []string{"read"},
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var created api.PostAdminApiKeys201JSONResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&created))
+
+	resp = doWithToken(t, http.MethodGet, ts.URL+"/users/get?user_id=u1", created.Secret, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp = doWithToken(t, http.MethodPost, ts.URL+"/users/setIsActive", created.Secret, map[string]any{
+		"user_id":   "u1",
+		"is_active": false,
+	})
+	require.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	resp = doWithToken(t, http.MethodGet, ts.URL+"/admin/apiKeys", created.Secret, nil)
+	require.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	resp = doWithToken(t, http.MethodPost, ts.URL+"/admin/apiKeys/revoke", adminToken, map[string]any{
+		"id": created.Key.Id,
+	})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp = doWithToken(t, http.MethodGet, ts.URL+"/users/get?user_id=u1", created.Secret, nil)
+	require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestHTTP_AuthOptionalWithoutRequireAuth(t *testing.T) {
+	t.Parallel()
+
+	const adminToken = "secret-admin"
+
+	userRepo := newFakeUserRepo()
+	userRepo.AddUser(api.User{UserId: "u1", Username: "Alice", TeamName: "backend", IsActive: true})
+	userSvc := service.NewUserService(userRepo, newFakePRRepo(), newFakeActivationScheduleRepo(), fakeTxManager{})
+	keySvc := service.NewAPIKeyService(newFakeAPIKeyRepo())
+
+	ts := httptest.NewServer(nethttp.NewRouter(
+		newPRServiceStub(), newTeamServiceStub(), userSvc, newRepositoryServiceStub(), adminToken,
+		handlers.WithAPIKeys(keySvc),
+	))
+	defer ts.Close()
+
+	resp := doWithToken(t, http.MethodGet, ts.URL+"/users/get?user_id=u1", "", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode, "операции без admin открыты, как до авторизации")
+
+	resp = doWithToken(t, http.MethodGet, ts.URL+"/users/get?user_id=u1", "bogus", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp = doWithToken(t, http.MethodGet, ts.URL+"/auth/whoami", "", nil)
+	require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	resp = doWithToken(t, http.MethodPost, ts.URL+"/users/setIsActive", "", map[string]any{
+		"user_id":   "u1",
+		"is_active": false,
+	})
+	require.Equal(t, http.StatusUnauthorized, resp.StatusCode, "бывшие админские ручки по-прежнему требуют токен")
+
+	resp = doWithToken(t, http.MethodPost, ts.URL+"/admin/apiKeys", adminToken, map[string]any{
+		"name":   "dashboard",
+		"scopes": []string{"read"},
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var created api.PostAdminApiKeys201JSONResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&created))
+
+	resp = doWithToken(t, http.MethodGet, ts.URL+"/auth/whoami", created.Secret, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode, "предъявленный токен определяет вызывающего")
+
+	resp = doWithToken(t, http.MethodPost, ts.URL+"/users/setIsActive", created.Secret, map[string]any{
+		"user_id":   "u1",
+		"is_active": false,
+	})
+	require.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
+
+func TestServer_RevokeAPIKeyWithoutBody(t *testing.T) {
+	t.Parallel()
+
+	srv := handlers.NewServer(
+		newPRServiceStub(), newTeamServiceStub(), nil, newRepositoryServiceStub(), "secret-admin",
+		handlers.WithAPIKeys(service.NewAPIKeyService(newFakeAPIKeyRepo())),
+	)
+
+	resp, err := srv.PostAdminApiKeysRevoke(context.Background(), api.PostAdminApiKeysRevokeRequestObject{})
+	require.NoError(t, err)
+	badRequest, ok := resp.(api.PostAdminApiKeysRevoke400JSONResponse)
+	require.True(t, ok, "нет тела — 400, а не 500")
+	require.Equal(t, api.BADREQUEST, badRequest.Error.Code)
+}
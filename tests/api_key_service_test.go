@@ -0,0 +1,86 @@
+package tests
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/service"
+	"context"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAPIKeyService_Lifecycle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	svc := service.NewAPIKeyService(newFakeAPIKeyRepo())
+
+	key, secret, err := svc.CreateKey(ctx, api.ApiKeyCreateRequest{
+		Name:   "ci",
+		Scopes: []api.ApiKeyScope{api.ApiKeyScopeWrite},
+	})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(secret, key.Prefix))
+
+	got, err := svc.Authenticate(ctx, secret)
+	require.NoError(t, err)
+	require.Equal(t, key.Id, got.Id)
+	require.NotNil(t, got.LastUsedAt)
+
+	_, err = svc.Authenticate(ctx, secret+"x")
+	require.ErrorIs(t, err, service.ErrUnauthorized)
+
+	revoked, err := svc.RevokeKey(ctx, key.Id)
+	require.NoError(t, err)
+	require.NotNil(t, revoked.RevokedAt)
+
+	_, err = svc.Authenticate(ctx, secret)
+	require.ErrorIs(t, err, service.ErrUnauthorized)
+
+	active, err := svc.ListKeys(ctx, false)
+	require.NoError(t, err)
+	require.Empty(t, active)
+	all, err := svc.ListKeys(ctx, true)
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+
+	_, err = svc.RevokeKey(ctx, 42)
+	require.ErrorIs(t, err, service.ErrNotFound)
+}
+
+func TestAPIKeyService_RejectsExpiredAndInvalid(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	repo := newFakeAPIKeyRepo()
+	svc := service.NewAPIKeyService(repo)
+
+	past := time.Now().Add(-time.Hour)
+	_, _, err := svc.CreateKey(ctx, api.ApiKeyCreateRequest{
+		Name: "old", Scopes: []api.ApiKeyScope{api.ApiKeyScopeRead}, ExpiresAt: &past,
+	})
+	require.ErrorIs(t, err, service.ErrInvalidArgument)
+
+	_, _, err = svc.CreateKey(ctx, api.ApiKeyCreateRequest{Name: "bad", Scopes: []api.ApiKeyScope{"root"}})
+	require.ErrorIs(t, err, service.ErrInvalidArgument)
+
+	soon := time.Now().Add(time.Hour)
+	key, secret, err := svc.CreateKey(ctx, api.ApiKeyCreateRequest{
+		Name: "temp", Scopes: []api.ApiKeyScope{api.ApiKeyScopeRead}, ExpiresAt: &soon,
+	})
+	require.NoError(t, err)
+
+	repo.keys[key.Id-1].ExpiresAt = &past
+	_, err = svc.Authenticate(ctx, secret)
+	require.ErrorIs(t, err, service.ErrUnauthorized)
+}
+
+func TestHasScope(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, service.HasScope([]api.ApiKeyScope{api.ApiKeyScopeAdmin}, api.ApiKeyScopeRead))
+	require.True(t, service.HasScope([]api.ApiKeyScope{api.ApiKeyScopeWrite}, api.ApiKeyScopeWrite))
+	require.False(t, service.HasScope([]api.ApiKeyScope{api.ApiKeyScopeWrite}, api.ApiKeyScopeAdmin))
+	require.False(t, service.HasScope(nil, api.ApiKeyScopeRead))
+}
@@ -0,0 +1,175 @@
+package tests
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	nethttp "avito-autumn2025-internship/internal/http"
+	"avito-autumn2025-internship/internal/http/handlers"
+	"avito-autumn2025-internship/internal/service"
+	"encoding/json"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHTTP_AuditRecordsMutatingCalls(t *testing.T) {
+	t.Parallel()
+
+	const adminToken = "secret-admin"
+
+	userRepo := newFakeUserRepo()
+	userRepo.AddUser(api.User{UserId: "u1", Username: "Alice", TeamName: "backend", IsActive: true})
+	prRepo := newFakePRRepo()
+	userSvc := service.NewUserService(userRepo, prRepo, newFakeActivationScheduleRepo(), fakeTxManager{})
+	keySvc := service.NewAPIKeyService(newFakeAPIKeyRepo())
+	auditRepo := newFakeAuditRepo()
+
+	ts := httptest.NewServer(nethttp.NewRouter(
+		newPRServiceStub(), newTeamServiceStub(), userSvc, newRepositoryServiceStub(), adminToken,
+		handlers.WithAPIKeys(keySvc),
+		handlers.WithAudit(service.NewAuditService(auditRepo)),
+	))
+	defer ts.Close()
+
+	resp := doWithToken(t, http.MethodPost, ts.URL+"/admin/apiKeys", adminToken, map[string]any{
+		"name":   "dashboard",
+		"scopes": []string{"read"},
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var created api.PostAdminApiKeys201JSONResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&created))
+
+	resp = doWithToken(t, http.MethodPost, ts.URL+"/users/setIsActive", adminToken, map[string]any{
+		"user_id":   "u1",
+		"is_active": false,
+	})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp = doWithToken(t, http.MethodPost, ts.URL+"/users/setIsActive", created.Secret, map[string]any{
+		"user_id":   "u1",
+		"is_active": true,
+	})
+	require.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	resp = doWithToken(t, http.MethodPost, ts.URL+"/users/setIsActive", "", map[string]any{
+		"user_id":   "u1",
+		"is_active": true,
+	})
+	require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	resp = doWithToken(t, http.MethodGet, ts.URL+"/users/get?user_id=u1", created.Secret, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp = doWithToken(t, http.MethodGet, ts.URL+"/admin/audit?operation_id=PostUsersSetIsActive", adminToken, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var page api.AuditPage
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&page))
+	require.Len(t, page.Records, 3)
+
+	unauthorized, denied, ok := page.Records[0], page.Records[1], page.Records[2]
+
+	require.Equal(t, api.Success, ok.Result)
+	require.Equal(t, http.StatusOK, ok.Status)
+	require.Equal(t, http.MethodPost, ok.Method)
+	require.Equal(t, "/users/setIsActive", ok.Path)
+	require.Equal(t, "admin-token", *ok.Actor)
+	require.Equal(t, api.PrincipalKindAdminToken, *ok.ActorKind)
+	require.Equal(t, "127.0.0.1", ok.ClientIp)
+	require.Equal(t, map[string]interface{}{
+		"body": map[string]interface{}{"user_id": "u1", "is_active": false},
+	}, ok.Payload)
+
+	require.Equal(t, api.Denied, denied.Result)
+	require.Equal(t, http.StatusForbidden, denied.Status)
+	require.Equal(t, created.Key.Prefix, *denied.Actor, "отказ по scope пишется с вызывающим")
+
+	require.Equal(t, api.Denied, unauthorized.Result)
+	require.Nil(t, unauthorized.Actor)
+
+	resp = doWithToken(t, http.MethodGet, ts.URL+"/admin/audit", adminToken, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&page))
+	require.Len(t, page.Records, 4, "GET-запросы не пишутся")
+	require.Equal(t, "PostAdminApiKeys", page.Records[3].OperationId)
+
+	resp = doWithToken(t, http.MethodGet, ts.URL+"/admin/audit", created.Secret, nil)
+	require.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	resp = doWithToken(t, http.MethodGet, ts.URL+"/admin/audit?limit=0", adminToken, nil)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestHTTP_AuditPayloadSummary(t *testing.T) {
+	t.Parallel()
+
+	userRepo := newFakeUserRepo()
+	userSvc := service.NewUserService(userRepo, newFakePRRepo(), newFakeActivationScheduleRepo(), fakeTxManager{})
+	auditRepo := newFakeAuditRepo()
+	ts := httptest.NewServer(nethttp.NewRouter(
+		newPRServiceStub(), newTeamServiceStub(), userSvc, newRepositoryServiceStub(), "",
+		handlers.WithAudit(service.NewAuditService(auditRepo)),
+	))
+	defer ts.Close()
+
+	req, err := http.NewRequest(http.MethodPost, ts.URL+"/integrations/gitlab/webhook",
+		strings.NewReader(`{"object_kind":"merge_request","object_attributes":{"title":"`+strings.Repeat("x", 1000)+`"}}`))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Gitlab-Token", "gitlab-secret")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+
+	resp = doWithToken(t, http.MethodGet, ts.URL+"/admin/audit", "", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var page api.AuditPage
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&page))
+	require.Len(t, page.Records, 1)
+
+	payload := page.Records[0].Payload
+	params := payload["params"].(map[string]interface{})
+	require.Equal(t, "[redacted]", params["X-Gitlab-Token"])
+	attrs := payload["body"].(map[string]interface{})["object_attributes"].(map[string]interface{})
+	require.Less(t, len(attrs["title"].(string)), 300, "длинные строки обрезаются")
+}
+
+func TestHTTP_AuditKeepsNoNamesAfterAnonymize(t *testing.T) {
+	t.Parallel()
+
+	teamRepo := newFakeTeamRepo()
+	userRepo := newFakeUserRepo()
+	prRepo := newFakePRRepo()
+	teamSvc := service.NewTeamService(teamRepo, userRepo, prRepo, newFakeRepoRepo(), newFakeTeamSettingsRepo(teamRepo), fakeTxManager{})
+	userSvc := service.NewUserService(userRepo, prRepo, newFakeActivationScheduleRepo(), fakeTxManager{})
+	auditRepo := newFakeAuditRepo()
+
+	ts := httptest.NewServer(nethttp.NewRouter(
+		newPRServiceStub(), teamSvc, userSvc, newRepositoryServiceStub(), "",
+		handlers.WithAudit(service.NewAuditService(auditRepo)),
+	))
+	defer ts.Close()
+
+	resp := doWithToken(t, http.MethodPost, ts.URL+"/team/add", "", map[string]any{
+		"team_name": "backend",
+		"members": []map[string]any{
+			{"user_id": "u1", "username": "Alice Liddell", "is_active": true},
+			{"user_id": "u2", "username": "Bob", "is_active": true},
+		},
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	resp = doWithToken(t, http.MethodPost, ts.URL+"/users/anonymize", "", map[string]any{"user_id": "u1"})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp = doWithToken(t, http.MethodGet, ts.URL+"/admin/audit?operation_id=PostTeamAdd", "", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var page api.AuditPage
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&page))
+	require.Len(t, page.Records, 1)
+
+	raw, err := json.Marshal(page.Records[0].Payload)
+	require.NoError(t, err)
+	require.NotContains(t, string(raw), "Alice Liddell", "имя анонимизированного пользователя не остаётся в журнале")
+	require.Contains(t, string(raw), `"user_id":"u1"`)
+}
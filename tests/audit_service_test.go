@@ -0,0 +1,79 @@
+package tests
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/service"
+	"context"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestAuditService_ListFiltersAndPaginates(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	svc := service.NewAuditService(newFakeAuditRepo())
+
+	base := time.Date(2025, 11, 4, 10, 0, 0, 0, time.UTC)
+	alice, bot := "alice", "prk_bot00000"
+	for i, rec := range []api.AuditRecord{
+		{OperationId: "PostTeamAdd", Result: api.Success, Actor: &alice},
+		{OperationId: "PostUsersSetIsActive", Result: api.Success, Actor: &alice},
+		{OperationId: "PostUsersSetIsActive", Result: api.Denied, Actor: &bot},
+		{OperationId: "PostTeamMassDeactivate", Result: api.Success, Actor: &alice},
+		{OperationId: "PostPullRequestMerge", Result: api.Failure, Actor: &bot},
+	} {
+		rec.OccurredAt = base.Add(time.Duration(i) * time.Hour)
+		require.NoError(t, svc.Record(ctx, rec))
+	}
+
+	limit := 2
+	page, err := svc.List(ctx, api.GetAdminAuditParams{Actor: &alice, Limit: &limit})
+	require.NoError(t, err)
+	require.Len(t, page.Records, 2)
+	require.Equal(t, "PostTeamMassDeactivate", page.Records[0].OperationId, "новые записи первыми")
+	require.Equal(t, "PostUsersSetIsActive", page.Records[1].OperationId)
+	require.NotNil(t, page.NextCursor)
+
+	page, err = svc.List(ctx, api.GetAdminAuditParams{Actor: &alice, Limit: &limit, Cursor: page.NextCursor})
+	require.NoError(t, err)
+	require.Len(t, page.Records, 1)
+	require.Equal(t, "PostTeamAdd", page.Records[0].OperationId)
+	require.Nil(t, page.NextCursor)
+
+	denied := api.Denied
+	page, err = svc.List(ctx, api.GetAdminAuditParams{Result: &denied})
+	require.NoError(t, err)
+	require.Len(t, page.Records, 1)
+	require.Equal(t, bot, *page.Records[0].Actor)
+
+	from, to := base.Add(time.Hour), base.Add(3*time.Hour)
+	page, err = svc.List(ctx, api.GetAdminAuditParams{From: &from, To: &to})
+	require.NoError(t, err)
+	require.Len(t, page.Records, 2, "to не включается")
+}
+
+func TestAuditService_ListRejectsInvalidParams(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	svc := service.NewAuditService(newFakeAuditRepo())
+
+	tooMany := 500
+	_, err := svc.List(ctx, api.GetAdminAuditParams{Limit: &tooMany})
+	require.ErrorIs(t, err, service.ErrInvalidArgument)
+
+	from := time.Now()
+	to := from.Add(-time.Hour)
+	_, err = svc.List(ctx, api.GetAdminAuditParams{From: &from, To: &to})
+	require.ErrorIs(t, err, service.ErrInvalidArgument)
+
+	cursor := "not-a-cursor"
+	_, err = svc.List(ctx, api.GetAdminAuditParams{Cursor: &cursor})
+	require.ErrorIs(t, err, service.ErrInvalidArgument)
+
+	unknown := api.AuditResult("maybe")
+	_, err = svc.List(ctx, api.GetAdminAuditParams{Result: &unknown})
+	require.ErrorIs(t, err, service.ErrInvalidArgument)
+}
@@ -0,0 +1,198 @@
+package tests
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	nethttp "avito-autumn2025-internship/internal/http"
+	"avito-autumn2025-internship/internal/repository"
+	"avito-autumn2025-internship/internal/service"
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newExportServer(t *testing.T) (*httptest.Server, *fakePRRepo) {
+	t.Helper()
+
+	userRepo := newFakeUserRepo()
+	userRepo.AddUser(api.User{UserId: "u1", Username: "Alice", TeamName: "backend", IsActive: true})
+	userRepo.AddUser(api.User{UserId: "u2", Username: "Bob, Jr.", TeamName: "backend", IsActive: false})
+	userRepo.AddUser(api.User{UserId: "u3", Username: "Carol", TeamName: "platform", IsActive: true})
+	userRepo.addMembership("u3", "backend")
+
+	prRepo := newFakePRRepo()
+	prSvc := service.NewPRService(prRepo, userRepo, newFakeRepoRepo(), newFakeTeamRepo())
+	userSvc := service.NewUserService(userRepo, prRepo, newFakeActivationScheduleRepo(), fakeTxManager{})
+
+	ts := httptest.NewServer(nethttp.NewRouter(prSvc, newTeamServiceStub(), userSvc, newRepositoryServiceStub(), ""))
+	t.Cleanup(ts.Close)
+	return ts, prRepo
+}
+
+func getExport(t *testing.T, url, accept string) (int, string, string) {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	require.NoError(t, err)
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	raw, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp.StatusCode, resp.Header.Get("Content-Type"), string(raw)
+}
+
+func TestHTTP_UsersList_CSVExportIgnoresLimit(t *testing.T) {
+	t.Parallel()
+
+	ts, _ := newExportServer(t)
+
+	status, contentType, body := getExport(t, ts.URL+"/users/list?format=csv&limit=1", "application/json")
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, "text/csv", contentType)
+	require.Equal(t, strings.Join([]string{
+		"user_id,username,team_name,is_active,teams",
+		"u1,Alice,backend,true,backend",
+		`u2,"Bob, Jr.",backend,false,backend`,
+		"u3,Carol,platform,true,backend;platform",
+		"",
+	}, "\n"), body)
+}
+
+func TestHTTP_UsersList_NDJSONByAcceptHeader(t *testing.T) {
+	t.Parallel()
+
+	ts, _ := newExportServer(t)
+
+	status, contentType, body := getExport(t, ts.URL+"/users/list?is_active=true",
+		"text/html;q=0.9, application/x-ndjson")
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, "application/x-ndjson", contentType)
+
+	var ids []string
+	scanner := bufio.NewScanner(strings.NewReader(body))
+	for scanner.Scan() {
+		var u api.User
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &u))
+		ids = append(ids, u.UserId)
+	}
+	require.Equal(t, []string{"u1", "u3"}, ids)
+
+	status, _, _ = getExport(t, ts.URL+"/users/list?format=xml", "")
+	require.Equal(t, http.StatusBadRequest, status)
+}
+
+// slowUserService отдаёт выгрузку пользователей с паузой перед каждой записью.
+type slowUserService struct {
+	service.UserService
+	delay time.Duration
+}
+
+func (s slowUserService) ExportUsers(ctx context.Context, params api.GetUsersListParams) (repository.Rows[api.User], error) {
+	rows, err := s.UserService.ExportUsers(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return slowRows[api.User]{Rows: rows, delay: s.delay}, nil
+}
+
+type slowRows[T any] struct {
+	repository.Rows[T]
+	delay time.Duration
+}
+
+func (r slowRows[T]) Next() bool {
+	time.Sleep(r.delay)
+	return r.Rows.Next()
+}
+
+func TestHTTP_UsersList_ExportOutlivesWriteTimeout(t *testing.T) {
+	t.Parallel()
+
+	userRepo := newFakeUserRepo()
+	for i := 0; i < 5; i++ {
+		userRepo.AddUser(api.User{UserId: fmt.Sprintf("u%d", i), Username: "user", TeamName: "backend", IsActive: true})
+	}
+	prRepo := newFakePRRepo()
+	prSvc := service.NewPRService(prRepo, userRepo, newFakeRepoRepo(), newFakeTeamRepo())
+	userSvc := slowUserService{
+		UserService: service.NewUserService(userRepo, prRepo, newFakeActivationScheduleRepo(), fakeTxManager{}),
+		delay:       50 * time.Millisecond,
+	}
+
+	ts := httptest.NewUnstartedServer(nethttp.NewRouter(prSvc, newTeamServiceStub(), userSvc, newRepositoryServiceStub(), ""))
+	ts.Config.WriteTimeout = 100 * time.Millisecond
+	ts.Start()
+	t.Cleanup(ts.Close)
+
+	status, _, body := getExport(t, ts.URL+"/users/list?format=ndjson", "")
+	require.Equal(t, http.StatusOK, status)
+	require.Len(t, strings.Split(strings.TrimSpace(body), "\n"), 5, "выгрузка длиннее WriteTimeout доходит целиком")
+}
+
+func TestHTTP_UsersGetReview_CSVExport(t *testing.T) {
+	t.Parallel()
+
+	ts, prRepo := newExportServer(t)
+	prRepo.AddShortForReviewer("u1", api.PullRequestShort{
+		PullRequestId:   "pr-1",
+		PullRequestName: "Add search",
+		AuthorId:        "u3",
+		Status:          api.PullRequestShortStatusOPEN,
+	})
+
+	status, _, body := getExport(t, ts.URL+"/users/getReview?user_id=u1", "text/csv")
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, "pull_request_id,pull_request_name,author_id,status\npr-1,Add search,u3,OPEN\n", body)
+
+	status, _, body = getExport(t, ts.URL+"/users/getReview?user_id=ghost&format=ndjson", "")
+	require.Equal(t, http.StatusOK, status)
+	require.Empty(t, body)
+}
+
+func TestHTTP_StatsReviewerAssignments_CSVExport(t *testing.T) {
+	t.Parallel()
+
+	ts, prRepo := newExportServer(t)
+	team := "backend"
+	prRepo.stats = []repository.ReviewerAssignmentsStat{
+		{UserID: "u1", TeamName: &team, Count: 4},
+		{UserID: "u3", TeamName: &team, Count: 1},
+	}
+
+	status, _, body := getExport(t, ts.URL+"/stats/reviewerAssignments?group_by=team&format=csv", "")
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, "user_id,assigned_count,team_name,week_start\nu1,4,backend,\nu3,1,backend,\n", body)
+}
+
+func TestHTTP_StatsReviewGraph_DOT(t *testing.T) {
+	t.Parallel()
+
+	ts, prRepo := newExportServer(t)
+	prRepo.reviewGraph = []repository.ReviewEdge{
+		{AuthorID: "u1", ReviewerID: `u"3`, Count: 2},
+	}
+
+	status, contentType, body := getExport(t, ts.URL+"/stats/reviewGraph", "text/vnd.graphviz")
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, "text/vnd.graphviz", contentType)
+	require.Equal(t, "digraph review_graph {\n  \"u1\" -> \"u\\\"3\" [weight=2, label=\"2\"];\n}\n", body)
+
+	status, _, _ = getExport(t, ts.URL+"/stats/reviewGraph?format=csv", "")
+	require.Equal(t, http.StatusBadRequest, status)
+
+	status, contentType, _ = getExport(t, ts.URL+"/users/list", "text/vnd.graphviz")
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, "application/json", contentType, "dot допустим только для графа")
+}
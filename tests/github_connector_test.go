@@ -0,0 +1,137 @@
+package tests
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/connector"
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+type gitHubCall struct {
+	Method    string
+	Path      string
+	Reviewers []string
+}
+
+type fakeGitHub struct {
+	mu       sync.Mutex
+	calls    []gitHubCall
+	failures int
+}
+
+func (f *fakeGitHub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var body struct {
+		Reviewers []string `json:"reviewers"`
+	}
+	_ = json.NewDecoder(r.Body).Decode(&body)
+	f.calls = append(f.calls, gitHubCall{Method: r.Method, Path: r.URL.Path, Reviewers: body.Reviewers})
+
+	if f.failures > 0 {
+		f.failures--
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+	if r.Method == http.MethodPost {
+		w.WriteHeader(http.StatusCreated)
+	}
+	_, _ = w.Write([]byte(`{}`))
+}
+
+func newGitHubConnector(t *testing.T, fake *fakeGitHub) *connector.GitHub {
+	t.Helper()
+
+	ts := httptest.NewServer(fake)
+	t.Cleanup(ts.Close)
+
+	userRepo := newFakeUserRepo()
+	for id, login := range map[string]string{"u_r1": "rev-one", "u_r2": "rev-two", "u_r3": "rev-three"} {
+		userRepo.AddUser(api.User{UserId: id, Username: id, TeamName: "backend", IsActive: true})
+		_, _ = userRepo.LinkExternalAccount(context.Background(), api.ExternalAccount{
+			UserId:   id,
+			Provider: api.Github,
+			Login:    login,
+		})
+	}
+
+	return connector.NewGitHub(connector.GitHubConfig{
+		BaseURL:    ts.URL,
+		Token:      "gh-token",
+		MaxRetries: 2,
+		RetryDelay: time.Millisecond,
+	}, userRepo)
+}
+
+func TestGitHubConnector_RequestAndRemoveReviewers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fake := &fakeGitHub{}
+	gh := newGitHubConnector(t, fake)
+
+	prID := connector.GitHubPullRequestID(connector.PullRequestRef{Owner: "acme", Repo: "api", Number: 7})
+	const path = "/repos/acme/api/pulls/7/requested_reviewers"
+
+	require.NoError(t, gh.RequestReviewers(ctx, prID, []string{"u_r2", "u_r1"}))
+	require.NoError(t, gh.RequestReviewers(ctx, prID, []string{"u_r1", "u_r2"}))
+	require.NoError(t, gh.RemoveReviewers(ctx, prID, []string{"u_r1"}))
+
+	// Новый экземпляр (рестарт или другая реплика) снимает ревьювера, которого
+	// сам не запрашивал.
+	restarted := newGitHubConnector(t, fake)
+	require.NoError(t, restarted.RemoveReviewers(ctx, prID, []string{"u_r3"}))
+
+	require.Equal(t, []gitHubCall{
+		{Method: http.MethodPost, Path: path, Reviewers: []string{"rev-one", "rev-two"}},
+		{Method: http.MethodPost, Path: path, Reviewers: []string{"rev-one", "rev-two"}},
+		{Method: http.MethodDelete, Path: path, Reviewers: []string{"rev-one"}},
+		{Method: http.MethodDelete, Path: path, Reviewers: []string{"rev-three"}},
+	}, fake.calls)
+}
+
+func TestGitHubConnector_RetriesServerErrors(t *testing.T) {
+	t.Parallel()
+
+	fake := &fakeGitHub{failures: 2}
+	gh := newGitHubConnector(t, fake)
+
+	prID := connector.GitHubPullRequestID(connector.PullRequestRef{Owner: "acme", Repo: "api", Number: 8})
+
+	require.NoError(t, gh.RequestReviewers(context.Background(), prID, []string{"u_r1"}))
+	require.Len(t, fake.calls, 3)
+}
+
+func TestGitHubConnector_GivesUpAfterMaxRetries(t *testing.T) {
+	t.Parallel()
+
+	fake := &fakeGitHub{failures: 10}
+	gh := newGitHubConnector(t, fake)
+
+	prID := connector.GitHubPullRequestID(connector.PullRequestRef{Owner: "acme", Repo: "api", Number: 9})
+
+	err := gh.RequestReviewers(context.Background(), prID, []string{"u_r1"})
+	require.Error(t, err)
+
+	var ghErr *connector.GitHubError
+	require.ErrorAs(t, err, &ghErr)
+	require.Equal(t, http.StatusBadGateway, ghErr.StatusCode)
+	require.Len(t, fake.calls, 3)
+}
+
+func TestGitHubConnector_SkipsForeignPullRequests(t *testing.T) {
+	t.Parallel()
+
+	fake := &fakeGitHub{}
+	gh := newGitHubConnector(t, fake)
+
+	require.NoError(t, gh.RequestReviewers(context.Background(), "pr-1001", []string{"u_r1"}))
+	require.Empty(t, fake.calls)
+}
@@ -0,0 +1,187 @@
+package tests
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	nethttp "avito-autumn2025-internship/internal/http"
+	"avito-autumn2025-internship/internal/http/handlers"
+	"avito-autumn2025-internship/internal/service"
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const gitLabTestToken = "gitlab-secret"
+
+func newGitLabTestServer(t *testing.T, userRepo *fakeUserRepo, prRepo *fakePRRepo) *httptest.Server {
+	t.Helper()
+
+	prSvc := service.NewPRService(prRepo, userRepo, newFakeRepoRepo(), newFakeTeamRepo())
+	userSvc := service.NewUserService(userRepo, prRepo, newFakeActivationScheduleRepo(), fakeTxManager{})
+	gitLabSvc := service.NewGitLabService(prSvc, userRepo)
+
+	handler := nethttp.NewRouter(prSvc, newTeamServiceStub(), userSvc, newRepositoryServiceStub(), "",
+		handlers.WithGitLabWebhook(gitLabSvc, gitLabTestToken))
+	ts := httptest.NewServer(handler)
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func sendGitLabEvent(t *testing.T, url, token string, event map[string]interface{}) (*http.Response, api.WebhookResult) {
+	t.Helper()
+
+	bodyBytes, err := json.Marshal(event)
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodPost, url+"/integrations/gitlab/webhook", bytes.NewReader(bodyBytes))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Gitlab-Event", "Merge Request Hook")
+	req.Header.Set("X-Gitlab-Token", token)
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer func(Body io.ReadCloser) {
+		_ = Body.Close()
+	}(resp.Body)
+
+	var result api.WebhookResult
+	if resp.StatusCode == http.StatusOK {
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+	}
+	return resp, result
+}
+
+func mergeRequestEvent(action string, draft bool) map[string]interface{} {
+	return map[string]interface{}{
+		"object_kind": "merge_request",
+		"user":        map[string]interface{}{"username": "alice.gl"},
+		"project":     map[string]interface{}{"path_with_namespace": "backend/api"},
+		"object_attributes": map[string]interface{}{
+			"iid":    42,
+			"title":  "Add search",
+			"action": action,
+			"draft":  draft,
+		},
+	}
+}
+
+func seedGitLabTeam(userRepo *fakeUserRepo) {
+	userRepo.AddUser(api.User{UserId: "u_author", Username: "alice", TeamName: "backend", IsActive: true})
+	userRepo.AddUser(api.User{UserId: "u_r1", Username: "rev1", TeamName: "backend", IsActive: true})
+	userRepo.AddUser(api.User{UserId: "u_r2", Username: "rev2", TeamName: "backend", IsActive: true})
+	_, _ = userRepo.LinkExternalAccount(context.Background(), api.ExternalAccount{
+		UserId:   "u_author",
+		Provider: api.Gitlab,
+		Login:    "alice.gl",
+	})
+}
+
+func TestHTTP_GitLabWebhook_OpenAndClose(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	userRepo := newFakeUserRepo()
+	prRepo := newFakePRRepo()
+	seedGitLabTeam(userRepo)
+
+	ts := newGitLabTestServer(t, userRepo, prRepo)
+	prID := service.GitLabPullRequestID("backend/api", 42)
+
+	resp, result := sendGitLabEvent(t, ts.URL, gitLabTestToken, mergeRequestEvent("open", false))
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, api.Processed, result.Status)
+	require.Equal(t, prID, *result.PullRequestId)
+
+	pr, err := prRepo.GetByID(ctx, prID)
+	require.NoError(t, err)
+	require.NotNil(t, pr)
+	require.Equal(t, "u_author", pr.AuthorId)
+	require.ElementsMatch(t, []string{"u_r1", "u_r2"}, pr.AssignedReviewers)
+
+	resp, result = sendGitLabEvent(t, ts.URL, gitLabTestToken, mergeRequestEvent("close", false))
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, api.Processed, result.Status)
+
+	pr, err = prRepo.GetByID(ctx, prID)
+	require.NoError(t, err)
+	require.Equal(t, api.PullRequestStatusCLOSED, pr.Status)
+
+	resp, result = sendGitLabEvent(t, ts.URL, gitLabTestToken, mergeRequestEvent("reopen", false))
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, api.Processed, result.Status)
+
+	pr, err = prRepo.GetByID(ctx, prID)
+	require.NoError(t, err)
+	require.Equal(t, api.PullRequestStatusOPEN, pr.Status)
+}
+
+func TestHTTP_GitLabWebhook_DraftIgnoredUntilReady(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	userRepo := newFakeUserRepo()
+	prRepo := newFakePRRepo()
+	seedGitLabTeam(userRepo)
+
+	ts := newGitLabTestServer(t, userRepo, prRepo)
+	prID := service.GitLabPullRequestID("backend/api", 42)
+
+	resp, result := sendGitLabEvent(t, ts.URL, gitLabTestToken, mergeRequestEvent("open", true))
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, api.Ignored, result.Status)
+
+	pr, err := prRepo.GetByID(ctx, prID)
+	require.NoError(t, err)
+	require.Nil(t, pr)
+
+	ready := mergeRequestEvent("update", false)
+	ready["changes"] = map[string]interface{}{
+		"draft": map[string]interface{}{"previous": true, "current": false},
+	}
+	resp, result = sendGitLabEvent(t, ts.URL, gitLabTestToken, ready)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, api.Processed, result.Status)
+
+	pr, err = prRepo.GetByID(ctx, prID)
+	require.NoError(t, err)
+	require.NotNil(t, pr)
+	require.Len(t, pr.AssignedReviewers, 2)
+}
+
+func TestHTTP_GitLabWebhook_InvalidToken(t *testing.T) {
+	t.Parallel()
+
+	userRepo := newFakeUserRepo()
+	prRepo := newFakePRRepo()
+	seedGitLabTeam(userRepo)
+
+	ts := newGitLabTestServer(t, userRepo, prRepo)
+
+	resp, _ := sendGitLabEvent(t, ts.URL, "wrong", mergeRequestEvent("open", false))
+	require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	require.Empty(t, prRepo.prs)
+}
+
+func TestServer_GitLabWebhookWithoutBody(t *testing.T) {
+	t.Parallel()
+
+	userRepo := newFakeUserRepo()
+	prRepo := newFakePRRepo()
+	prSvc := service.NewPRService(prRepo, userRepo, newFakeRepoRepo(), newFakeTeamRepo())
+	srv := handlers.NewServer(prSvc, newTeamServiceStub(), nil, newRepositoryServiceStub(), "",
+		handlers.WithGitLabWebhook(service.NewGitLabService(prSvc, userRepo), gitLabTestToken))
+
+	token := gitLabTestToken
+	resp, err := srv.PostIntegrationsGitlabWebhook(context.Background(), api.PostIntegrationsGitlabWebhookRequestObject{
+		Params: api.PostIntegrationsGitlabWebhookParams{XGitlabToken: &token},
+	})
+	require.NoError(t, err)
+	badRequest, ok := resp.(api.PostIntegrationsGitlabWebhook400JSONResponse)
+	require.True(t, ok, "нет тела — 400, интеграция при этом настроена")
+	require.Equal(t, api.BADREQUEST, badRequest.Error.Code)
+}
@@ -0,0 +1,309 @@
+package tests
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/auth"
+	nethttp "avito-autumn2025-internship/internal/http"
+	"avito-autumn2025-internship/internal/http/handlers"
+	"avito-autumn2025-internship/internal/service"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"github.com/stretchr/testify/require"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const (
+	testIssuer   = "https://sso.example.com"
+	testAudience = "pr-service"
+)
+
+func b64(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func signJWT(t *testing.T, key crypto.Signer, kid string, claims map[string]any) string {
+	t.Helper()
+
+	alg := "RS256"
+	if _, ok := key.(*ecdsa.PrivateKey); ok {
+		alg = "ES256"
+	}
+	header, err := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+
+	signed := b64(header) + "." + b64(payload)
+	digest := sha256.Sum256([]byte(signed))
+
+	var sig []byte
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		sig, err = rsa.SignPKCS1v15(rand.Reader, k, crypto.SHA256, digest[:])
+		require.NoError(t, err)
+	case *ecdsa.PrivateKey:
+		r, s, err := ecdsa.Sign(rand.Reader, k, digest[:])
+		require.NoError(t, err)
+		sig = append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+	}
+	return signed + "." + b64(sig)
+}
+
+func jwksJSON(t *testing.T, keys map[string]crypto.PublicKey) []byte {
+	t.Helper()
+
+	var doc struct {
+		Keys []map[string]string `json:"keys"`
+	}
+	for kid, key := range keys {
+		switch k := key.(type) {
+		case *rsa.PublicKey:
+			doc.Keys = append(doc.Keys, map[string]string{
+				"kty": "RSA", "kid": kid, "use": "sig",
+				"n": b64(k.N.Bytes()), "e": b64(big.NewInt(int64(k.E)).Bytes()),
+			})
+		case *ecdsa.PublicKey:
+			doc.Keys = append(doc.Keys, map[string]string{
+				"kty": "EC", "kid": kid, "crv": "P-256",
+				"x": b64(k.X.FillBytes(make([]byte, 32))), "y": b64(k.Y.FillBytes(make([]byte, 32))),
+			})
+		}
+	}
+	data, err := json.Marshal(doc)
+	require.NoError(t, err)
+	return data
+}
+
+func writeJWKSFile(t *testing.T, keys map[string]crypto.PublicKey) auth.KeySet {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, jwksJSON(t, keys), 0o600))
+	set, err := auth.LoadJWKSFile(path)
+	require.NoError(t, err)
+	return set
+}
+
+func validClaims() map[string]any {
+	return map[string]any{
+		"iss":     testIssuer,
+		"aud":     []string{testAudience, "other"},
+		"sub":     "sso-42",
+		"user_id": "u1",
+		"exp":     time.Now().Add(time.Hour).Unix(),
+		"realm_access": map[string]any{
+			"roles": []string{"member"},
+		},
+	}
+}
+
+func TestJWTVerifier_ValidatesTokens(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	keys := writeJWKSFile(t, map[string]crypto.PublicKey{"rsa": &rsaKey.PublicKey, "ec": &ecKey.PublicKey})
+	verifier := auth.NewVerifier(keys, auth.VerifierConfig{
+		Issuer:      testIssuer,
+		Audience:    testAudience,
+		UserIDClaim: "user_id",
+		RolesClaim:  "realm_access.roles",
+	})
+
+	identity, err := verifier.Verify(ctx, signJWT(t, rsaKey, "rsa", validClaims()))
+	require.NoError(t, err)
+	require.Equal(t, api.PrincipalKindJwt, identity.Kind)
+	require.Equal(t, "u1", identity.UserID)
+	require.Equal(t, "sso-42", identity.Subject)
+	require.Equal(t, []string{"member"}, identity.Roles)
+	require.Equal(t, []api.ApiKeyScope{api.ApiKeyScopeWrite}, identity.Scopes)
+
+	admin := validClaims()
+	admin["realm_access"] = map[string]any{"roles": []string{"admin"}}
+	identity, err = verifier.Verify(ctx, signJWT(t, ecKey, "ec", admin))
+	require.NoError(t, err)
+	require.Equal(t, []api.ApiKeyScope{api.ApiKeyScopeAdmin}, identity.Scopes)
+
+	cases := map[string]func() string{
+		"expired": func() string {
+			c := validClaims()
+			c["exp"] = time.Now().Add(-2 * time.Minute).Unix()
+			return signJWT(t, rsaKey, "rsa", c)
+		},
+		"no exp": func() string {
+			c := validClaims()
+			delete(c, "exp")
+			return signJWT(t, rsaKey, "rsa", c)
+		},
+		"wrong issuer": func() string {
+			c := validClaims()
+			c["iss"] = "https://evil.example.com"
+			return signJWT(t, rsaKey, "rsa", c)
+		},
+		"wrong audience": func() string {
+			c := validClaims()
+			c["aud"] = "other"
+			return signJWT(t, rsaKey, "rsa", c)
+		},
+		"no user id": func() string {
+			c := validClaims()
+			delete(c, "user_id")
+			return signJWT(t, rsaKey, "rsa", c)
+		},
+		"foreign signature": func() string {
+			return signJWT(t, otherKey, "rsa", validClaims())
+		},
+		"unknown kid": func() string {
+			return signJWT(t, rsaKey, "rotated", validClaims())
+		},
+		"alg none": func() string {
+			header := b64([]byte(`{"alg":"none","kid":"rsa"}`))
+			payload, _ := json.Marshal(validClaims())
+			return header + "." + b64(payload) + "."
+		},
+	}
+	for name, token := range cases {
+		_, err := verifier.Verify(ctx, token())
+		require.ErrorIs(t, err, auth.ErrInvalidToken, name)
+	}
+}
+
+func TestRemoteKeySet_CachesAndRefetchesOnRotation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	oldKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	newKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	var fetches atomic.Int32
+	var body atomic.Value
+	body.Store(jwksJSON(t, map[string]crypto.PublicKey{"k1": &oldKey.PublicKey}))
+	idp := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		_, _ = w.Write(body.Load().([]byte))
+	}))
+	defer idp.Close()
+
+	keys := auth.NewRemoteKeySet(idp.URL, time.Hour, idp.Client())
+	verifier := auth.NewVerifier(keys, auth.VerifierConfig{Issuer: testIssuer, Audience: testAudience})
+
+	for range 3 {
+		_, err := verifier.Verify(ctx, signJWT(t, oldKey, "k1", validClaims()))
+		require.NoError(t, err)
+	}
+	require.Equal(t, int32(1), fetches.Load(), "набор ключей кэшируется")
+
+	body.Store(jwksJSON(t, map[string]crypto.PublicKey{"k2": &newKey.PublicKey}))
+	_, err = verifier.Verify(ctx, signJWT(t, newKey, "k2", validClaims()))
+	require.ErrorIs(t, err, auth.ErrInvalidToken, "досрочная перезагрузка не чаще раза в минуту")
+	require.Equal(t, int32(1), fetches.Load())
+}
+
+func TestRemoteKeySet_UnavailableIdPYieldsInvalidToken(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	var fetches atomic.Int32
+	release := make(chan struct{})
+	idp := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		<-release
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer idp.Close()
+
+	keys := auth.NewRemoteKeySet(idp.URL, time.Hour, idp.Client())
+	verifier := auth.NewVerifier(keys, auth.VerifierConfig{Issuer: testIssuer, Audience: testAudience})
+	token := signJWT(t, key, "k1", validClaims())
+
+	// Параллельные запросы ждут одну загрузку, а не запускают свои.
+	var wg sync.WaitGroup
+	errs := make([]error, 5)
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, errs[i] = verifier.Verify(ctx, token)
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	for _, err := range errs {
+		require.ErrorIs(t, err, auth.ErrInvalidToken)
+	}
+	_, err = verifier.Verify(ctx, token)
+	require.ErrorIs(t, err, auth.ErrInvalidToken)
+	require.Equal(t, int32(1), fetches.Load(), "пустой набор перечитывается не чаще раза в минуту")
+}
+
+func TestHTTP_JWTAuthentication(t *testing.T) {
+	t.Parallel()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	verifier := auth.NewVerifier(
+		writeJWKSFile(t, map[string]crypto.PublicKey{"k1": &key.PublicKey}),
+		auth.VerifierConfig{Issuer: testIssuer, Audience: testAudience},
+	)
+
+	userRepo := newFakeUserRepo()
+	userRepo.AddUser(api.User{UserId: "u1", Username: "Alice", TeamName: "backend", IsActive: true})
+	userSvc := service.NewUserService(userRepo, newFakePRRepo(), newFakeActivationScheduleRepo(), fakeTxManager{})
+
+	ts := httptest.NewServer(nethttp.NewRouter(
+		newPRServiceStub(), newTeamServiceStub(), userSvc, newRepositoryServiceStub(), "",
+		handlers.WithJWT(verifier),
+		handlers.WithRequireAuth(),
+	))
+	defer ts.Close()
+
+	member := signJWT(t, key, "k1", validClaims())
+
+	resp := doWithToken(t, http.MethodGet, ts.URL+"/users/get?user_id=u1", "", nil)
+	require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	resp = doWithToken(t, http.MethodGet, ts.URL+"/users/get?user_id=u1", member, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp = doWithToken(t, http.MethodPost, ts.URL+"/users/setIsActive", member, map[string]any{
+		"user_id":   "u1",
+		"is_active": false,
+	})
+	require.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	claims := validClaims()
+	claims["roles"] = []string{"admin"}
+	resp = doWithToken(t, http.MethodPost, ts.URL+"/users/setIsActive", signJWT(t, key, "k1", claims), map[string]any{
+		"user_id":   "u1",
+		"is_active": false,
+	})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
@@ -0,0 +1,74 @@
+package tests
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	nethttp "avito-autumn2025-internship/internal/http"
+	"avito-autumn2025-internship/internal/metrics"
+	"avito-autumn2025-internship/internal/service"
+	"bytes"
+	"encoding/json"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHTTP_Metrics_CountsOperationsAndDomainEvents(t *testing.T) {
+	t.Parallel()
+
+	userRepo := newFakeUserRepo()
+	prRepo := newFakePRRepo()
+	for _, id := range []string{"u_author", "u_r1"} {
+		userRepo.AddUser(api.User{UserId: id, Username: id, TeamName: "backend", IsActive: true})
+	}
+
+	reg := prometheus.NewRegistry()
+	m := metrics.New(reg)
+
+	prSvc := service.NewInstrumentedPRService(
+		service.NewPRService(prRepo, userRepo, newFakeRepoRepo(), newFakeTeamRepo()), m)
+	userSvc := service.NewUserService(userRepo, prRepo, newFakeActivationScheduleRepo(), fakeTxManager{})
+	router := nethttp.NewRouter(prSvc, newTeamServiceStub(), userSvc, newRepositoryServiceStub(), "")
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(reg, promhttp.HandlerOpts{}))
+	mux.Handle("/", nethttp.MetricsMiddleware(m)(router))
+	ts := httptest.NewServer(mux)
+	t.Cleanup(ts.Close)
+
+	post := func(path string, body any) int {
+		raw, err := json.Marshal(body)
+		require.NoError(t, err)
+		resp, err := http.Post(ts.URL+path, "application/json", bytes.NewReader(raw))
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	require.Equal(t, http.StatusCreated, post("/pullRequest/create", api.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   "pr-1",
+		PullRequestName: "metrics",
+		AuthorId:        "u_author",
+	}))
+	require.Equal(t, http.StatusConflict, post("/pullRequest/reassign", api.PostPullRequestReassignJSONRequestBody{
+		PullRequestId: "pr-1",
+		OldUserId:     "u_r1",
+	}))
+
+	resp, err := http.Get(ts.URL + "/metrics")
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+	raw, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	text := string(raw)
+
+	require.Contains(t, text, `pr_reviewer_http_requests_total{code="201",operation="PostPullRequestCreate"} 1`)
+	require.Contains(t, text, `pr_reviewer_http_requests_total{code="409",operation="PostPullRequestReassign"} 1`)
+	require.Contains(t, text, `pr_reviewer_http_request_duration_seconds_count{operation="PostPullRequestCreate"} 1`)
+	require.Contains(t, text, "pr_reviewer_pull_requests_created_total 1")
+	require.Contains(t, text, "pr_reviewer_reviewers_assigned_total 1")
+	require.Contains(t, text, "pr_reviewer_no_candidate_total 1")
+}
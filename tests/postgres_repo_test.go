@@ -2,11 +2,16 @@ package tests
 
 import (
 	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/repository"
 	pgrepo "avito-autumn2025-internship/internal/repository/postgres"
+	"avito-autumn2025-internship/internal/service"
 	"context"
+	"errors"
+	"fmt"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/stretchr/testify/require"
 	"os"
+	"sync"
 	"testing"
 	"time"
 )
@@ -94,6 +99,40 @@ func TestPostgresUserRepository_UpsertAndList(t *testing.T) {
 	require.Equal(t, "u1", active[0].UserId)
 }
 
+func TestPostgresUserRepository_UpsertKeepsOtherTeamMember(t *testing.T) {
+	pool := connectTestDB(t)
+	truncateAll(t, pool)
+
+	ctx := context.Background()
+	userRepo := pgrepo.NewUserRepository(pool)
+
+	for _, team := range []string{"backend", "platform"} {
+		_, err := pool.Exec(ctx, "INSERT INTO teams (team_name) VALUES ($1)", team)
+		require.NoError(t, err)
+	}
+	_, err := userRepo.UpsertTeamMembers(ctx, "backend", []api.TeamMember{
+		{UserId: "u1", Username: "alice", IsActive: true},
+	})
+	require.NoError(t, err)
+
+	users, err := userRepo.UpsertTeamMembers(ctx, "platform", []api.TeamMember{
+		{UserId: "u1", Username: "mallory", IsActive: false},
+	})
+	require.NoError(t, err)
+	require.Equal(t, api.User{UserId: "u1", Username: "alice", TeamName: "backend", IsActive: true}, users[0])
+
+	teams, err := userRepo.ListTeams(ctx, "u1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"backend", "platform"}, teams)
+
+	users, err = userRepo.UpsertTeamMembers(ctx, "backend", []api.TeamMember{
+		{UserId: "u1", Username: "alice2", IsActive: false},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "alice2", users[0].Username, "основная команда обновляет пользователя")
+	require.False(t, users[0].IsActive)
+}
+
 func TestPostgresPRRepository_CreateReplaceAndListShort(t *testing.T) {
 	pool := connectTestDB(t)
 	truncateAll(t, pool)
@@ -177,4 +216,722 @@ func TestPostgresPRRepository_CreateReplaceAndListShort(t *testing.T) {
 		},
 		statuses,
 	)
+
+	open, err := prRepo.ListOpenByReviewer(ctx, "u_dev1")
+	require.NoError(t, err)
+	require.Len(t, open, 1)
+	require.Equal(t, "pr-open", open[0].PullRequestId)
+	require.Equal(t, []string{"u_dev1"}, open[0].AssignedReviewers)
+
+	authored, err := prRepo.ListOpenByAuthor(ctx, "u_author")
+	require.NoError(t, err)
+	require.Len(t, authored, 2)
+
+	total, recent, err := prRepo.CountAssignments(ctx, "u_dev1", now.Add(time.Second))
+	require.NoError(t, err)
+	require.Equal(t, int64(2), total)
+	require.Zero(t, recent)
+
+	edges, err := prRepo.GetReviewGraph(ctx, repository.ReviewGraphFilter{AuthorTeams: []string{"backend"}})
+	require.NoError(t, err)
+	require.Equal(t, []repository.ReviewEdge{
+		{AuthorID: "u_author", ReviewerID: "u_dev1", Count: 2},
+		{AuthorID: "u_author", ReviewerID: "u_new", Count: 1},
+	}, edges)
+}
+
+func TestPostgresPRRepository_ListOpenShortByReviewerInTeam(t *testing.T) {
+	pool := connectTestDB(t)
+	truncateAll(t, pool)
+
+	ctx := context.Background()
+
+	userRepo := pgrepo.NewUserRepository(pool)
+	prRepo := pgrepo.NewPRRepository(pool)
+	repoRepo := pgrepo.NewRepoRepository(pool)
+
+	for _, team := range []string{"backend", "platform"} {
+		_, err := pool.Exec(ctx, "INSERT INTO teams (team_name) VALUES ($1)", team)
+		require.NoError(t, err)
+	}
+	_, err := userRepo.UpsertTeamMembers(ctx, "backend", []api.TeamMember{
+		{UserId: "u_back", Username: "back", IsActive: true},
+		{UserId: "u_rev", Username: "rev", IsActive: true},
+	})
+	require.NoError(t, err)
+	_, err = userRepo.UpsertTeamMembers(ctx, "platform", []api.TeamMember{
+		{UserId: "u_plat", Username: "plat", IsActive: true},
+		{UserId: "u_rev", Username: "rev", IsActive: true},
+	})
+	require.NoError(t, err)
+	_, err = repoRepo.Upsert(ctx, api.Repository{
+		RepositoryId:   "platform/infra",
+		TeamName:       "platform",
+		ReviewerSource: api.AuthorTeam,
+	})
+	require.NoError(t, err)
+
+	infra := "platform/infra"
+	for _, pr := range []api.PullRequest{
+		{PullRequestId: "pr-back", AuthorId: "u_back"},
+		{PullRequestId: "pr-plat", AuthorId: "u_plat"},
+		{PullRequestId: "pr-infra", AuthorId: "u_back", Repository: &infra},
+	} {
+		pr.PullRequestName = "team"
+		pr.Status = api.PullRequestStatusOPEN
+		pr.AssignedReviewers = []string{"u_rev"}
+		require.NoError(t, prRepo.Create(ctx, &pr))
+	}
+
+	ids := func(team string) []string {
+		prs, err := prRepo.ListOpenShortByReviewerInTeam(ctx, "u_rev", team)
+		require.NoError(t, err)
+		res := make([]string, 0, len(prs))
+		for _, pr := range prs {
+			res = append(res, pr.PullRequestId)
+		}
+		return res
+	}
+	require.ElementsMatch(t, []string{"pr-back", "pr-infra"}, ids("backend"), "по основной команде автора")
+	require.ElementsMatch(t, []string{"pr-plat", "pr-infra"}, ids("platform"), "и по владельцу репозитория")
+}
+
+func TestPostgresUserRepository_ListWithCursor(t *testing.T) {
+	pool := connectTestDB(t)
+	truncateAll(t, pool)
+
+	ctx := context.Background()
+
+	userRepo := pgrepo.NewUserRepository(pool)
+
+	_, err := pool.Exec(ctx, "INSERT INTO teams (team_name) VALUES ('backend'), ('platform')")
+	require.NoError(t, err)
+
+	_, err = userRepo.UpsertTeamMembers(ctx, "backend", []api.TeamMember{
+		{UserId: "u1", Username: "anna", IsActive: true},
+		{UserId: "u2", Username: "boris", IsActive: true},
+		{UserId: "u3", Username: "anton_x", IsActive: false},
+	})
+	require.NoError(t, err)
+	_, err = userRepo.UpsertTeamMembers(ctx, "platform", []api.TeamMember{
+		{UserId: "u2", Username: "boris", IsActive: true},
+	})
+	require.NoError(t, err)
+
+	page, err := userRepo.List(ctx, repository.UserFilter{
+		SortBy: repository.UserSortByUsername,
+		Limit:  2,
+	})
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	require.Equal(t, "anna", page[0].Username)
+	require.Equal(t, "anton_x", page[1].Username)
+
+	page, err = userRepo.List(ctx, repository.UserFilter{
+		SortBy: repository.UserSortByUsername,
+		After:  &repository.UserCursor{SortKey: page[1].Username, UserID: page[1].UserId},
+		Limit:  2,
+	})
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	require.Equal(t, "u2", page[0].UserId)
+	require.Equal(t, "backend", page[0].TeamName)
+	require.Equal(t, []string{"backend", "platform"}, *page[0].Teams)
+
+	team := "backend"
+	page, err = userRepo.List(ctx, repository.UserFilter{
+		TeamName: &team,
+		Query:    "_",
+		SortBy:   repository.UserSortByID,
+		Limit:    10,
+	})
+	require.NoError(t, err)
+	require.Len(t, page, 1, "подчёркивание в запросе ищется буквально")
+	require.Equal(t, "u3", page[0].UserId)
+
+	rows, err := userRepo.Stream(ctx, repository.UserFilter{SortBy: repository.UserSortByUsername, Desc: true})
+	require.NoError(t, err)
+	var streamed []string
+	for rows.Next() {
+		streamed = append(streamed, rows.Value().Username)
+	}
+	require.NoError(t, rows.Err())
+	rows.Close()
+	require.Equal(t, []string{"boris", "anton_x", "anna"}, streamed, "без Limit выгружается вся выборка")
+}
+
+func TestPostgresTeamRepository_ListWithCounts(t *testing.T) {
+	pool := connectTestDB(t)
+	truncateAll(t, pool)
+
+	ctx := context.Background()
+
+	teamRepo := pgrepo.NewTeamRepository(pool)
+	userRepo := pgrepo.NewUserRepository(pool)
+	prRepo := pgrepo.NewPRRepository(pool)
+
+	require.NoError(t, teamRepo.Create(ctx, "backend"))
+	require.NoError(t, teamRepo.Create(ctx, "platform"))
+
+	_, err := userRepo.UpsertTeamMembers(ctx, "backend", []api.TeamMember{
+		{UserId: "u1", Username: "dev1", IsActive: true},
+		{UserId: "u2", Username: "dev2", IsActive: false},
+	})
+	require.NoError(t, err)
+	_, err = userRepo.UpsertTeamMembers(ctx, "platform", []api.TeamMember{
+		{UserId: "u1", Username: "dev1", IsActive: true},
+	})
+	require.NoError(t, err)
+
+	now := time.Now().UTC()
+	require.NoError(t, prRepo.Create(ctx, &api.PullRequest{
+		PullRequestId:   "pr-1",
+		PullRequestName: "Open PR",
+		AuthorId:        "u1",
+		Status:          api.PullRequestStatusOPEN,
+		CreatedAt:       &now,
+	}))
+
+	teams, err := teamRepo.List(ctx, repository.TeamFilter{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, teams, 2)
+
+	require.Equal(t, "backend", teams[0].TeamName)
+	require.Equal(t, 2, teams[0].MemberCount)
+	require.Equal(t, 1, teams[0].ActiveMemberCount)
+	require.Equal(t, 1, teams[0].OpenPrCount)
+	require.False(t, teams[0].CreatedAt.IsZero())
+
+	require.Equal(t, "platform", teams[1].TeamName)
+	require.Equal(t, 1, teams[1].MemberCount)
+	require.Equal(t, 0, teams[1].OpenPrCount, "PR относится к основной команде автора")
+
+	teams, err = teamRepo.List(ctx, repository.TeamFilter{Prefix: "pl", After: "backend", Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, teams, 1)
+	require.Equal(t, "platform", teams[0].TeamName)
+}
+
+func TestPostgresUserRepository_UpsertUserAndCount(t *testing.T) {
+	pool := connectTestDB(t)
+	truncateAll(t, pool)
+
+	ctx := context.Background()
+
+	userRepo := pgrepo.NewUserRepository(pool)
+
+	u, err := userRepo.UpsertUser(ctx, "u1", "Alice", true)
+	require.NoError(t, err)
+	require.Equal(t, "", u.TeamName, "пользователь создаётся без команды")
+
+	_, err = userRepo.UpsertUser(ctx, "u2", "bob", true)
+	require.NoError(t, err)
+	_, err = userRepo.UpsertUser(ctx, "u3", "carol", false)
+	require.NoError(t, err)
+
+	name := "alice"
+	cnt, err := userRepo.Count(ctx, repository.UserFilter{Username: &name})
+	require.NoError(t, err)
+	require.Equal(t, 1, cnt)
+
+	active := true
+	cnt, err = userRepo.Count(ctx, repository.UserFilter{IsActive: &active, Limit: 1, Offset: 1})
+	require.NoError(t, err)
+	require.Equal(t, 2, cnt, "Count не учитывает страницу")
+
+	page, err := userRepo.List(ctx, repository.UserFilter{
+		SortBy: repository.UserSortByID,
+		Offset: 1,
+		Limit:  1,
+	})
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	require.Equal(t, "u2", page[0].UserId)
+}
+
+func TestPostgresTeamSettingsRepository_VersionsAndTeamNode(t *testing.T) {
+	pool := connectTestDB(t)
+	truncateAll(t, pool)
+
+	ctx := context.Background()
+
+	teamRepo := pgrepo.NewTeamRepository(pool)
+	settingsRepo := pgrepo.NewTeamSettingsRepository(pool)
+	require.NoError(t, teamRepo.Create(ctx, "backend"))
+
+	v, err := settingsRepo.Get(ctx, "backend")
+	require.NoError(t, err)
+	require.Nil(t, v)
+
+	two := 2
+	v, err = settingsRepo.Save(ctx, "backend", api.TeamPolicy{ReviewerCount: &two}, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, v.Version)
+
+	strategy := api.LeastLoaded
+	expected := 1
+	comment := "switch strategy"
+	v, err = settingsRepo.Save(ctx, "backend", api.TeamPolicy{AssignmentStrategy: &strategy}, &expected, &comment)
+	require.NoError(t, err)
+	require.Equal(t, 2, v.Version)
+	require.Equal(t, comment, *v.Comment)
+
+	v, err = settingsRepo.Save(ctx, "backend", api.TeamPolicy{}, &expected, nil)
+	require.NoError(t, err)
+	require.Nil(t, v, "устаревшая expected_version не сохраняется")
+
+	versions, err := settingsRepo.ListVersions(ctx, "backend")
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	require.Equal(t, 2, versions[0].Version)
+
+	first, err := settingsRepo.GetVersion(ctx, "backend", 1)
+	require.NoError(t, err)
+	require.Equal(t, 2, *first.Settings.ReviewerCount)
+
+	node, err := teamRepo.Get(ctx, "backend")
+	require.NoError(t, err)
+	require.Nil(t, node.Policy.ReviewerCount, "узел команды видит последнюю версию")
+	require.Equal(t, api.LeastLoaded, *node.Policy.AssignmentStrategy)
+}
+
+func TestPostgresActivationScheduleRepository_ClaimAndApply(t *testing.T) {
+	pool := connectTestDB(t)
+	truncateAll(t, pool)
+
+	ctx := context.Background()
+
+	userRepo := pgrepo.NewUserRepository(pool)
+	scheduleRepo := pgrepo.NewActivationScheduleRepository(pool)
+	txManager := pgrepo.NewTxManager(pool)
+
+	_, err := userRepo.UpsertUser(ctx, "u1", "alice", true)
+	require.NoError(t, err)
+
+	now := time.Now()
+	first, err := scheduleRepo.Create(ctx, "u1", false, now.Add(-time.Minute), nil)
+	require.NoError(t, err)
+	later, err := scheduleRepo.Create(ctx, "u1", true, now.Add(time.Hour), nil)
+	require.NoError(t, err)
+
+	err = txManager.WithinTx(ctx, func(ctx context.Context) error {
+		due, err := scheduleRepo.ClaimDue(ctx, now)
+		require.NoError(t, err)
+		require.Equal(t, first.Id, due.Id)
+		return scheduleRepo.MarkApplied(ctx, due.Id, now)
+	})
+	require.NoError(t, err)
+
+	due, err := scheduleRepo.ClaimDue(ctx, now)
+	require.NoError(t, err)
+	require.Nil(t, due)
+
+	cancelled, err := scheduleRepo.Cancel(ctx, first.Id)
+	require.NoError(t, err)
+	require.Nil(t, cancelled, "применённое изменение не отменяется")
+
+	userID := "u1"
+	pending, err := scheduleRepo.List(ctx, repository.ActivationScheduleFilter{
+		UserID: &userID,
+		Status: api.ScheduledActivationStatusPENDING,
+	})
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.Equal(t, later.Id, pending[0].Id)
+}
+
+func TestPostgresPRRepository_ReviewerStatsWindowAndGrouping(t *testing.T) {
+	pool := connectTestDB(t)
+	truncateAll(t, pool)
+
+	ctx := context.Background()
+
+	userRepo := pgrepo.NewUserRepository(pool)
+	prRepo := pgrepo.NewPRRepository(pool)
+
+	for _, team := range []string{"backend", "platform"} {
+		_, err := pool.Exec(ctx, "INSERT INTO teams (team_name) VALUES ($1)", team)
+		require.NoError(t, err)
+	}
+	_, err := userRepo.UpsertTeamMembers(ctx, "backend", []api.TeamMember{
+		{UserId: "u_author", Username: "author", IsActive: true},
+		{UserId: "u_rev", Username: "rev", IsActive: true},
+	})
+	require.NoError(t, err)
+	_, err = userRepo.UpsertTeamMembers(ctx, "platform", []api.TeamMember{
+		{UserId: "u_rev", Username: "rev", IsActive: true},
+	})
+	require.NoError(t, err)
+
+	monday := time.Date(2025, 10, 20, 10, 0, 0, 0, time.UTC)
+	for i, created := range []time.Time{monday, monday.Add(24 * time.Hour), monday.AddDate(0, 0, 7)} {
+		createdAt := created
+		require.NoError(t, prRepo.Create(ctx, &api.PullRequest{
+			PullRequestId:     fmt.Sprintf("pr-%d", i),
+			PullRequestName:   "stats",
+			AuthorId:          "u_author",
+			Status:            api.PullRequestStatusOPEN,
+			AssignedReviewers: []string{"u_rev"},
+			CreatedAt:         &createdAt,
+		}))
+	}
+
+	to := monday.AddDate(0, 0, 7)
+	stats, err := prRepo.GetReviewerAssignmentsStats(ctx, repository.StatsFilter{To: &to})
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+	require.EqualValues(t, 2, stats[0].Count)
+
+	stats, err = prRepo.GetReviewerAssignmentsStats(ctx, repository.StatsFilter{GroupBy: repository.StatsGroupByWeek})
+	require.NoError(t, err)
+	require.Len(t, stats, 2)
+	require.Equal(t, time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC), stats[0].WeekStart.UTC())
+	require.EqualValues(t, 2, stats[0].Count)
+
+	stats, err = prRepo.GetReviewerAssignmentsStats(ctx, repository.StatsFilter{
+		GroupBy: repository.StatsGroupByTeam,
+		Teams:   []string{"platform"},
+	})
+	require.NoError(t, err)
+	require.Len(t, stats, 1, "с фильтром остаются только команды из него")
+	require.Equal(t, "platform", *stats[0].TeamName)
+	require.EqualValues(t, 3, stats[0].Count)
+
+	stats, err = prRepo.GetReviewerAssignmentsStats(ctx, repository.StatsFilter{
+		GroupBy: repository.StatsGroupByAssignmentTeam,
+	})
+	require.NoError(t, err)
+	require.Len(t, stats, 1, "назначение учитывается в одной команде")
+	require.Equal(t, "backend", *stats[0].TeamName)
+	require.EqualValues(t, 3, stats[0].Count)
+
+	stats, err = prRepo.GetReviewerAssignmentsStats(ctx, repository.StatsFilter{
+		GroupBy: repository.StatsGroupByAssignmentTeam,
+		Teams:   []string{"platform"},
+	})
+	require.NoError(t, err)
+	require.Empty(t, stats, "PR автора из backend не засчитываются platform")
+
+	merged := api.PullRequestStatusMERGED
+	stats, err = prRepo.GetReviewerAssignmentsStats(ctx, repository.StatsFilter{Status: &merged})
+	require.NoError(t, err)
+	require.Empty(t, stats)
+}
+
+func TestPostgresPRRepository_TurnaroundPercentiles(t *testing.T) {
+	pool := connectTestDB(t)
+	truncateAll(t, pool)
+
+	ctx := context.Background()
+
+	userRepo := pgrepo.NewUserRepository(pool)
+	prRepo := pgrepo.NewPRRepository(pool)
+
+	_, err := pool.Exec(ctx, "INSERT INTO teams (team_name) VALUES ('backend')")
+	require.NoError(t, err)
+	_, err = userRepo.UpsertTeamMembers(ctx, "backend", []api.TeamMember{
+		{UserId: "u_author", Username: "author", IsActive: true},
+		{UserId: "u_rev", Username: "rev", IsActive: true},
+	})
+	require.NoError(t, err)
+
+	created := time.Date(2025, 10, 20, 10, 0, 0, 0, time.UTC)
+	for i, hours := range []int{1, 2, 3, 4, 10} {
+		createdAt := created
+		id := fmt.Sprintf("pr-%d", i)
+		require.NoError(t, prRepo.Create(ctx, &api.PullRequest{
+			PullRequestId:     id,
+			PullRequestName:   "turnaround",
+			AuthorId:          "u_author",
+			Status:            api.PullRequestStatusOPEN,
+			AssignedReviewers: []string{"u_rev"},
+			CreatedAt:         &createdAt,
+		}))
+		_, err := prRepo.SetMerged(ctx, id, created.Add(time.Duration(hours)*time.Hour))
+		require.NoError(t, err)
+	}
+
+	stats, err := prRepo.GetTurnaroundStats(ctx, repository.TurnaroundFilter{GroupBy: repository.StatsGroupByTeam})
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+	require.Equal(t, "backend", stats[0].Key)
+	require.EqualValues(t, 5, stats[0].MergedCount)
+	require.InDelta(t, 3*3600, stats[0].MedianSeconds, 0.001)
+	require.InDelta(t, 7.6*3600, stats[0].P90Seconds, 0.001)
+	require.InDelta(t, 4*3600, stats[0].MeanSeconds, 0.001)
+
+	to := created.Add(150 * time.Minute)
+	stats, err = prRepo.GetTurnaroundStats(ctx, repository.TurnaroundFilter{
+		GroupBy: repository.StatsGroupByReviewer,
+		To:      &to,
+	})
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+	require.Equal(t, "u_rev", stats[0].Key)
+	require.EqualValues(t, 2, stats[0].MergedCount)
+}
+
+func TestPostgresAPIKeyRepository_CreateRevokeAndList(t *testing.T) {
+	pool := connectTestDB(t)
+	truncateAll(t, pool)
+
+	ctx := context.Background()
+	_, err := pool.Exec(ctx, `TRUNCATE TABLE api_keys RESTART IDENTITY`)
+	require.NoError(t, err)
+
+	keyRepo := pgrepo.NewAPIKeyRepository(pool)
+
+	ci, err := keyRepo.Create(ctx, "ci", "prk_ci000000", "hash-ci", []api.ApiKeyScope{api.ApiKeyScopeWrite}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []api.ApiKeyScope{api.ApiKeyScopeWrite}, ci.Scopes)
+	_, err = keyRepo.Create(ctx, "bot", "prk_bot00000", "hash-bot", []api.ApiKeyScope{api.ApiKeyScopeRead}, nil)
+	require.NoError(t, err)
+
+	found, err := keyRepo.GetByHash(ctx, "hash-ci")
+	require.NoError(t, err)
+	require.Equal(t, ci.Id, found.Id)
+
+	now := time.Now()
+	require.NoError(t, keyRepo.TouchLastUsed(ctx, ci.Id, now))
+	revoked, err := keyRepo.Revoke(ctx, ci.Id, now)
+	require.NoError(t, err)
+	require.NotNil(t, revoked.RevokedAt)
+	require.NotNil(t, revoked.LastUsedAt)
+
+	again, err := keyRepo.Revoke(ctx, ci.Id, now.Add(time.Hour))
+	require.NoError(t, err)
+	require.True(t, again.RevokedAt.Equal(*revoked.RevokedAt), "повторный отзыв не меняет время")
+
+	active, err := keyRepo.List(ctx, false)
+	require.NoError(t, err)
+	require.Len(t, active, 1)
+	require.Equal(t, "bot", active[0].Name)
+
+	all, err := keyRepo.List(ctx, true)
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+
+	missing, err := keyRepo.Revoke(ctx, 999, now)
+	require.NoError(t, err)
+	require.Nil(t, missing)
+}
+
+func TestPostgresTeamLeadRepository_AddRemoveList(t *testing.T) {
+	pool := connectTestDB(t)
+	truncateAll(t, pool)
+
+	ctx := context.Background()
+
+	teamRepo := pgrepo.NewTeamRepository(pool)
+	userRepo := pgrepo.NewUserRepository(pool)
+	leadRepo := pgrepo.NewTeamLeadRepository(pool)
+
+	require.NoError(t, teamRepo.Create(ctx, "backend"))
+	require.NoError(t, teamRepo.Create(ctx, "platform"))
+	_, err := userRepo.UpsertUser(ctx, "u1", "alice", true)
+	require.NoError(t, err)
+
+	first, err := leadRepo.Add(ctx, "backend", "u1")
+	require.NoError(t, err)
+	again, err := leadRepo.Add(ctx, "backend", "u1")
+	require.NoError(t, err)
+	require.True(t, first.GrantedAt.Equal(again.GrantedAt), "повторное назначение не меняет granted_at")
+	_, err = leadRepo.Add(ctx, "platform", "u1")
+	require.NoError(t, err)
+
+	require.NoError(t, teamRepo.Rename(ctx, "platform", "infra"))
+	teams, err := leadRepo.ListTeamsByUser(ctx, "u1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"backend", "infra"}, teams)
+
+	removed, err := leadRepo.Remove(ctx, "backend", "u1")
+	require.NoError(t, err)
+	require.NotNil(t, removed)
+	removed, err = leadRepo.Remove(ctx, "backend", "u1")
+	require.NoError(t, err)
+	require.Nil(t, removed)
+
+	leads, err := leadRepo.List(ctx, "infra")
+	require.NoError(t, err)
+	require.Len(t, leads, 1)
+}
+
+func TestPostgresAuditRepository_InsertAndFilter(t *testing.T) {
+	pool := connectTestDB(t)
+
+	ctx := context.Background()
+	_, err := pool.Exec(ctx, `TRUNCATE TABLE audit_log RESTART IDENTITY`)
+	require.NoError(t, err)
+
+	auditRepo := pgrepo.NewAuditRepository(pool)
+
+	base := time.Now().UTC().Truncate(time.Second)
+	kind := api.PrincipalKindJwt
+	subject, userID := "sso|42", "u42"
+	require.NoError(t, auditRepo.Insert(ctx, api.AuditRecord{
+		OccurredAt:  base,
+		OperationId: "PostTeamMassDeactivate",
+		Method:      "POST",
+		Path:        "/team/massDeactivate",
+		Status:      200,
+		Result:      api.Success,
+		ActorKind:   &kind,
+		Actor:       &subject,
+		ActorUserId: &userID,
+		ClientIp:    "10.0.0.1",
+		Payload:     map[string]interface{}{"body": map[string]interface{}{"team_name": "backend"}},
+	}))
+	require.NoError(t, auditRepo.Insert(ctx, api.AuditRecord{
+		OccurredAt:  base.Add(time.Minute),
+		OperationId: "PostTeamAdd",
+		Method:      "POST",
+		Path:        "/team/add",
+		Status:      401,
+		Result:      api.Denied,
+		ClientIp:    "10.0.0.2",
+	}))
+
+	records, err := auditRepo.List(ctx, repository.AuditFilter{Actor: &userID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, api.PrincipalKindJwt, *records[0].ActorKind)
+	require.Equal(t, "backend", records[0].Payload["body"].(map[string]interface{})["team_name"])
+	require.True(t, records[0].OccurredAt.Equal(base))
+
+	all, err := auditRepo.List(ctx, repository.AuditFilter{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	require.Equal(t, "PostTeamAdd", all[0].OperationId)
+	require.Nil(t, all[0].Actor)
+
+	denied := api.Denied
+	to := base.Add(time.Minute)
+	records, err = auditRepo.List(ctx, repository.AuditFilter{Result: &denied, To: &to, Limit: 10})
+	require.NoError(t, err)
+	require.Empty(t, records)
+
+	records, err = auditRepo.List(ctx, repository.AuditFilter{BeforeID: &all[0].Id, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, "PostTeamMassDeactivate", records[0].OperationId)
+}
+
+func TestPostgresUserRepository_ExternalAccounts(t *testing.T) {
+	pool := connectTestDB(t)
+	truncateAll(t, pool)
+
+	ctx := context.Background()
+	userRepo := pgrepo.NewUserRepository(pool)
+
+	for _, u := range []struct{ id, name string }{{"u1", "alice"}, {"u2", "alice"}, {"u3", "carol"}} {
+		_, err := userRepo.UpsertUser(ctx, u.id, u.name, true)
+		require.NoError(t, err)
+	}
+
+	linked, err := userRepo.LinkExternalAccount(ctx, api.ExternalAccount{UserId: "u3", Provider: api.Gitlab, Login: "c.smith"})
+	require.NoError(t, err)
+	require.True(t, linked)
+	linked, err = userRepo.LinkExternalAccount(ctx, api.ExternalAccount{UserId: "u1", Provider: api.Gitlab, Login: "c.smith"})
+	require.NoError(t, err)
+	require.False(t, linked, "логин другого пользователя не перехватывается")
+	linked, err = userRepo.LinkExternalAccount(ctx, api.ExternalAccount{UserId: "u3", Provider: api.Gitlab, Login: "c.smith"})
+	require.NoError(t, err)
+	require.True(t, linked)
+
+	user, err := userRepo.GetByExternalLogin(ctx, api.Gitlab, "c.smith")
+	require.NoError(t, err)
+	require.Equal(t, "u3", user.UserId)
+
+	user, err = userRepo.GetByExternalLogin(ctx, api.Gitlab, "alice")
+	require.NoError(t, err)
+	require.Nil(t, user, "username alice неоднозначен")
+
+	user, err = userRepo.GetByExternalLogin(ctx, api.Gitlab, "carol")
+	require.NoError(t, err)
+	require.Nil(t, user, "у carol есть привязка, имя не сопоставляется")
+
+	linked, err = userRepo.LinkExternalAccount(ctx, api.ExternalAccount{UserId: "u1", Provider: api.Gitlab, Login: "alice"})
+	require.NoError(t, err)
+	require.True(t, linked)
+	user, err = userRepo.GetByExternalLogin(ctx, api.Gitlab, "alice")
+	require.NoError(t, err)
+	require.Equal(t, "u1", user.UserId)
+}
+
+func TestPostgresUserRepository_LinkExternalAccountConcurrently(t *testing.T) {
+	pool := connectTestDB(t)
+	truncateAll(t, pool)
+
+	ctx := context.Background()
+	userRepo := pgrepo.NewUserRepository(pool)
+
+	const users = 8
+	for i := 0; i < users; i++ {
+		_, err := userRepo.UpsertUser(ctx, fmt.Sprintf("u%d", i), fmt.Sprintf("user%d", i), true)
+		require.NoError(t, err)
+	}
+
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		linked int
+		errs   []error
+	)
+	for i := 0; i < users; i++ {
+		wg.Add(1)
+		go func(userID string) {
+			defer wg.Done()
+			ok, err := userRepo.LinkExternalAccount(ctx, api.ExternalAccount{UserId: userID, Provider: api.Gitlab, Login: "shared"})
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, err)
+			}
+			if ok {
+				linked++
+			}
+		}(fmt.Sprintf("u%d", i))
+	}
+	wg.Wait()
+
+	require.Empty(t, errs, "гонка за логин — это отказ, а не ошибка")
+	require.Equal(t, 1, linked)
+}
+
+// failingMembersRepo ломает добавление участников, чтобы проверить откат AddTeam.
+type failingMembersRepo struct {
+	repository.UserRepository
+}
+
+func (failingMembersRepo) UpsertTeamMembers(context.Context, string, []api.TeamMember) ([]api.User, error) {
+	return nil, errors.New("members failed")
+}
+
+func TestPostgresTeamService_AddTeamIsAtomic(t *testing.T) {
+	pool := connectTestDB(t)
+	truncateAll(t, pool)
+
+	ctx := context.Background()
+	teamRepo := pgrepo.NewTeamRepository(pool)
+	userRepo := pgrepo.NewUserRepository(pool)
+	newSvc := func(userRepo repository.UserRepository) service.TeamService {
+		return service.NewTeamService(
+			teamRepo, userRepo, pgrepo.NewPRRepository(pool), pgrepo.NewRepoRepository(pool),
+			pgrepo.NewTeamSettingsRepository(pool), pgrepo.NewTxManager(pool),
+		)
+	}
+	body := api.PostTeamAddJSONRequestBody{
+		TeamName: "backend",
+		Members:  []api.TeamMember{{UserId: "u1", Username: "alice", IsActive: true}},
+	}
+
+	_, err := newSvc(failingMembersRepo{userRepo}).AddTeam(ctx, body, service.MembershipRefuse)
+	require.Error(t, err)
+	exists, err := teamRepo.Exists(ctx, "backend")
+	require.NoError(t, err)
+	require.False(t, exists, "команда без участников не остаётся")
+
+	team, err := newSvc(userRepo).AddTeam(ctx, body, service.MembershipRefuse)
+	require.NoError(t, err, "повтор не получает TEAM_EXISTS")
+	require.Len(t, team.Members, 1)
 }
@@ -47,13 +47,13 @@ func TestHTTP_CreatePullRequest_HappyPath(t *testing.T) {
 		IsActive: true,
 	})
 
-	prSvc := service.NewPRService(prRepo, userRepo)
+	prSvc := service.NewPRService(prRepo, userRepo, newFakeRepoRepo(), newFakeTeamRepo())
 	teamSvc := newTeamServiceStub()
-	userSvc := service.NewUserService(userRepo, prRepo)
+	userSvc := service.NewUserService(userRepo, prRepo, newFakeActivationScheduleRepo(), fakeTxManager{})
 
 	const adminToken = ""
 
-	handler := nethttp.NewRouter(prSvc, teamSvc, userSvc, adminToken)
+	handler := nethttp.NewRouter(prSvc, teamSvc, userSvc, newRepositoryServiceStub(), adminToken)
 	ts := httptest.NewServer(handler)
 	defer ts.Close()
 
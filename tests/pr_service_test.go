@@ -33,7 +33,7 @@ func TestPRService_CreatePR_Success_AssignsTwoReviewers(t *testing.T) {
 		IsActive: true,
 	})
 
-	prSvc := service.NewPRService(prRepo, userRepo)
+	prSvc := service.NewPRService(prRepo, userRepo, newFakeRepoRepo(), newFakeTeamRepo())
 
 	body := api.PostPullRequestCreateJSONRequestBody{
 		PullRequestId:   "pr-1",
@@ -70,7 +70,7 @@ func TestPRService_CreatePR_PRAlreadyExists(t *testing.T) {
 		PullRequestId: "pr-1",
 	})
 
-	prSvc := service.NewPRService(prRepo, userRepo)
+	prSvc := service.NewPRService(prRepo, userRepo, newFakeRepoRepo(), newFakeTeamRepo())
 
 	body := api.PostPullRequestCreateJSONRequestBody{
 		PullRequestId:   "pr-1",
@@ -117,7 +117,7 @@ func TestPRService_ReassignReviewer_Success(t *testing.T) {
 		AssignedReviewers: []string{"u_old"},
 	})
 
-	prSvc := service.NewPRService(prRepo, userRepo)
+	prSvc := service.NewPRService(prRepo, userRepo, newFakeRepoRepo(), newFakeTeamRepo())
 
 	body := api.PostPullRequestReassignJSONRequestBody{
 		PullRequestId: "pr-1",
@@ -153,7 +153,7 @@ func TestPRService_ReassignReviewer_PRIsMerged(t *testing.T) {
 		AssignedReviewers: []string{"u_old"},
 	})
 
-	prSvc := service.NewPRService(prRepo, userRepo)
+	prSvc := service.NewPRService(prRepo, userRepo, newFakeRepoRepo(), newFakeTeamRepo())
 
 	body := api.PostPullRequestReassignJSONRequestBody{
 		PullRequestId: "pr-1",
@@ -0,0 +1,77 @@
+package tests
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/service"
+	"context"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestPRService_CreatePR_UsesRepositoryPolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	userRepo := newFakeUserRepo()
+	prRepo := newFakePRRepo()
+	repoRepo := newFakeRepoRepo()
+
+	userRepo.AddUser(api.User{UserId: "u_author", Username: "author", TeamName: "frontend", IsActive: true})
+	userRepo.AddUser(api.User{UserId: "u_fe", Username: "fe", TeamName: "frontend", IsActive: true})
+	userRepo.AddUser(api.User{UserId: "u_busy", Username: "busy", TeamName: "backend", IsActive: true})
+	userRepo.AddUser(api.User{UserId: "u_free", Username: "free", TeamName: "backend", IsActive: true})
+
+	prRepo.AddPR(&api.PullRequest{
+		PullRequestId:     "pr-old",
+		AuthorId:          "u_free",
+		Status:            api.PullRequestStatusOPEN,
+		AssignedReviewers: []string{"u_busy"},
+	})
+
+	one := 1
+	strategy := api.LeastLoaded
+	_, err := repoRepo.Upsert(ctx, api.Repository{
+		RepositoryId:       "backend/api",
+		TeamName:           "backend",
+		ReviewerCount:      &one,
+		AssignmentStrategy: &strategy,
+		ReviewerSource:     api.OwnerTeam,
+	})
+	require.NoError(t, err)
+
+	prSvc := service.NewPRService(prRepo, userRepo, repoRepo, newFakeTeamRepo())
+
+	repoID := "backend/api"
+	pr, err := prSvc.CreatePR(ctx, api.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   "pr-1",
+		PullRequestName: "Cross-team change",
+		AuthorId:        "u_author",
+		Repository:      &repoID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"u_free"}, pr.AssignedReviewers)
+	require.NotNil(t, pr.Repository)
+	require.Equal(t, repoID, *pr.Repository)
+}
+
+func TestPRService_CreatePR_UnknownRepository(t *testing.T) {
+	t.Parallel()
+
+	userRepo := newFakeUserRepo()
+	prRepo := newFakePRRepo()
+
+	userRepo.AddUser(api.User{UserId: "u_author", Username: "author", TeamName: "backend", IsActive: true})
+
+	prSvc := service.NewPRService(prRepo, userRepo, newFakeRepoRepo(), newFakeTeamRepo())
+
+	repoID := "missing/repo"
+	pr, err := prSvc.CreatePR(context.Background(), api.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   "pr-1",
+		PullRequestName: "Change",
+		AuthorId:        "u_author",
+		Repository:      &repoID,
+	})
+	require.ErrorIs(t, err, service.ErrNotFound)
+	require.Nil(t, pr)
+}
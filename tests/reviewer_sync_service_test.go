@@ -0,0 +1,81 @@
+package tests
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/service"
+	"context"
+	"github.com/stretchr/testify/require"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// recordingConnector записывает вызовы по завершении; первый вызов ждёт gate.
+type recordingConnector struct {
+	mu      sync.Mutex
+	calls   []string
+	gate    chan struct{}
+	blocked atomic.Bool
+}
+
+func (c *recordingConnector) RequestReviewers(_ context.Context, prID string, userIDs []string) error {
+	c.record("request", prID, userIDs)
+	return nil
+}
+
+func (c *recordingConnector) RemoveReviewers(_ context.Context, prID string, userIDs []string) error {
+	c.record("remove", prID, userIDs)
+	return nil
+}
+
+func (c *recordingConnector) record(op, prID string, userIDs []string) {
+	if c.blocked.CompareAndSwap(false, true) {
+		<-c.gate
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, id := range userIDs {
+		c.calls = append(c.calls, op+" "+prID+" "+id)
+	}
+}
+
+func TestReviewerSyncPRService_SerializesSyncsPerPR(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	userRepo := newFakeUserRepo()
+	for _, id := range []string{"u_author", "u_r1", "u_r2"} {
+		userRepo.AddUser(api.User{UserId: id, Username: id, TeamName: "backend", IsActive: true})
+	}
+	prRepo := newFakePRRepo()
+	inner := service.NewPRService(prRepo, userRepo, newFakeRepoRepo(), newFakeTeamRepo())
+
+	conn := &recordingConnector{gate: make(chan struct{})}
+	var wg sync.WaitGroup
+	svc := service.NewReviewerSyncPRService(inner, conn, &wg)
+
+	pr, err := svc.CreatePR(ctx, api.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   "pr-1",
+		PullRequestName: "Sync",
+		AuthorId:        "u_author",
+	})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"u_r1", "u_r2"}, pr.AssignedReviewers)
+
+	// Третий участник появляется, чтобы было кого назначить взамен.
+	userRepo.AddUser(api.User{UserId: "u_r3", Username: "u_r3", TeamName: "backend", IsActive: true})
+	_, newID, err := svc.ReassignReviewer(ctx, api.PostPullRequestReassignJSONRequestBody{
+		PullRequestId: "pr-1",
+		OldUserId:     "u_r1",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "u_r3", newID)
+
+	// Синхронизация создания ещё ждёт gate: переназначение не должно её обогнать.
+	close(conn.gate)
+	wg.Wait()
+
+	require.Len(t, conn.calls, 4)
+	require.ElementsMatch(t, []string{"request pr-1 u_r1", "request pr-1 u_r2"}, conn.calls[:2])
+	require.Equal(t, []string{"request pr-1 u_r3", "remove pr-1 u_r1"}, conn.calls[2:])
+}
@@ -0,0 +1,196 @@
+package tests
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	nethttp "avito-autumn2025-internship/internal/http"
+	"avito-autumn2025-internship/internal/http/handlers"
+	"avito-autumn2025-internship/internal/service"
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+const scimTestToken = "scim-secret"
+
+func newSCIMServer(t *testing.T) (*httptest.Server, *teamManagementFixture) {
+	t.Helper()
+
+	f := newTeamManagementFixture()
+	prSvc := service.NewPRService(f.prRepo, f.userRepo, f.repoRepo, f.teamRepo)
+	userSvc := service.NewUserService(f.userRepo, f.prRepo, newFakeActivationScheduleRepo(), fakeTxManager{})
+	scimSvc := service.NewSCIMService(f.svc, f.userRepo, f.teamRepo, f.prRepo, fakeTxManager{})
+
+	ts := httptest.NewServer(nethttp.NewRouter(
+		prSvc, f.svc, userSvc, newRepositoryServiceStub(), "",
+		handlers.WithSCIM(scimSvc, scimTestToken),
+	))
+	t.Cleanup(ts.Close)
+	return ts, f
+}
+
+func scimDo(t *testing.T, method, target, token string, body any, out any) int {
+	t.Helper()
+
+	var reader *bytes.Reader
+	if body != nil {
+		raw, err := json.Marshal(body)
+		require.NoError(t, err)
+		reader = bytes.NewReader(raw)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), method, target, reader)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/scim+json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	if out != nil && resp.StatusCode < 300 && resp.StatusCode != http.StatusNoContent {
+		require.Equal(t, "application/scim+json", resp.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(out))
+	}
+	return resp.StatusCode
+}
+
+func patchOp(op, path string, value any) map[string]any {
+	res := map[string]any{"op": op, "value": value}
+	if path != "" {
+		res["path"] = path
+	}
+	return res
+}
+
+func patchBody(ops ...map[string]any) map[string]any {
+	return map[string]any{
+		"schemas":    []string{"urn:ietf:params:scim:api:messages:2.0:PatchOp"},
+		"Operations": ops,
+	}
+}
+
+func TestHTTP_SCIM_RequiresToken(t *testing.T) {
+	t.Parallel()
+
+	ts, _ := newSCIMServer(t)
+	require.Equal(t, http.StatusUnauthorized, scimDo(t, http.MethodGet, ts.URL+"/scim/v2/Users", "", nil, nil))
+	require.Equal(t, http.StatusUnauthorized, scimDo(t, http.MethodGet, ts.URL+"/scim/v2/Users", "wrong", nil, nil))
+
+	f := newTeamManagementFixture()
+	userSvc := service.NewUserService(f.userRepo, f.prRepo, newFakeActivationScheduleRepo(), fakeTxManager{})
+	disabled := httptest.NewServer(nethttp.NewRouter(
+		newPRServiceStub(), newTeamServiceStub(), userSvc, newRepositoryServiceStub(), "",
+	))
+	t.Cleanup(disabled.Close)
+	require.Equal(t, http.StatusNotFound, scimDo(t, http.MethodGet, disabled.URL+"/scim/v2/Users", scimTestToken, nil, nil))
+}
+
+func TestHTTP_SCIM_UsersCreateFilterAndDeactivate(t *testing.T) {
+	t.Parallel()
+
+	ts, f := newSCIMServer(t)
+
+	var created api.ScimUser
+	status := scimDo(t, http.MethodPost, ts.URL+"/scim/v2/Users", scimTestToken, map[string]any{
+		"schemas":    []string{"urn:ietf:params:scim:schemas:core:2.0:User"},
+		"userName":   "newbie",
+		"externalId": "u_new",
+	}, &created)
+	require.Equal(t, http.StatusCreated, status)
+	require.Equal(t, "u_new", *created.Id)
+	require.True(t, *created.Active)
+
+	status = scimDo(t, http.MethodPost, ts.URL+"/scim/v2/Users", scimTestToken, map[string]any{
+		"schemas":  []string{"urn:ietf:params:scim:schemas:core:2.0:User"},
+		"userName": "DEV1",
+	}, nil)
+	require.Equal(t, http.StatusConflict, status, "userName уникален без учёта регистра")
+
+	var list api.ScimUserList
+	query := url.Values{"filter": {`userName eq "dev1" and active eq true`}}
+	status = scimDo(t, http.MethodGet, ts.URL+"/scim/v2/Users?"+query.Encode(), scimTestToken, nil, &list)
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, 1, list.TotalResults)
+	require.Equal(t, "u_dev1", *list.Resources[0].Id)
+	require.Equal(t, []api.ScimMemberRef{{Value: "backend"}}, *list.Resources[0].Groups)
+
+	query = url.Values{"filter": {`displayName co "dev"`}}
+	status = scimDo(t, http.MethodGet, ts.URL+"/scim/v2/Users?"+query.Encode(), scimTestToken, nil, nil)
+	require.Equal(t, http.StatusBadRequest, status)
+
+	var patched api.ScimUser
+	status = scimDo(t, http.MethodPatch, ts.URL+"/scim/v2/Users/u_dev1", scimTestToken,
+		patchBody(patchOp("Replace", "active", "False")), &patched)
+	require.Equal(t, http.StatusOK, status)
+	require.False(t, *patched.Active)
+
+	require.Len(t, f.prRepo.replaceCalls, 1, "открытое ревью переназначено")
+	require.Equal(t, "u_dev1", f.prRepo.replaceCalls[0].OldReviewerID)
+	require.Equal(t, "u_dev2", f.prRepo.replaceCalls[0].NewReviewerID)
+
+	status = scimDo(t, http.MethodDelete, ts.URL+"/scim/v2/Users/u_new", scimTestToken, nil, nil)
+	require.Equal(t, http.StatusNoContent, status)
+	u, err := f.userRepo.GetByID(context.Background(), "u_new")
+	require.NoError(t, err)
+	require.False(t, u.IsActive, "DELETE деактивирует, но сохраняет пользователя")
+}
+
+func TestHTTP_SCIM_GroupsMapToTeams(t *testing.T) {
+	t.Parallel()
+
+	ts, f := newSCIMServer(t)
+	ctx := context.Background()
+
+	var group api.ScimGroup
+	status := scimDo(t, http.MethodPost, ts.URL+"/scim/v2/Groups", scimTestToken, map[string]any{
+		"schemas":     []string{"urn:ietf:params:scim:schemas:core:2.0:Group"},
+		"displayName": "payments",
+		"members":     []map[string]string{{"value": "u_dev1"}, {"value": "u_plat"}},
+	}, &group)
+	require.Equal(t, http.StatusCreated, status)
+	require.Equal(t, "payments", *group.Id)
+	require.Len(t, *group.Members, 2)
+
+	status = scimDo(t, http.MethodPost, ts.URL+"/scim/v2/Groups", scimTestToken, map[string]any{
+		"schemas":     []string{"urn:ietf:params:scim:schemas:core:2.0:Group"},
+		"displayName": "mobile",
+		"members":     []map[string]string{{"value": "u_ghost"}},
+	}, nil)
+	require.Equal(t, http.StatusBadRequest, status, "участники должны существовать")
+
+	status = scimDo(t, http.MethodPatch, ts.URL+"/scim/v2/Groups/payments", scimTestToken, patchBody(
+		patchOp("add", "members", []map[string]string{{"value": "u_dev2"}}),
+		patchOp("remove", `members[value eq "u_plat"]`, nil),
+		patchOp("replace", "", map[string]any{"displayName": "billing"}),
+	), &group)
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, "billing", group.DisplayName)
+
+	ids := make([]string, 0, len(*group.Members))
+	for _, m := range *group.Members {
+		ids = append(ids, m.Value)
+	}
+	require.Equal(t, []string{"u_dev1", "u_dev2"}, ids)
+
+	teams, err := f.userRepo.ListTeams(ctx, "u_plat")
+	require.NoError(t, err)
+	require.Equal(t, []string{"platform"}, teams)
+
+	var list api.ScimGroupList
+	query := url.Values{"filter": {`displayName eq "billing"`}}
+	status = scimDo(t, http.MethodGet, ts.URL+"/scim/v2/Groups?"+query.Encode(), scimTestToken, nil, &list)
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, 1, list.TotalResults)
+
+	status = scimDo(t, http.MethodDelete, ts.URL+"/scim/v2/Groups/backend", scimTestToken, nil, nil)
+	require.Equal(t, http.StatusConflict, status, "у участников backend есть открытые ревью")
+}
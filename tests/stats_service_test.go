@@ -0,0 +1,196 @@
+package tests
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/repository"
+	"avito-autumn2025-internship/internal/service"
+	"context"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestPRService_GetReviewerAssignments_PassesWindowAndGrouping(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	teamRepo := newFakeTeamRepo("payments", "payments-core", "platform")
+	parent := "payments"
+	teamRepo.teams["payments-core"].Parent = &parent
+
+	prRepo := newFakePRRepo()
+	week := time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC)
+	prRepo.stats = []repository.ReviewerAssignmentsStat{
+		{UserID: "u1", WeekStart: &week, Count: 3},
+	}
+	svc := service.NewPRService(prRepo, newFakeUserRepo(), newFakeRepoRepo(), teamRepo)
+
+	from := week
+	to := week.AddDate(0, 0, 14)
+	team := "payments"
+	status := api.MERGED
+	groupBy := api.GetStatsReviewerAssignmentsParamsGroupByWeek
+	stats, err := svc.GetReviewerAssignments(ctx, api.GetStatsReviewerAssignmentsParams{
+		Team:    &team,
+		From:    &from,
+		To:      &to,
+		Status:  &status,
+		GroupBy: &groupBy,
+	})
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+	require.Equal(t, "2025-10-20", stats[0].WeekStart.String())
+
+	require.Len(t, prRepo.statsFilters, 1)
+	filter := prRepo.statsFilters[0]
+	require.ElementsMatch(t, []string{"payments", "payments-core"}, filter.Teams)
+	require.Equal(t, from, *filter.From)
+	require.Equal(t, to, *filter.To)
+	require.Equal(t, api.PullRequestStatusMERGED, *filter.Status)
+	require.Equal(t, repository.StatsGroupByWeek, filter.GroupBy)
+}
+
+func TestPRService_GetReviewerAssignments_RejectsBadWindow(t *testing.T) {
+	t.Parallel()
+
+	svc := service.NewPRService(newFakePRRepo(), newFakeUserRepo(), newFakeRepoRepo(), newFakeTeamRepo())
+
+	now := time.Now()
+	_, err := svc.GetReviewerAssignments(context.Background(), api.GetStatsReviewerAssignmentsParams{
+		From: &now,
+		To:   &now,
+	})
+	require.ErrorIs(t, err, service.ErrInvalidArgument)
+
+	groupBy := api.GetStatsReviewerAssignmentsParamsGroupBy("month")
+	_, err = svc.GetReviewerAssignments(context.Background(), api.GetStatsReviewerAssignmentsParams{
+		GroupBy: &groupBy,
+	})
+	require.ErrorIs(t, err, service.ErrInvalidArgument)
+}
+
+func TestPRService_GetTurnaround_MapsGroupingAndWindow(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	prRepo := newFakePRRepo()
+	prRepo.turnaround = []repository.TurnaroundStat{
+		{Key: "backend", MergedCount: 4, MedianSeconds: 3600, P90Seconds: 7200, MeanSeconds: 4500},
+	}
+	svc := service.NewPRService(prRepo, newFakeUserRepo(), newFakeRepoRepo(), newFakeTeamRepo())
+
+	from := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+	stats, err := svc.GetTurnaround(ctx, api.GetStatsTurnaroundParams{
+		GroupBy: api.GetStatsTurnaroundParamsGroupByTeam,
+		From:    &from,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []api.TurnaroundStat{
+		{Key: "backend", MergedCount: 4, MedianSeconds: 3600, P90Seconds: 7200, MeanSeconds: 4500},
+	}, stats)
+	require.Equal(t, repository.TurnaroundFilter{From: &from, GroupBy: repository.StatsGroupByTeam}, prRepo.turnaroundFilters[0])
+
+	_, err = svc.GetTurnaround(ctx, api.GetStatsTurnaroundParams{GroupBy: "week"})
+	require.ErrorIs(t, err, service.ErrInvalidArgument)
+}
+
+func TestPRService_GetFairness_CountsIdleMembersAndFlagsOutliers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	teamRepo := newFakeTeamRepo("payments", "platform")
+	userRepo := newFakeUserRepo()
+	userRepo.AddUser(api.User{UserId: "u1", Username: "Alice", TeamName: "payments", IsActive: true})
+	userRepo.AddUser(api.User{UserId: "u2", Username: "Bob", TeamName: "payments", IsActive: true})
+	userRepo.AddUser(api.User{UserId: "u3", Username: "Carol", TeamName: "payments", IsActive: true})
+	userRepo.AddUser(api.User{UserId: "u4", Username: "Dave", TeamName: "payments", IsActive: false})
+
+	payments := "payments"
+	prRepo := newFakePRRepo()
+	prRepo.stats = []repository.ReviewerAssignmentsStat{
+		{UserID: "u1", TeamName: &payments, Count: 6},
+		{UserID: "u2", TeamName: &payments, Count: 3},
+	}
+	svc := service.NewPRService(prRepo, userRepo, newFakeRepoRepo(), teamRepo)
+
+	teams, err := svc.GetFairness(ctx, api.GetStatsFairnessParams{Team: &payments})
+	require.NoError(t, err)
+	require.Len(t, teams, 1)
+	require.Equal(t, repository.StatsGroupByAssignmentTeam, prRepo.statsFilters[0].GroupBy)
+	require.Equal(t, []string{"payments"}, prRepo.statsFilters[0].Teams)
+
+	team := teams[0]
+	require.Equal(t, 3, team.MemberCount, "неактивный без назначений не учитывается")
+	require.Equal(t, int64(9), team.TotalAssignments)
+	require.Equal(t, int64(0), team.Min)
+	require.Equal(t, int64(6), team.Max)
+	require.InDelta(t, 3.0, team.Mean, 1e-9)
+	require.InDelta(t, 2.449, team.Stddev, 1e-3)
+	require.InDelta(t, 4.0/9, team.Gini, 1e-9)
+
+	loads := map[string]api.MemberFairnessLoad{}
+	for _, m := range team.Members {
+		loads[m.UserId] = m.Load
+	}
+	require.Equal(t, map[string]api.MemberFairnessLoad{
+		"u1": api.Over,
+		"u2": api.Balanced,
+		"u3": api.Under,
+	}, loads)
+}
+
+func TestPRService_GetFairness_Validation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	svc := service.NewPRService(newFakePRRepo(), newFakeUserRepo(), newFakeRepoRepo(), newFakeTeamRepo("payments"))
+
+	negative := -0.1
+	_, err := svc.GetFairness(ctx, api.GetStatsFairnessParams{Tolerance: &negative})
+	require.ErrorIs(t, err, service.ErrInvalidArgument)
+
+	ghost := "ghost"
+	_, err = svc.GetFairness(ctx, api.GetStatsFairnessParams{Team: &ghost})
+	require.ErrorIs(t, err, service.ErrNotFound)
+
+	teams, err := svc.GetFairness(ctx, api.GetStatsFairnessParams{})
+	require.NoError(t, err)
+	require.Len(t, teams, 1)
+	require.Zero(t, teams[0].MemberCount)
+}
+
+func TestPRService_GetReviewGraph_FlagsSingleReviewerAuthors(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	teamRepo := newFakeTeamRepo("payments", "payments-core")
+	parent := "payments"
+	teamRepo.teams["payments-core"].Parent = &parent
+
+	userRepo := newFakeUserRepo()
+	userRepo.AddUser(api.User{UserId: "u1", Username: "Alice", TeamName: "payments", IsActive: true})
+	userRepo.AddUser(api.User{UserId: "u2", Username: "Bob", TeamName: "payments-core", IsActive: true})
+	userRepo.AddUser(api.User{UserId: "u3", Username: "Carol", TeamName: "payments-core", IsActive: true})
+
+	prRepo := newFakePRRepo()
+	prRepo.reviewGraph = []repository.ReviewEdge{
+		{AuthorID: "u2", ReviewerID: "u3", Count: 5},
+		{AuthorID: "u1", ReviewerID: "u2", Count: 2},
+		{AuthorID: "u1", ReviewerID: "u3", Count: 1},
+	}
+	svc := service.NewPRService(prRepo, userRepo, newFakeRepoRepo(), teamRepo)
+
+	team := "payments"
+	graph, err := svc.GetReviewGraph(ctx, api.GetStatsReviewGraphParams{Team: &team})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"payments", "payments-core"}, prRepo.reviewGraphFilters[0].AuthorTeams)
+	require.Len(t, graph.Edges, 3)
+	require.Equal(t, api.ReviewGraphEdge{AuthorId: "u2", ReviewerId: "u3", Weight: 5}, graph.Edges[0])
+	require.Equal(t, []api.BusFactorHint{
+		{TeamName: "payments-core", AuthorId: "u2", ReviewerId: "u3", PullRequests: 5},
+	}, graph.BusFactor)
+
+	ghost := "ghost"
+	_, err = svc.GetReviewGraph(ctx, api.GetStatsReviewGraphParams{Team: &ghost})
+	require.ErrorIs(t, err, service.ErrNotFound)
+}
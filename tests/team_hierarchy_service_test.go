@@ -0,0 +1,100 @@
+package tests
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/service"
+	"context"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func newPaymentsTree(t *testing.T) (*fakeTeamRepo, service.TeamService) {
+	t.Helper()
+
+	ctx := context.Background()
+	teamRepo := newFakeTeamRepo("payments", "payments-core", "payments-api")
+	svc := service.NewTeamService(teamRepo, newFakeUserRepo(), newFakePRRepo(), newFakeRepoRepo(), newFakeTeamSettingsRepo(teamRepo), fakeTxManager{})
+
+	for _, squad := range []string{"payments-core", "payments-api"} {
+		parent := "payments"
+		_, err := svc.SetParent(ctx, api.PostTeamSetParentJSONRequestBody{TeamName: squad, ParentTeamName: &parent})
+		require.NoError(t, err)
+	}
+
+	three, fallback := 3, true
+	_, err := svc.UpdateSettings(ctx, api.PutTeamSettingsJSONRequestBody{
+		TeamName: "payments",
+		Settings: api.TeamPolicy{ReviewerCount: &three, SiblingFallback: &fallback},
+	})
+	require.NoError(t, err)
+	return teamRepo, svc
+}
+
+func TestPRService_CreatePR_InheritsPolicyAndFallsBackToSiblings(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	teamRepo, _ := newPaymentsTree(t)
+
+	userRepo := newFakeUserRepo()
+	userRepo.AddUser(api.User{UserId: "u_author", Username: "author", TeamName: "payments-core", IsActive: true})
+	userRepo.AddUser(api.User{UserId: "u_core", Username: "core", TeamName: "payments-core", IsActive: true})
+	userRepo.AddUser(api.User{UserId: "u_api1", Username: "api1", TeamName: "payments-api", IsActive: true})
+	userRepo.AddUser(api.User{UserId: "u_api2", Username: "api2", TeamName: "payments-api", IsActive: true})
+	userRepo.AddUser(api.User{UserId: "u_other", Username: "other", TeamName: "platform", IsActive: true})
+
+	prSvc := service.NewPRService(newFakePRRepo(), userRepo, newFakeRepoRepo(), teamRepo)
+
+	pr, err := prSvc.CreatePR(ctx, api.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   "pr-1",
+		PullRequestName: "Refunds",
+		AuthorId:        "u_author",
+	})
+	require.NoError(t, err)
+	require.Len(t, pr.AssignedReviewers, 3)
+	require.Equal(t, "u_core", pr.AssignedReviewers[0], "сначала назначаются участники своей команды")
+	require.ElementsMatch(t, []string{"u_api1", "u_api2"}, pr.AssignedReviewers[1:])
+}
+
+func TestTeamService_SetParent_RejectsCycle(t *testing.T) {
+	t.Parallel()
+
+	_, svc := newPaymentsTree(t)
+
+	parent := "payments-core"
+	_, err := svc.SetParent(context.Background(), api.PostTeamSetParentJSONRequestBody{
+		TeamName:       "payments",
+		ParentTeamName: &parent,
+	})
+	require.ErrorIs(t, err, service.ErrInvalidArgument)
+}
+
+func TestTeamService_GetTree_ShowsEffectivePolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	_, svc := newPaymentsTree(t)
+
+	one := 1
+	_, err := svc.UpdateSettings(ctx, api.PutTeamSettingsJSONRequestBody{
+		TeamName: "payments-api",
+		Settings: api.TeamPolicy{ReviewerCount: &one},
+	})
+	require.NoError(t, err)
+
+	root := "payments"
+	tree, err := svc.GetTree(ctx, api.GetTeamTreeParams{TeamName: &root})
+	require.NoError(t, err)
+	require.Len(t, tree, 1)
+	require.Len(t, tree[0].Children, 2)
+
+	apiSquad, core := tree[0].Children[0], tree[0].Children[1]
+	require.Equal(t, "payments-api", apiSquad.TeamName)
+	require.Equal(t, 1, *apiSquad.EffectivePolicy.ReviewerCount)
+	require.True(t, *apiSquad.EffectivePolicy.SiblingFallback)
+
+	require.Equal(t, "payments-core", core.TeamName)
+	require.Nil(t, core.Policy.ReviewerCount)
+	require.Equal(t, 3, *core.EffectivePolicy.ReviewerCount)
+	require.Equal(t, api.Random, *core.EffectivePolicy.AssignmentStrategy)
+}
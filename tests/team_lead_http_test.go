@@ -0,0 +1,119 @@
+package tests
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/auth"
+	nethttp "avito-autumn2025-internship/internal/http"
+	"avito-autumn2025-internship/internal/http/handlers"
+	"avito-autumn2025-internship/internal/service"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHTTP_TeamLeadAuthorization(t *testing.T) {
+	t.Parallel()
+
+	const adminToken = "secret-admin"
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	verifier := auth.NewVerifier(
+		writeJWKSFile(t, map[string]crypto.PublicKey{"k1": &key.PublicKey}),
+		auth.VerifierConfig{Issuer: testIssuer, Audience: testAudience, UserIDClaim: "user_id"},
+	)
+	tokenFor := func(userID string) string {
+		claims := validClaims()
+		claims["user_id"] = userID
+		return signJWT(t, key, "k1", claims)
+	}
+
+	userRepo := newFakeUserRepo()
+	userRepo.AddUser(api.User{UserId: "lead", Username: "Lead", TeamName: "backend", IsActive: true})
+	userRepo.AddUser(api.User{UserId: "dev", Username: "Dev", TeamName: "backend", IsActive: true})
+	userRepo.AddUser(api.User{UserId: "ops", Username: "Ops", TeamName: "platform", IsActive: true})
+	userRepo.AddUser(api.User{UserId: "dev2", Username: "Dev2", TeamName: "backend", IsActive: true})
+	prRepo := newFakePRRepo()
+	prRepo.AddPR(&api.PullRequest{
+		PullRequestId: "pr-backend", AuthorId: "dev", Status: api.PullRequestStatusOPEN,
+		AssignedReviewers: []string{"lead"},
+	})
+	prRepo.AddPR(&api.PullRequest{
+		PullRequestId: "pr-platform", AuthorId: "ops", Status: api.PullRequestStatusOPEN,
+		AssignedReviewers: []string{"lead"},
+	})
+	prSvc := service.NewPRService(prRepo, userRepo, newFakeRepoRepo(), newFakeTeamRepo("backend", "platform"))
+	userSvc := service.NewUserService(userRepo, prRepo, newFakeActivationScheduleRepo(), fakeTxManager{})
+	accessSvc := service.NewAccessService(
+		newFakeTeamLeadRepo(), newFakeTeamRepo("backend", "platform"), userRepo,
+		prRepo, newFakeRepoRepo(), newFakeActivationScheduleRepo(),
+	)
+
+	ts := httptest.NewServer(nethttp.NewRouter(
+		prSvc, newTeamServiceStub(), userSvc, newRepositoryServiceStub(), adminToken,
+		handlers.WithJWT(verifier),
+		handlers.WithTeamLeads(accessSvc),
+		handlers.WithRequireAuth(),
+	))
+	defer ts.Close()
+
+	setIsActive := func(token, userID string) *http.Response {
+		return doWithToken(t, http.MethodPost, ts.URL+"/users/setIsActive", token, map[string]any{
+			"user_id":   userID,
+			"is_active": false,
+		})
+	}
+
+	resp := setIsActive(tokenFor("lead"), "dev")
+	require.Equal(t, http.StatusForbidden, resp.StatusCode, "до назначения руководителем")
+	var errResp api.ErrorResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&errResp))
+	require.Equal(t, api.FORBIDDEN, errResp.Error.Code)
+
+	resp = doWithToken(t, http.MethodPost, ts.URL+"/team/leads/add", tokenFor("lead"), map[string]any{
+		"team_name": "backend", "user_id": "lead",
+	})
+	require.Equal(t, http.StatusForbidden, resp.StatusCode, "назначать руководителей может только admin")
+
+	resp = doWithToken(t, http.MethodPost, ts.URL+"/team/leads/add", adminToken, map[string]any{
+		"team_name": "backend", "user_id": "lead",
+	})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp = doWithToken(t, http.MethodGet, ts.URL+"/auth/whoami", tokenFor("lead"), nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var principal api.Principal
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&principal))
+	require.Equal(t, api.RoleTeamLead, principal.Role)
+	require.Equal(t, []string{"backend"}, principal.LeadTeams)
+
+	require.Equal(t, http.StatusOK, setIsActive(tokenFor("lead"), "dev").StatusCode)
+	require.Equal(t, http.StatusForbidden, setIsActive(tokenFor("lead"), "ops").StatusCode)
+	require.Equal(t, http.StatusForbidden, setIsActive(tokenFor("dev"), "lead").StatusCode)
+
+	resp = doWithToken(t, http.MethodPost, ts.URL+"/team/massDeactivate", tokenFor("lead"), map[string]any{
+		"team_name": "platform", "user_ids": []string{"ops"},
+	})
+	require.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	reassign := func(token, prID string) *http.Response {
+		return doWithToken(t, http.MethodPost, ts.URL+"/pullRequest/reassign", token, map[string]any{
+			"pull_request_id": prID, "old_user_id": "lead",
+		})
+	}
+	require.Equal(t, http.StatusForbidden, reassign(tokenFor("dev2"), "pr-backend").StatusCode,
+		"scope write участника не даёт переназначать")
+	require.Equal(t, http.StatusForbidden, reassign(tokenFor("lead"), "pr-platform").StatusCode,
+		"руководитель чужой команды")
+	require.Equal(t, http.StatusOK, reassign(tokenFor("lead"), "pr-backend").StatusCode)
+
+	resp = doWithToken(t, http.MethodGet, ts.URL+"/users/get?user_id=dev", "bogus", nil)
+	require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&errResp))
+	require.Equal(t, api.UNAUTHORIZED, errResp.Error.Code)
+}
@@ -0,0 +1,86 @@
+package tests
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/service"
+	"context"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestAccessService_TeamLeads(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	userRepo := newFakeUserRepo()
+	userRepo.AddUser(api.User{UserId: "u1", Username: "Alice", TeamName: "backend", IsActive: true})
+	leadRepo := newFakeTeamLeadRepo()
+	svc := service.NewAccessService(
+		leadRepo, newFakeTeamRepo("backend", "platform"), userRepo,
+		newFakePRRepo(), newFakeRepoRepo(), newFakeActivationScheduleRepo(),
+	)
+
+	first, err := svc.AddTeamLead(ctx, api.TeamLeadRequest{TeamName: "backend", UserId: "u1"})
+	require.NoError(t, err)
+	again, err := svc.AddTeamLead(ctx, api.TeamLeadRequest{TeamName: "backend", UserId: "u1"})
+	require.NoError(t, err)
+	require.Equal(t, first.GrantedAt, again.GrantedAt)
+
+	_, err = svc.AddTeamLead(ctx, api.TeamLeadRequest{TeamName: "platform", UserId: "u1"})
+	require.NoError(t, err)
+	led, err := svc.LedTeams(ctx, "u1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"backend", "platform"}, led)
+
+	_, err = svc.AddTeamLead(ctx, api.TeamLeadRequest{TeamName: "mobile", UserId: "u1"})
+	require.ErrorIs(t, err, service.ErrNotFound)
+	_, err = svc.AddTeamLead(ctx, api.TeamLeadRequest{TeamName: "backend", UserId: "ghost"})
+	require.ErrorIs(t, err, service.ErrNotFound)
+
+	_, err = svc.RemoveTeamLead(ctx, api.TeamLeadRequest{TeamName: "platform", UserId: "u1"})
+	require.NoError(t, err)
+	_, err = svc.RemoveTeamLead(ctx, api.TeamLeadRequest{TeamName: "platform", UserId: "u1"})
+	require.ErrorIs(t, err, service.ErrNotFound)
+
+	leads, err := svc.ListTeamLeads(ctx, "platform")
+	require.NoError(t, err)
+	require.Empty(t, leads)
+	_, err = svc.ListTeamLeads(ctx, "mobile")
+	require.ErrorIs(t, err, service.ErrNotFound)
+}
+
+func TestAccessService_OperationTeams(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	userRepo := newFakeUserRepo()
+	userRepo.AddUser(api.User{UserId: "u1", Username: "Alice", TeamName: "backend", IsActive: true})
+	userRepo.addMembership("u1", "mobile")
+
+	repoRepo := newFakeRepoRepo()
+	_, err := repoRepo.Upsert(ctx, api.Repository{RepositoryId: "platform/api", TeamName: "platform"})
+	require.NoError(t, err)
+
+	repoID := "platform/api"
+	prRepo := newFakePRRepo()
+	prRepo.AddPR(&api.PullRequest{PullRequestId: "pr-1", AuthorId: "u1", Repository: &repoID})
+
+	scheduleRepo := newFakeActivationScheduleRepo()
+	sched, err := scheduleRepo.Create(ctx, "u1", false, time.Now().Add(time.Hour), nil)
+	require.NoError(t, err)
+
+	svc := service.NewAccessService(newFakeTeamLeadRepo(), newFakeTeamRepo(), userRepo, prRepo, repoRepo, scheduleRepo)
+
+	teams, err := svc.PullRequestTeams(ctx, "pr-1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"backend", "mobile", "platform"}, teams)
+
+	teams, err = svc.PullRequestTeams(ctx, "pr-missing")
+	require.NoError(t, err)
+	require.Empty(t, teams)
+
+	teams, err = svc.ScheduledActivationTeams(ctx, sched.Id)
+	require.NoError(t, err)
+	require.Equal(t, []string{"backend", "mobile"}, teams)
+}
@@ -0,0 +1,35 @@
+package tests
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/service"
+	"context"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestTeamService_ListTeams_PrefixAndCursor(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	teamRepo := newFakeTeamRepo("backend", "payments", "payments-api", "payments-core", "platform")
+	svc := service.NewTeamService(teamRepo, newFakeUserRepo(), newFakePRRepo(), newFakeRepoRepo(), newFakeTeamSettingsRepo(teamRepo), fakeTxManager{})
+
+	prefix, limit := "pay", 2
+	page, err := svc.ListTeams(ctx, api.GetTeamListParams{Prefix: &prefix, Limit: &limit})
+	require.NoError(t, err)
+	require.Len(t, page.Teams, 2)
+	require.Equal(t, "payments", page.Teams[0].TeamName)
+	require.Equal(t, "payments-api", page.Teams[1].TeamName)
+	require.NotNil(t, page.NextCursor)
+
+	page, err = svc.ListTeams(ctx, api.GetTeamListParams{Prefix: &prefix, Limit: &limit, Cursor: page.NextCursor})
+	require.NoError(t, err)
+	require.Len(t, page.Teams, 1)
+	require.Equal(t, "payments-core", page.Teams[0].TeamName)
+	require.Nil(t, page.NextCursor)
+
+	broken := "not-a-cursor"
+	_, err = svc.ListTeams(ctx, api.GetTeamListParams{Cursor: &broken})
+	require.ErrorIs(t, err, service.ErrInvalidArgument)
+}
@@ -0,0 +1,219 @@
+package tests
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/service"
+	"context"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type teamManagementFixture struct {
+	teamRepo *fakeTeamRepo
+	userRepo *fakeUserRepo
+	prRepo   *fakePRRepo
+	repoRepo *fakeRepoRepo
+	svc      service.TeamService
+}
+
+func newTeamManagementFixture() *teamManagementFixture {
+	f := &teamManagementFixture{
+		teamRepo: newFakeTeamRepo("backend", "platform"),
+		userRepo: newFakeUserRepo(),
+		prRepo:   newFakePRRepo(),
+		repoRepo: newFakeRepoRepo(),
+	}
+	f.teamRepo.onRename = f.userRepo.renameTeam
+	f.prRepo.users = f.userRepo
+	f.prRepo.repos = f.repoRepo
+
+	f.userRepo.AddUser(api.User{UserId: "u_lead", Username: "lead", TeamName: "backend", IsActive: true})
+	f.userRepo.AddUser(api.User{UserId: "u_dev1", Username: "dev1", TeamName: "backend", IsActive: true})
+	f.userRepo.AddUser(api.User{UserId: "u_dev2", Username: "dev2", TeamName: "backend", IsActive: true})
+	f.userRepo.AddUser(api.User{UserId: "u_plat", Username: "plat", TeamName: "platform", IsActive: true})
+
+	f.prRepo.AddPR(&api.PullRequest{
+		PullRequestId:     "pr-1",
+		PullRequestName:   "feature X",
+		AuthorId:          "u_lead",
+		Status:            api.PullRequestStatusOPEN,
+		AssignedReviewers: []string{"u_dev1"},
+	})
+	f.prRepo.AddShortForReviewer("u_dev1", api.PullRequestShort{
+		PullRequestId: "pr-1",
+		Status:        api.PullRequestShortStatusOPEN,
+	})
+
+	f.svc = service.NewTeamService(f.teamRepo, f.userRepo, f.prRepo, f.repoRepo, newFakeTeamSettingsRepo(f.teamRepo), fakeTxManager{})
+	return f
+}
+
+func TestTeamService_UpdateTeam_RemoveMemberReassignsReviews(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := newTeamManagementFixture()
+
+	remove := []string{"u_dev1"}
+	team, res, err := f.svc.UpdateTeam(ctx, api.PatchTeamUpdateJSONRequestBody{
+		TeamName:      "backend",
+		AddMembers:    &[]api.TeamMember{{UserId: "u_new", Username: "new", IsActive: true}},
+		RemoveMembers: &remove,
+	})
+	require.NoError(t, err)
+
+	ids := make([]string, 0, len(team.Members))
+	for _, m := range team.Members {
+		ids = append(ids, m.UserId)
+	}
+	require.ElementsMatch(t, []string{"u_lead", "u_dev2", "u_new"}, ids)
+
+	require.Equal(t, 1, res.ReassignedCount)
+	require.Equal(t, 0, res.NotReassignedCount)
+	require.Len(t, f.prRepo.replaceCalls, 1)
+	require.Equal(t, "u_dev1", f.prRepo.replaceCalls[0].OldReviewerID)
+	require.Contains(t, []string{"u_dev2", "u_new"}, f.prRepo.replaceCalls[0].NewReviewerID)
+
+	dev1, err := f.userRepo.GetByID(ctx, "u_dev1")
+	require.NoError(t, err)
+	require.Equal(t, "", dev1.TeamName)
+}
+
+// addPlatformReview делает u_dev1 участником platform с открытым ревью PR этой команды.
+func (f *teamManagementFixture) addPlatformReview(t *testing.T) {
+	t.Helper()
+
+	_, err := f.userRepo.UpsertTeamMembers(context.Background(), "platform", []api.TeamMember{
+		{UserId: "u_dev1", Username: "dev1", IsActive: true},
+	})
+	require.NoError(t, err)
+	f.prRepo.AddPR(&api.PullRequest{
+		PullRequestId:     "pr-plat",
+		PullRequestName:   "platform feature",
+		AuthorId:          "u_plat",
+		Status:            api.PullRequestStatusOPEN,
+		AssignedReviewers: []string{"u_dev1"},
+	})
+	f.prRepo.AddShortForReviewer("u_dev1", api.PullRequestShort{
+		PullRequestId: "pr-plat",
+		Status:        api.PullRequestShortStatusOPEN,
+	})
+}
+
+func TestTeamService_UpdateTeam_RemoveMemberKeepsOtherTeamReviews(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := newTeamManagementFixture()
+	f.addPlatformReview(t)
+
+	remove := []string{"u_dev1"}
+	_, res, err := f.svc.UpdateTeam(ctx, api.PatchTeamUpdateJSONRequestBody{
+		TeamName:      "backend",
+		RemoveMembers: &remove,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, res.ReassignedCount)
+	require.Len(t, f.prRepo.replaceCalls, 1)
+	require.Equal(t, "pr-1", f.prRepo.replaceCalls[0].PRID, "ревью в platform остаётся за участником")
+}
+
+func TestTeamService_DeleteTeam_IgnoresOtherTeamReviews(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := newTeamManagementFixture()
+	f.addPlatformReview(t)
+	require.NoError(t, f.teamRepo.Create(ctx, "guild"))
+	_, err := f.userRepo.UpsertTeamMembers(ctx, "guild", []api.TeamMember{
+		{UserId: "u_dev1", Username: "dev1", IsActive: true},
+	})
+	require.NoError(t, err)
+
+	res, err := f.svc.DeleteTeam(ctx, api.DeleteTeamParams{
+		TeamName: "guild",
+		Policy:   api.Refuse,
+	})
+	require.NoError(t, err, "ревью в других командах не мешают удалению")
+	require.Equal(t, 1, res.DetachedCount)
+
+	require.NoError(t, f.teamRepo.Create(ctx, "guild"))
+	_, err = f.userRepo.UpsertTeamMembers(ctx, "guild", []api.TeamMember{
+		{UserId: "u_dev1", Username: "dev1", IsActive: true},
+	})
+	require.NoError(t, err)
+	target := "backend"
+	res, err = f.svc.DeleteTeam(ctx, api.DeleteTeamParams{
+		TeamName:   "guild",
+		Policy:     api.Reassign,
+		TargetTeam: &target,
+	})
+	require.NoError(t, err)
+	require.Zero(t, res.ReassignedCount)
+	require.Empty(t, f.prRepo.replaceCalls)
+}
+
+func TestTeamService_DeleteTeam_RefuseWithOpenReviews(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := newTeamManagementFixture()
+
+	res, err := f.svc.DeleteTeam(ctx, api.DeleteTeamParams{
+		TeamName: "backend",
+		Policy:   api.Refuse,
+	})
+	require.ErrorIs(t, err, service.ErrTeamInUse)
+	require.Nil(t, res)
+
+	exists, err := f.teamRepo.Exists(ctx, "backend")
+	require.NoError(t, err)
+	require.True(t, exists)
+}
+
+func TestTeamService_DeleteTeam_ReassignToTargetTeam(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := newTeamManagementFixture()
+
+	_, err := f.repoRepo.Upsert(ctx, api.Repository{
+		RepositoryId:   "backend/api",
+		TeamName:       "backend",
+		ReviewerSource: api.AuthorTeam,
+	})
+	require.NoError(t, err)
+
+	target := "platform"
+	res, err := f.svc.DeleteTeam(ctx, api.DeleteTeamParams{
+		TeamName:   "backend",
+		Policy:     api.Reassign,
+		TargetTeam: &target,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, res.DetachedCount)
+	require.Equal(t, 1, res.ReassignedCount)
+
+	require.Len(t, f.prRepo.replaceCalls, 1)
+	require.Equal(t, "u_plat", f.prRepo.replaceCalls[0].NewReviewerID)
+
+	repo, err := f.repoRepo.GetByID(ctx, "backend/api")
+	require.NoError(t, err)
+	require.Equal(t, "platform", repo.TeamName)
+
+	exists, err := f.teamRepo.Exists(ctx, "backend")
+	require.NoError(t, err)
+	require.False(t, exists)
+}
+
+func TestTeamService_DeleteTeam_ReassignRequiresTarget(t *testing.T) {
+	t.Parallel()
+
+	f := newTeamManagementFixture()
+
+	_, err := f.svc.DeleteTeam(context.Background(), api.DeleteTeamParams{
+		TeamName: "backend",
+		Policy:   api.Reassign,
+	})
+	require.ErrorIs(t, err, service.ErrInvalidArgument)
+}
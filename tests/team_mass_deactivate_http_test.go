@@ -52,14 +52,14 @@ func TestHTTP_TeamMassDeactivate_HappyPath(t *testing.T) {
 		Status:        api.PullRequestShortStatusOPEN,
 	})
 
-	userSvc := service.NewUserService(userRepo, prRepo)
+	userSvc := service.NewUserService(userRepo, prRepo, newFakeActivationScheduleRepo(), fakeTxManager{})
 
 	prSvc := newPRServiceStub()
 	teamSvc := newTeamServiceStub()
 
 	const adminToken = "secret-admin"
 
-	handler := nethttp.NewRouter(prSvc, teamSvc, userSvc, adminToken)
+	handler := nethttp.NewRouter(prSvc, teamSvc, userSvc, newRepositoryServiceStub(), adminToken)
 	ts := httptest.NewServer(handler)
 	defer ts.Close()
 
@@ -119,13 +119,13 @@ func TestHTTP_TeamMassDeactivate_UnauthorizedWithoutToken(t *testing.T) {
 	userRepo := newFakeUserRepo()
 	prRepo := newFakePRRepo()
 
-	userSvc := service.NewUserService(userRepo, prRepo)
+	userSvc := service.NewUserService(userRepo, prRepo, newFakeActivationScheduleRepo(), fakeTxManager{})
 	prSvc := newPRServiceStub()
 	teamSvc := newTeamServiceStub()
 
 	const adminToken = "secret-admin"
 
-	handler := nethttp.NewRouter(prSvc, teamSvc, userSvc, adminToken)
+	handler := nethttp.NewRouter(prSvc, teamSvc, userSvc, newRepositoryServiceStub(), adminToken)
 	ts := httptest.NewServer(handler)
 	defer ts.Close()
 
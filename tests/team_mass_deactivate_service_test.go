@@ -47,7 +47,7 @@ func TestMassDeactivateTeamUsers_HappyPath(t *testing.T) {
 		Status:        api.PullRequestShortStatusOPEN,
 	})
 
-	userSvc := service.NewUserService(userRepo, prRepo)
+	userSvc := service.NewUserService(userRepo, prRepo, newFakeActivationScheduleRepo(), fakeTxManager{})
 
 	res, err := userSvc.MassDeactivateTeamUsers(ctx, "backend", []string{"u_dev1"})
 	require.NoError(t, err)
@@ -102,7 +102,7 @@ func TestMassDeactivateTeamUsers_NoCandidates(t *testing.T) {
 		Status:        api.PullRequestShortStatusOPEN,
 	})
 
-	userSvc := service.NewUserService(userRepo, prRepo)
+	userSvc := service.NewUserService(userRepo, prRepo, newFakeActivationScheduleRepo(), fakeTxManager{})
 
 	res, err := userSvc.MassDeactivateTeamUsers(ctx, "data", []string{"u_rev"})
 	require.NoError(t, err)
@@ -121,7 +121,7 @@ func TestMassDeactivateTeamUsers_InvalidTeamName(t *testing.T) {
 	userRepo := newFakeUserRepo()
 	prRepo := newFakePRRepo()
 
-	userSvc := service.NewUserService(userRepo, prRepo)
+	userSvc := service.NewUserService(userRepo, prRepo, newFakeActivationScheduleRepo(), fakeTxManager{})
 
 	res, err := userSvc.MassDeactivateTeamUsers(context.Background(), "", []string{"u1"})
 	require.Error(t, err)
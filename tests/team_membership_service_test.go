@@ -0,0 +1,72 @@
+package tests
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/service"
+	"context"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestPRService_ReassignReviewer_UsesSharedTeam(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	userRepo := newFakeUserRepo()
+	prRepo := newFakePRRepo()
+
+	userRepo.AddUser(api.User{UserId: "u_author", Username: "author", TeamName: "payments", IsActive: true})
+	userRepo.AddUser(api.User{UserId: "u_pay", Username: "pay", TeamName: "payments", IsActive: true})
+	userRepo.AddUser(api.User{UserId: "u_plat", Username: "plat", TeamName: "platform", IsActive: true})
+	userRepo.AddUser(api.User{UserId: "u_both", Username: "both", TeamName: "platform", IsActive: true})
+
+	_, err := userRepo.UpsertTeamMembers(ctx, "payments", []api.TeamMember{
+		{UserId: "u_both", Username: "both", IsActive: true},
+	})
+	require.NoError(t, err)
+
+	members, err := userRepo.ListByTeam(ctx, "payments")
+	require.NoError(t, err)
+	require.Len(t, members, 3)
+
+	prRepo.AddPR(&api.PullRequest{
+		PullRequestId:     "pr-1",
+		PullRequestName:   "refund flow",
+		AuthorId:          "u_author",
+		Status:            api.PullRequestStatusOPEN,
+		AssignedReviewers: []string{"u_both"},
+	})
+
+	prSvc := service.NewPRService(prRepo, userRepo, newFakeRepoRepo(), newFakeTeamRepo())
+
+	_, newID, err := prSvc.ReassignReviewer(ctx, api.PostPullRequestReassignJSONRequestBody{
+		PullRequestId: "pr-1",
+		OldUserId:     "u_both",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "u_pay", newID, "замена ищется в общей с автором команде, а не в основной команде ревьювера")
+}
+
+func TestTeamService_UpdateTeam_RemovePrimaryFallsBackToSecondary(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := newTeamManagementFixture()
+
+	_, err := f.userRepo.UpsertTeamMembers(ctx, "platform", []api.TeamMember{
+		{UserId: "u_dev2", Username: "dev2", IsActive: true},
+	})
+	require.NoError(t, err)
+
+	remove := []string{"u_dev2"}
+	_, _, err = f.svc.UpdateTeam(ctx, api.PatchTeamUpdateJSONRequestBody{
+		TeamName:      "backend",
+		RemoveMembers: &remove,
+	})
+	require.NoError(t, err)
+
+	dev2, err := f.userRepo.GetByID(ctx, "u_dev2")
+	require.NoError(t, err)
+	require.Equal(t, "platform", dev2.TeamName)
+}
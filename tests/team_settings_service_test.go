@@ -0,0 +1,125 @@
+package tests
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/service"
+	"context"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestTeamService_Settings_VersionsAndEffective(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	_, svc := newPaymentsTree(t)
+
+	settings, err := svc.GetSettings(ctx, api.GetTeamSettingsParams{TeamName: "payments-core"})
+	require.NoError(t, err)
+	require.Equal(t, 0, settings.Version)
+	require.Nil(t, settings.Settings.ReviewerCount)
+	require.Equal(t, 3, *settings.Effective.ReviewerCount, "наследуется от payments")
+
+	one, zero := 1, 0
+	settings, err = svc.UpdateSettings(ctx, api.PutTeamSettingsJSONRequestBody{
+		TeamName:        "payments-core",
+		Settings:        api.TeamPolicy{ReviewerCount: &one},
+		ExpectedVersion: &zero,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, settings.Version)
+	require.Equal(t, 1, *settings.Effective.ReviewerCount)
+	require.True(t, *settings.Effective.SiblingFallback)
+
+	_, err = svc.UpdateSettings(ctx, api.PutTeamSettingsJSONRequestBody{
+		TeamName:        "payments-core",
+		Settings:        api.TeamPolicy{},
+		ExpectedVersion: &zero,
+	})
+	require.ErrorIs(t, err, service.ErrSettingsConflict)
+
+	bad := -1
+	_, err = svc.UpdateSettings(ctx, api.PutTeamSettingsJSONRequestBody{
+		TeamName: "payments-core",
+		Settings: api.TeamPolicy{ReviewSlaHours: &bad},
+	})
+	require.ErrorIs(t, err, service.ErrInvalidArgument)
+
+	_, err = svc.GetSettings(ctx, api.GetTeamSettingsParams{TeamName: "payments-core", Version: &zero})
+	require.ErrorIs(t, err, service.ErrNotFound)
+
+	_, err = svc.UpdateSettings(ctx, api.PutTeamSettingsJSONRequestBody{
+		TeamName: "ghost",
+		Settings: api.TeamPolicy{},
+	})
+	require.ErrorIs(t, err, service.ErrNotFound)
+}
+
+func TestTeamService_Settings_RollbackAddsVersion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	_, svc := newPaymentsTree(t)
+
+	one := 1
+	_, err := svc.UpdateSettings(ctx, api.PutTeamSettingsJSONRequestBody{
+		TeamName: "payments",
+		Settings: api.TeamPolicy{ReviewerCount: &one},
+	})
+	require.NoError(t, err)
+
+	settings, err := svc.RollbackSettings(ctx, api.PostTeamSettingsRollbackJSONRequestBody{
+		TeamName: "payments",
+		Version:  1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, settings.Version)
+	require.Equal(t, 3, *settings.Settings.ReviewerCount)
+	require.Equal(t, "rollback to version 1", *settings.Comment)
+
+	history, err := svc.SettingsHistory(ctx, "payments")
+	require.NoError(t, err)
+	require.Len(t, history, 3)
+	require.Equal(t, []int{3, 2, 1}, []int{history[0].Version, history[1].Version, history[2].Version})
+
+	tree, err := svc.GetTree(ctx, api.GetTeamTreeParams{})
+	require.NoError(t, err)
+	require.Equal(t, 3, *tree[0].Policy.ReviewerCount, "откат меняет действующие настройки")
+
+	_, err = svc.RollbackSettings(ctx, api.PostTeamSettingsRollbackJSONRequestBody{
+		TeamName: "payments",
+		Version:  7,
+	})
+	require.ErrorIs(t, err, service.ErrNotFound)
+}
+
+// racingSettingsRepo имитирует версию, которую одновременно занял другой запрос.
+type racingSettingsRepo struct {
+	*fakeTeamSettingsRepo
+}
+
+func (racingSettingsRepo) Save(context.Context, string, api.TeamPolicy, *int, *string) (*api.TeamSettingsVersion, error) {
+	return nil, nil
+}
+
+func TestTeamService_PolicyConflictIsNotDropped(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	teamRepo := newFakeTeamRepo("backend")
+	settings := racingSettingsRepo{newFakeTeamSettingsRepo(teamRepo)}
+	svc := service.NewTeamService(teamRepo, newFakeUserRepo(), newFakePRRepo(), newFakeRepoRepo(), settings, fakeTxManager{})
+
+	one := 1
+	_, err := svc.AddTeam(ctx, api.PostTeamAddJSONRequestBody{
+		TeamName: "payments",
+		Policy:   &api.TeamPolicy{ReviewerCount: &one},
+	}, service.MembershipRefuse)
+	require.ErrorIs(t, err, service.ErrSettingsConflict)
+
+	_, _, err = svc.UpdateTeam(ctx, api.PatchTeamUpdateJSONRequestBody{
+		TeamName: "backend",
+		Policy:   &api.TeamPolicy{ReviewerCount: &one},
+	})
+	require.ErrorIs(t, err, service.ErrSettingsConflict)
+}
@@ -5,22 +5,59 @@ import (
 	"avito-autumn2025-internship/internal/repository"
 	"avito-autumn2025-internship/internal/service"
 	"context"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 )
 
 type fakeUserRepo struct {
-	users map[string]*api.User
+	users      map[string]*api.User
+	accounts   map[string]string
+	teams      map[string]map[string]struct{}
+	anonymized map[string]time.Time
 }
 
 func newFakeUserRepo() *fakeUserRepo {
 	return &fakeUserRepo{
-		users: make(map[string]*api.User),
+		users:      make(map[string]*api.User),
+		accounts:   make(map[string]string),
+		teams:      make(map[string]map[string]struct{}),
+		anonymized: make(map[string]time.Time),
 	}
 }
 
 func (r *fakeUserRepo) AddUser(u api.User) {
 	uCopy := u
 	r.users[u.UserId] = &uCopy
+	if u.TeamName != "" {
+		r.addMembership(u.UserId, u.TeamName)
+	}
+}
+
+func (r *fakeUserRepo) renameTeam(teamName, newTeamName string) {
+	for userID, teams := range r.teams {
+		if _, ok := teams[teamName]; ok {
+			delete(teams, teamName)
+			teams[newTeamName] = struct{}{}
+		}
+		if u := r.users[userID]; u != nil && u.TeamName == teamName {
+			u.TeamName = newTeamName
+		}
+	}
+}
+
+func (r *fakeUserRepo) addMembership(userID, teamName string) {
+	if r.teams[userID] == nil {
+		r.teams[userID] = make(map[string]struct{})
+	}
+	r.teams[userID][teamName] = struct{}{}
+}
+
+func (r *fakeUserRepo) isMember(userID, teamName string) bool {
+	_, ok := r.teams[userID][teamName]
+	return ok
 }
 
 func (r *fakeUserRepo) UpsertTeamMembers(
@@ -34,9 +71,17 @@ func (r *fakeUserRepo) UpsertTeamMembers(
 			UserId:   m.UserId,
 			Username: m.Username,
 			TeamName: teamName,
-			IsActive: true,
+			IsActive: m.IsActive,
+		}
+		if existing, ok := r.users[m.UserId]; ok && existing.TeamName != "" {
+			u.TeamName = existing.TeamName
+			if existing.TeamName != teamName {
+				u.Username = existing.Username
+				u.IsActive = existing.IsActive
+			}
 		}
 		r.AddUser(u)
+		r.addMembership(u.UserId, teamName)
 		res = append(res, u)
 	}
 	return res, nil
@@ -45,7 +90,7 @@ func (r *fakeUserRepo) UpsertTeamMembers(
 func (r *fakeUserRepo) ListByTeam(_ context.Context, teamName string) ([]api.User, error) {
 	var res []api.User
 	for _, u := range r.users {
-		if u.TeamName == teamName {
+		if r.isMember(u.UserId, teamName) {
 			res = append(res, *u)
 		}
 	}
@@ -74,15 +119,527 @@ func (r *fakeUserRepo) SetIsActive(_ context.Context, userID string, isActive bo
 func (r *fakeUserRepo) ListActiveByTeam(_ context.Context, teamName string) ([]api.User, error) {
 	var res []api.User
 	for _, u := range r.users {
-		if u.TeamName == teamName && u.IsActive {
+		if r.isMember(u.UserId, teamName) && u.IsActive {
 			res = append(res, *u)
 		}
 	}
 	return res, nil
 }
 
+func (r *fakeUserRepo) LinkExternalAccount(_ context.Context, account api.ExternalAccount) (bool, error) {
+	key := string(account.Provider) + "/" + account.Login
+	if owner, ok := r.accounts[key]; ok && owner != account.UserId {
+		return false, nil
+	}
+	for k, id := range r.accounts {
+		if id == account.UserId && strings.HasPrefix(k, string(account.Provider)+"/") {
+			delete(r.accounts, k)
+		}
+	}
+	r.accounts[key] = account.UserId
+	return true, nil
+}
+
+func (r *fakeUserRepo) GetByExternalLogin(
+	ctx context.Context,
+	provider api.ExternalAccountProvider,
+	login string,
+) (*api.User, error) {
+	if userID, ok := r.accounts[string(provider)+"/"+login]; ok {
+		return r.GetByID(ctx, userID)
+	}
+	var match *api.User
+	for _, u := range r.users {
+		if u.Username != login || r.hasAccount(provider, u.UserId) {
+			continue
+		}
+		if match != nil {
+			return nil, nil
+		}
+		uCopy := *u
+		match = &uCopy
+	}
+	return match, nil
+}
+
+func (r *fakeUserRepo) hasAccount(provider api.ExternalAccountProvider, userID string) bool {
+	for k, id := range r.accounts {
+		if id == userID && strings.HasPrefix(k, string(provider)+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *fakeUserRepo) ListExternalLogins(
+	_ context.Context,
+	provider api.ExternalAccountProvider,
+	userIDs []string,
+) (map[string]string, error) {
+	wanted := make(map[string]struct{}, len(userIDs))
+	for _, id := range userIDs {
+		wanted[id] = struct{}{}
+	}
+	prefix := string(provider) + "/"
+	res := make(map[string]string)
+	for key, userID := range r.accounts {
+		if _, ok := wanted[userID]; ok && strings.HasPrefix(key, prefix) {
+			res[userID] = strings.TrimPrefix(key, prefix)
+		}
+	}
+	return res, nil
+}
+
+func (r *fakeUserRepo) ListTeams(_ context.Context, userID string) ([]string, error) {
+	teams := make([]string, 0, len(r.teams[userID]))
+	for team := range r.teams[userID] {
+		teams = append(teams, team)
+	}
+	sort.Strings(teams)
+	return teams, nil
+}
+
+func (r *fakeUserRepo) DetachFromTeam(ctx context.Context, teamName string, userIDs []string) error {
+	for _, id := range userIDs {
+		delete(r.teams[id], teamName)
+		if u, ok := r.users[id]; ok && u.TeamName == teamName {
+			u.TeamName = ""
+			if rest, _ := r.ListTeams(ctx, id); len(rest) > 0 {
+				u.TeamName = rest[0]
+			}
+		}
+	}
+	return nil
+}
+
+func (r *fakeUserRepo) MoveToTeam(_ context.Context, userID, teamName string) (*api.User, error) {
+	u, ok := r.users[userID]
+	if !ok {
+		return nil, nil
+	}
+	if u.TeamName != "" {
+		delete(r.teams[userID], u.TeamName)
+	}
+	u.TeamName = teamName
+	r.addMembership(userID, teamName)
+	uCopy := *u
+	return &uCopy, nil
+}
+
+func (r *fakeUserRepo) List(ctx context.Context, filter repository.UserFilter) ([]api.User, error) {
+	key := func(u api.User) string {
+		if filter.SortBy == repository.UserSortByUsername {
+			return u.Username
+		}
+		return u.UserId
+	}
+	less := func(ak, aID, bk, bID string) bool {
+		if ak != bk {
+			return ak < bk
+		}
+		return aID < bID
+	}
+
+	var res []api.User
+	for _, u := range r.users {
+		if filter.TeamName != nil && !r.isMember(u.UserId, *filter.TeamName) {
+			continue
+		}
+		if filter.IsActive != nil && u.IsActive != *filter.IsActive {
+			continue
+		}
+		if filter.Query != "" && !strings.Contains(strings.ToLower(u.Username), strings.ToLower(filter.Query)) {
+			continue
+		}
+		if filter.Username != nil && !strings.EqualFold(u.Username, *filter.Username) {
+			continue
+		}
+		if filter.UserIDs != nil && !slices.Contains(filter.UserIDs, u.UserId) {
+			continue
+		}
+		if after := filter.After; after != nil {
+			if filter.Desc && !less(key(*u), u.UserId, after.SortKey, after.UserID) {
+				continue
+			}
+			if !filter.Desc && !less(after.SortKey, after.UserID, key(*u), u.UserId) {
+				continue
+			}
+		}
+		uCopy := *u
+		teams, _ := r.ListTeams(ctx, u.UserId)
+		uCopy.Teams = &teams
+		res = append(res, uCopy)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if filter.Desc {
+			i, j = j, i
+		}
+		return less(key(res[i]), res[i].UserId, key(res[j]), res[j].UserId)
+	})
+	if filter.After == nil && filter.Offset > 0 {
+		res = res[min(filter.Offset, len(res)):]
+	}
+	if filter.Limit > 0 && len(res) > filter.Limit {
+		res = res[:filter.Limit]
+	}
+	return res, nil
+}
+
+func (r *fakeUserRepo) Stream(ctx context.Context, filter repository.UserFilter) (repository.Rows[api.User], error) {
+	users, err := r.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return repository.NewSliceRows(users), nil
+}
+
+func (r *fakeUserRepo) Count(ctx context.Context, filter repository.UserFilter) (int, error) {
+	filter.After, filter.Offset, filter.Limit = nil, 0, len(r.users)
+	users, err := r.List(ctx, filter)
+	return len(users), err
+}
+
+func (r *fakeUserRepo) UpsertUser(_ context.Context, userID, username string, isActive bool) (*api.User, error) {
+	u, ok := r.users[userID]
+	if !ok {
+		u = &api.User{UserId: userID}
+		r.users[userID] = u
+	}
+	u.Username = username
+	u.IsActive = isActive
+	res := *u
+	return &res, nil
+}
+
+func (r *fakeUserRepo) Anonymize(_ context.Context, userID, username string, _ *string) (time.Time, error) {
+	if u, ok := r.users[userID]; ok {
+		u.Username = username
+		u.IsActive = false
+	}
+	for key, id := range r.accounts {
+		if id == userID {
+			delete(r.accounts, key)
+		}
+	}
+	now := time.Now().UTC()
+	r.anonymized[userID] = now
+	return now, nil
+}
+
+func (r *fakeUserRepo) GetAnonymizedAt(_ context.Context, userID string) (*time.Time, error) {
+	at, ok := r.anonymized[userID]
+	if !ok {
+		return nil, nil
+	}
+	return &at, nil
+}
+
 var _ repository.UserRepository = (*fakeUserRepo)(nil)
 
+type fakeTeamRepo struct {
+	teams map[string]*repository.TeamNode
+	// onRename повторяет ON UPDATE CASCADE для связанных фейков.
+	onRename func(teamName, newTeamName string)
+}
+
+func newFakeTeamRepo(teams ...string) *fakeTeamRepo {
+	r := &fakeTeamRepo{teams: make(map[string]*repository.TeamNode)}
+	for _, t := range teams {
+		r.teams[t] = &repository.TeamNode{Name: t}
+	}
+	return r
+}
+
+func (r *fakeTeamRepo) Create(_ context.Context, teamName string) error {
+	r.teams[teamName] = &repository.TeamNode{Name: teamName}
+	return nil
+}
+
+func (r *fakeTeamRepo) Exists(_ context.Context, teamName string) (bool, error) {
+	_, ok := r.teams[teamName]
+	return ok, nil
+}
+
+func (r *fakeTeamRepo) Rename(_ context.Context, teamName, newTeamName string) error {
+	node, ok := r.teams[teamName]
+	if !ok {
+		return nil
+	}
+	delete(r.teams, teamName)
+	node.Name = newTeamName
+	r.teams[newTeamName] = node
+	for _, t := range r.teams {
+		if t.Parent != nil && *t.Parent == teamName {
+			t.Parent = &newTeamName
+		}
+	}
+	if r.onRename != nil {
+		r.onRename(teamName, newTeamName)
+	}
+	return nil
+}
+
+func (r *fakeTeamRepo) Delete(_ context.Context, teamName string) error {
+	node, ok := r.teams[teamName]
+	if !ok {
+		return nil
+	}
+	for _, t := range r.teams {
+		if t.Parent != nil && *t.Parent == teamName {
+			t.Parent = node.Parent
+		}
+	}
+	delete(r.teams, teamName)
+	return nil
+}
+
+func (r *fakeTeamRepo) List(_ context.Context, filter repository.TeamFilter) ([]api.TeamSummary, error) {
+	var res []api.TeamSummary
+	for name, node := range r.teams {
+		if !strings.HasPrefix(name, filter.Prefix) || (filter.After != "" && name <= filter.After) {
+			continue
+		}
+		res = append(res, api.TeamSummary{TeamName: name, ParentTeamName: node.Parent})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].TeamName < res[j].TeamName })
+	if len(res) > filter.Limit {
+		res = res[:filter.Limit]
+	}
+	return res, nil
+}
+
+func (r *fakeTeamRepo) Get(_ context.Context, teamName string) (*repository.TeamNode, error) {
+	node, ok := r.teams[teamName]
+	if !ok {
+		return nil, nil
+	}
+	nodeCopy := *node
+	return &nodeCopy, nil
+}
+
+func (r *fakeTeamRepo) SetParent(_ context.Context, teamName string, parent *string) error {
+	if node, ok := r.teams[teamName]; ok {
+		node.Parent = parent
+	}
+	return nil
+}
+
+func (r *fakeTeamRepo) Ancestors(_ context.Context, teamName string) ([]repository.TeamNode, error) {
+	var chain []repository.TeamNode
+	for name, depth := teamName, 0; ; depth++ {
+		node, ok := r.teams[name]
+		if !ok {
+			break
+		}
+		nodeCopy := *node
+		nodeCopy.Depth = depth
+		chain = append(chain, nodeCopy)
+		if node.Parent == nil {
+			break
+		}
+		name = *node.Parent
+	}
+	return chain, nil
+}
+
+func (r *fakeTeamRepo) Subtree(_ context.Context, teamName string) ([]repository.TeamNode, error) {
+	var level []repository.TeamNode
+	for _, node := range r.teams {
+		if (teamName == "" && node.Parent == nil) || node.Name == teamName {
+			level = append(level, *node)
+		}
+	}
+
+	var res []repository.TeamNode
+	for depth := 0; len(level) > 0; depth++ {
+		sort.Slice(level, func(i, j int) bool { return level[i].Name < level[j].Name })
+		var next []repository.TeamNode
+		for _, node := range level {
+			node.Depth = depth
+			res = append(res, node)
+			for _, child := range r.teams {
+				if child.Parent != nil && *child.Parent == node.Name {
+					next = append(next, *child)
+				}
+			}
+		}
+		level = next
+	}
+	return res, nil
+}
+
+// fakeTeamSettingsRepo хранит версии настроек и переносит последнюю в узлы fakeTeamRepo,
+// как это делает LEFT JOIN в postgres-реализации.
+type fakeTeamSettingsRepo struct {
+	teams    *fakeTeamRepo
+	versions map[string][]api.TeamSettingsVersion
+}
+
+func newFakeTeamSettingsRepo(teams *fakeTeamRepo) *fakeTeamSettingsRepo {
+	return &fakeTeamSettingsRepo{
+		teams:    teams,
+		versions: make(map[string][]api.TeamSettingsVersion),
+	}
+}
+
+func (r *fakeTeamSettingsRepo) Get(_ context.Context, teamName string) (*api.TeamSettingsVersion, error) {
+	versions := r.versions[teamName]
+	if len(versions) == 0 {
+		return nil, nil
+	}
+	v := versions[len(versions)-1]
+	return &v, nil
+}
+
+func (r *fakeTeamSettingsRepo) GetVersion(_ context.Context, teamName string, version int) (*api.TeamSettingsVersion, error) {
+	versions := r.versions[teamName]
+	if version < 1 || version > len(versions) {
+		return nil, nil
+	}
+	v := versions[version-1]
+	return &v, nil
+}
+
+func (r *fakeTeamSettingsRepo) ListVersions(_ context.Context, teamName string) ([]api.TeamSettingsVersion, error) {
+	versions := r.versions[teamName]
+	res := make([]api.TeamSettingsVersion, 0, len(versions))
+	for i := len(versions) - 1; i >= 0; i-- {
+		res = append(res, versions[i])
+	}
+	return res, nil
+}
+
+func (r *fakeTeamSettingsRepo) Save(
+	_ context.Context,
+	teamName string,
+	settings api.TeamPolicy,
+	expectedVersion *int,
+	comment *string,
+) (*api.TeamSettingsVersion, error) {
+	current := len(r.versions[teamName])
+	if expectedVersion != nil && *expectedVersion != current {
+		return nil, nil
+	}
+
+	v := api.TeamSettingsVersion{
+		TeamName:  teamName,
+		Version:   current + 1,
+		Settings:  settings,
+		CreatedAt: time.Now(),
+		Comment:   comment,
+	}
+	r.versions[teamName] = append(r.versions[teamName], v)
+	if node, ok := r.teams.teams[teamName]; ok {
+		node.Policy = settings
+	}
+	return &v, nil
+}
+
+var _ repository.TeamRepository = (*fakeTeamRepo)(nil)
+
+type fakeTxManager struct{}
+
+func (fakeTxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+var _ repository.TxManager = fakeTxManager{}
+
+type fakeActivationScheduleRepo struct {
+	schedules []*api.ScheduledActivation
+}
+
+func newFakeActivationScheduleRepo() *fakeActivationScheduleRepo {
+	return &fakeActivationScheduleRepo{}
+}
+
+func (r *fakeActivationScheduleRepo) Create(
+	_ context.Context,
+	userID string,
+	isActive bool,
+	effectiveAt time.Time,
+	comment *string,
+) (*api.ScheduledActivation, error) {
+	s := &api.ScheduledActivation{
+		Id:          int64(len(r.schedules) + 1),
+		UserId:      userID,
+		IsActive:    isActive,
+		EffectiveAt: effectiveAt,
+		Status:      api.ScheduledActivationStatusPENDING,
+		Comment:     comment,
+		CreatedAt:   time.Now(),
+	}
+	r.schedules = append(r.schedules, s)
+	sCopy := *s
+	return &sCopy, nil
+}
+
+func (r *fakeActivationScheduleRepo) Get(_ context.Context, id int64) (*api.ScheduledActivation, error) {
+	if id < 1 || id > int64(len(r.schedules)) {
+		return nil, nil
+	}
+	sCopy := *r.schedules[id-1]
+	return &sCopy, nil
+}
+
+func (r *fakeActivationScheduleRepo) List(
+	_ context.Context,
+	filter repository.ActivationScheduleFilter,
+) ([]api.ScheduledActivation, error) {
+	var res []api.ScheduledActivation
+	for _, s := range r.schedules {
+		if s.Status != filter.Status || (filter.UserID != nil && s.UserId != *filter.UserID) {
+			continue
+		}
+		res = append(res, *s)
+	}
+	sort.SliceStable(res, func(i, j int) bool { return res[i].EffectiveAt.Before(res[j].EffectiveAt) })
+	return res, nil
+}
+
+func (r *fakeActivationScheduleRepo) Cancel(_ context.Context, id int64) (*api.ScheduledActivation, error) {
+	if id < 1 || id > int64(len(r.schedules)) || r.schedules[id-1].Status != api.ScheduledActivationStatusPENDING {
+		return nil, nil
+	}
+	r.schedules[id-1].Status = api.ScheduledActivationStatusCANCELLED
+	sCopy := *r.schedules[id-1]
+	return &sCopy, nil
+}
+
+func (r *fakeActivationScheduleRepo) ClaimDue(_ context.Context, now time.Time) (*api.ScheduledActivation, error) {
+	var due *api.ScheduledActivation
+	for _, s := range r.schedules {
+		if s.Status != api.ScheduledActivationStatusPENDING || s.EffectiveAt.After(now) {
+			continue
+		}
+		if due == nil || s.EffectiveAt.Before(due.EffectiveAt) {
+			due = s
+		}
+	}
+	if due == nil {
+		return nil, nil
+	}
+	sCopy := *due
+	return &sCopy, nil
+}
+
+func (r *fakeActivationScheduleRepo) MarkApplied(_ context.Context, id int64, appliedAt time.Time) error {
+	s := r.schedules[id-1]
+	s.Status = api.ScheduledActivationStatusAPPLIED
+	s.AppliedAt = &appliedAt
+	return nil
+}
+
+func (r *fakeActivationScheduleRepo) MarkFailed(_ context.Context, id int64, reason string) error {
+	s := r.schedules[id-1]
+	s.Status = api.ScheduledActivationStatusFAILED
+	s.FailureReason = &reason
+	return nil
+}
+
+var _ repository.ActivationScheduleRepository = (*fakeActivationScheduleRepo)(nil)
+
 type fakePRRepo struct {
 	prs             map[string]*api.PullRequest
 	shortByReviewer map[string][]api.PullRequestShort
@@ -92,6 +649,20 @@ type fakePRRepo struct {
 		OldReviewerID string
 		NewReviewerID string
 	}
+
+	// stats возвращается из GetReviewerAssignmentsStats, statsFilters — полученные фильтры.
+	stats        []repository.ReviewerAssignmentsStat
+	statsFilters []repository.StatsFilter
+
+	turnaround        []repository.TurnaroundStat
+	turnaroundFilters []repository.TurnaroundFilter
+
+	reviewGraph        []repository.ReviewEdge
+	reviewGraphFilters []repository.ReviewGraphFilter
+
+	// users и repos нужны ListOpenShortByReviewerInTeam, чтобы отнести PR к команде.
+	users *fakeUserRepo
+	repos *fakeRepoRepo
 }
 
 func newFakePRRepo() *fakePRRepo {
@@ -138,6 +709,17 @@ func (r *fakePRRepo) SetMerged(_ context.Context, prID string, mergedAt time.Tim
 	return &cp, nil
 }
 
+func (r *fakePRRepo) SetStatus(_ context.Context, prID string, status api.PullRequestStatus) (*api.PullRequest, error) {
+	pr, ok := r.prs[prID]
+	if !ok {
+		return nil, nil
+	}
+	cp := *pr
+	cp.Status = status
+	r.prs[prID] = &cp
+	return &cp, nil
+}
+
 func (r *fakePRRepo) ReplaceReviewer(_ context.Context, prID, oldReviewerID, newReviewerID string) error {
 	r.replaceCalls = append(r.replaceCalls, struct {
 		PRID          string
@@ -188,17 +770,187 @@ func (r *fakePRRepo) ListShortByReviewer(_ context.Context, reviewerID string) (
 	return cp, nil
 }
 
-func (r *fakePRRepo) GetReviewerAssignmentsStats(_ context.Context) ([]repository.ReviewerAssignmentsStat, error) {
-	return nil, nil
+func (r *fakePRRepo) ListOpenShortByReviewerInTeam(
+	_ context.Context,
+	reviewerID, teamName string,
+) ([]api.PullRequestShort, error) {
+	var res []api.PullRequestShort
+	for _, short := range r.shortByReviewer[reviewerID] {
+		pr, ok := r.prs[short.PullRequestId]
+		if short.Status != api.PullRequestShortStatusOPEN || !ok {
+			continue
+		}
+		inTeam := false
+		if r.users != nil {
+			if author, ok := r.users.users[pr.AuthorId]; ok && author.TeamName == teamName {
+				inTeam = true
+			}
+		}
+		if r.repos != nil && pr.Repository != nil {
+			if repo, ok := r.repos.repos[*pr.Repository]; ok && repo.TeamName == teamName {
+				inTeam = true
+			}
+		}
+		if inTeam {
+			res = append(res, short)
+		}
+	}
+	return res, nil
+}
+
+func (r *fakePRRepo) StreamShortByReviewer(
+	ctx context.Context,
+	reviewerID string,
+) (repository.Rows[api.PullRequestShort], error) {
+	prs, err := r.ListShortByReviewer(ctx, reviewerID)
+	if err != nil {
+		return nil, err
+	}
+	return repository.NewSliceRows(prs), nil
+}
+
+func (r *fakePRRepo) GetReviewGraph(
+	_ context.Context,
+	filter repository.ReviewGraphFilter,
+) ([]repository.ReviewEdge, error) {
+	r.reviewGraphFilters = append(r.reviewGraphFilters, filter)
+	return r.reviewGraph, nil
+}
+
+func (r *fakePRRepo) ListOpenByReviewer(_ context.Context, reviewerID string) ([]api.PullRequest, error) {
+	return r.listOpen(func(pr *api.PullRequest) bool {
+		return slices.Contains(pr.AssignedReviewers, reviewerID)
+	}), nil
+}
+
+func (r *fakePRRepo) ListOpenByAuthor(_ context.Context, authorID string) ([]api.PullRequest, error) {
+	return r.listOpen(func(pr *api.PullRequest) bool { return pr.AuthorId == authorID }), nil
+}
+
+func (r *fakePRRepo) listOpen(match func(pr *api.PullRequest) bool) []api.PullRequest {
+	var res []api.PullRequest
+	for _, pr := range r.prs {
+		if pr.Status == api.PullRequestStatusOPEN && match(pr) {
+			res = append(res, *pr)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		a, b := res[i].CreatedAt, res[j].CreatedAt
+		if a != nil && b != nil && !a.Equal(*b) {
+			return a.Before(*b)
+		}
+		return res[i].PullRequestId < res[j].PullRequestId
+	})
+	return res
+}
+
+func (r *fakePRRepo) CountAssignments(
+	_ context.Context,
+	reviewerID string,
+	since time.Time,
+) (total, recent int64, err error) {
+	for _, pr := range r.prs {
+		if !slices.Contains(pr.AssignedReviewers, reviewerID) {
+			continue
+		}
+		total++
+		if pr.CreatedAt != nil && !pr.CreatedAt.Before(since) {
+			recent++
+		}
+	}
+	return total, recent, nil
+}
+
+func (r *fakePRRepo) GetReviewerAssignmentsStats(
+	_ context.Context,
+	filter repository.StatsFilter,
+) ([]repository.ReviewerAssignmentsStat, error) {
+	r.statsFilters = append(r.statsFilters, filter)
+	return r.stats, nil
+}
+
+func (r *fakePRRepo) CountOpenAssignments(_ context.Context, reviewerIDs []string) (map[string]int64, error) {
+	wanted := make(map[string]struct{}, len(reviewerIDs))
+	for _, id := range reviewerIDs {
+		wanted[id] = struct{}{}
+	}
+	res := make(map[string]int64)
+	for _, pr := range r.prs {
+		if pr.Status != api.PullRequestStatusOPEN {
+			continue
+		}
+		for _, rid := range pr.AssignedReviewers {
+			if _, ok := wanted[rid]; ok {
+				res[rid]++
+			}
+		}
+	}
+	return res, nil
+}
+
+func (r *fakePRRepo) GetTurnaroundStats(
+	_ context.Context,
+	filter repository.TurnaroundFilter,
+) ([]repository.TurnaroundStat, error) {
+	r.turnaroundFilters = append(r.turnaroundFilters, filter)
+	return r.turnaround, nil
 }
 
 var _ repository.PRRepository = (*fakePRRepo)(nil)
 
+type fakeRepoRepo struct {
+	repos map[string]*api.Repository
+}
+
+func newFakeRepoRepo() *fakeRepoRepo {
+	return &fakeRepoRepo{
+		repos: make(map[string]*api.Repository),
+	}
+}
+
+func (r *fakeRepoRepo) Upsert(_ context.Context, repo api.Repository) (*api.Repository, error) {
+	cp := repo
+	r.repos[repo.RepositoryId] = &cp
+	return &repo, nil
+}
+
+func (r *fakeRepoRepo) GetByID(_ context.Context, repositoryID string) (*api.Repository, error) {
+	repo, ok := r.repos[repositoryID]
+	if !ok {
+		return nil, nil
+	}
+	cp := *repo
+	return &cp, nil
+}
+
+func (r *fakeRepoRepo) CountByTeam(_ context.Context, teamName string) (int64, error) {
+	var cnt int64
+	for _, repo := range r.repos {
+		if repo.TeamName == teamName {
+			cnt++
+		}
+	}
+	return cnt, nil
+}
+
+func (r *fakeRepoRepo) TransferTeam(_ context.Context, fromTeam, toTeam string) error {
+	for _, repo := range r.repos {
+		if repo.TeamName == fromTeam {
+			repo.TeamName = toTeam
+		}
+	}
+	return nil
+}
+
+var _ repository.RepoRepository = (*fakeRepoRepo)(nil)
+
 type prServiceStub struct{}
 type teamServiceStub struct{}
+type repositoryServiceStub struct{}
 
-func newPRServiceStub() service.PRService     { return &prServiceStub{} }
-func newTeamServiceStub() service.TeamService { return &teamServiceStub{} }
+func newPRServiceStub() service.PRService                 { return &prServiceStub{} }
+func newTeamServiceStub() service.TeamService             { return &teamServiceStub{} }
+func newRepositoryServiceStub() service.RepositoryService { return &repositoryServiceStub{} }
 
 func (*prServiceStub) CreatePR(ctx context.Context, body api.PostPullRequestCreateJSONRequestBody) (*api.PullRequest, error) {
 	panic("not implemented")
@@ -212,11 +964,38 @@ func (*prServiceStub) ReassignReviewer(ctx context.Context, body api.PostPullReq
 	panic("not implemented")
 }
 
-func (*prServiceStub) GetReviewerAssignments(ctx context.Context) ([]api.ReviewerStat, error) {
+func (*prServiceStub) GetReviewerAssignments(
+	ctx context.Context,
+	params api.GetStatsReviewerAssignmentsParams,
+) ([]api.ReviewerStat, error) {
+	panic("not implemented")
+}
+
+func (*prServiceStub) GetTurnaround(ctx context.Context, params api.GetStatsTurnaroundParams) ([]api.TurnaroundStat, error) {
+	panic("not implemented")
+}
+
+func (*prServiceStub) GetFairness(ctx context.Context, params api.GetStatsFairnessParams) ([]api.TeamFairness, error) {
+	panic("not implemented")
+}
+
+func (*prServiceStub) GetReviewGraph(ctx context.Context, params api.GetStatsReviewGraphParams) (*api.ReviewGraph, error) {
+	panic("not implemented")
+}
+
+func (*prServiceStub) ClosePR(ctx context.Context, prID string) (*api.PullRequest, error) {
 	panic("not implemented")
 }
 
-func (*teamServiceStub) AddTeam(ctx context.Context, body api.PostTeamAddJSONRequestBody) (*api.Team, error) {
+func (*prServiceStub) ReopenPR(ctx context.Context, prID string) (*api.PullRequest, error) {
+	panic("not implemented")
+}
+
+func (*teamServiceStub) AddTeam(
+	ctx context.Context,
+	body api.PostTeamAddJSONRequestBody,
+	mode service.MembershipMode,
+) (*api.Team, error) {
 	panic("not implemented")
 }
 
@@ -224,5 +1003,253 @@ func (*teamServiceStub) GetTeam(ctx context.Context, teamName string) (*api.Team
 	panic("not implemented")
 }
 
+func (*teamServiceStub) UpdateTeam(
+	ctx context.Context,
+	body api.PatchTeamUpdateJSONRequestBody,
+) (*api.Team, *api.ReviewReassignmentResult, error) {
+	panic("not implemented")
+}
+
+func (*teamServiceStub) RenameTeam(ctx context.Context, body api.PostTeamRenameJSONRequestBody) (*api.Team, error) {
+	panic("not implemented")
+}
+
+func (*teamServiceStub) DeleteTeam(ctx context.Context, params api.DeleteTeamParams) (*api.TeamDeleteResult, error) {
+	panic("not implemented")
+}
+
+func (*teamServiceStub) MoveUser(
+	ctx context.Context,
+	body api.PostUsersMoveTeamJSONRequestBody,
+) (*api.MoveTeamResult, error) {
+	panic("not implemented")
+}
+
+func (*repositoryServiceStub) UpsertRepository(
+	ctx context.Context,
+	body api.PostRepositoryUpsertJSONRequestBody,
+) (*api.Repository, error) {
+	panic("not implemented")
+}
+
+func (*repositoryServiceStub) GetRepository(ctx context.Context, repositoryID string) (*api.Repository, error) {
+	panic("not implemented")
+}
+
 var _ service.PRService = (*prServiceStub)(nil)
+
+func (*teamServiceStub) SetParent(ctx context.Context, body api.PostTeamSetParentJSONRequestBody) (*api.Team, error) {
+	panic("not implemented")
+}
+
+func (*teamServiceStub) GetTree(ctx context.Context, params api.GetTeamTreeParams) ([]api.TeamTreeNode, error) {
+	panic("not implemented")
+}
+
+func (*teamServiceStub) ListTeams(ctx context.Context, params api.GetTeamListParams) (*api.TeamListPage, error) {
+	panic("not implemented")
+}
+
+func (*teamServiceStub) GetSettings(ctx context.Context, params api.GetTeamSettingsParams) (*api.TeamSettings, error) {
+	panic("not implemented")
+}
+
+func (*teamServiceStub) UpdateSettings(
+	ctx context.Context,
+	body api.PutTeamSettingsJSONRequestBody,
+) (*api.TeamSettings, error) {
+	panic("not implemented")
+}
+
+func (*teamServiceStub) SettingsHistory(ctx context.Context, teamName string) ([]api.TeamSettingsVersion, error) {
+	panic("not implemented")
+}
+
+func (*teamServiceStub) RollbackSettings(
+	ctx context.Context,
+	body api.PostTeamSettingsRollbackJSONRequestBody,
+) (*api.TeamSettings, error) {
+	panic("not implemented")
+}
+
+func (*teamServiceStub) ImportTeams(
+	ctx context.Context,
+	format api.PostAdminImportParamsFormat,
+	data []byte,
+	dryRun bool,
+) (*api.ImportResult, error) {
+	panic("not implemented")
+}
+
 var _ service.TeamService = (*teamServiceStub)(nil)
+var _ service.RepositoryService = (*repositoryServiceStub)(nil)
+
+type fakeAPIKeyRepo struct {
+	keys   []*api.ApiKey
+	hashes map[int64]string
+}
+
+func newFakeAPIKeyRepo() *fakeAPIKeyRepo {
+	return &fakeAPIKeyRepo{hashes: make(map[int64]string)}
+}
+
+func (r *fakeAPIKeyRepo) Create(
+	_ context.Context,
+	name, prefix, hash string,
+	scopes []api.ApiKeyScope,
+	expiresAt *time.Time,
+) (*api.ApiKey, error) {
+	key := &api.ApiKey{
+		Id:        int64(len(r.keys) + 1),
+		Name:      name,
+		Prefix:    prefix,
+		Scopes:    slices.Clone(scopes),
+		ExpiresAt: expiresAt,
+		CreatedAt: time.Now(),
+	}
+	r.keys = append(r.keys, key)
+	r.hashes[key.Id] = hash
+	cp := *key
+	return &cp, nil
+}
+
+func (r *fakeAPIKeyRepo) GetByHash(_ context.Context, hash string) (*api.ApiKey, error) {
+	for _, key := range r.keys {
+		if r.hashes[key.Id] == hash {
+			cp := *key
+			return &cp, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeAPIKeyRepo) List(_ context.Context, includeRevoked bool) ([]api.ApiKey, error) {
+	var res []api.ApiKey
+	for i := len(r.keys) - 1; i >= 0; i-- {
+		if includeRevoked || r.keys[i].RevokedAt == nil {
+			res = append(res, *r.keys[i])
+		}
+	}
+	return res, nil
+}
+
+func (r *fakeAPIKeyRepo) Revoke(_ context.Context, id int64, at time.Time) (*api.ApiKey, error) {
+	for _, key := range r.keys {
+		if key.Id == id {
+			if key.RevokedAt == nil {
+				key.RevokedAt = &at
+			}
+			cp := *key
+			return &cp, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeAPIKeyRepo) TouchLastUsed(_ context.Context, id int64, at time.Time) error {
+	for _, key := range r.keys {
+		if key.Id == id {
+			key.LastUsedAt = &at
+		}
+	}
+	return nil
+}
+
+var _ repository.APIKeyRepository = (*fakeAPIKeyRepo)(nil)
+
+type fakeTeamLeadRepo struct {
+	leads map[string]map[string]time.Time
+}
+
+func newFakeTeamLeadRepo() *fakeTeamLeadRepo {
+	return &fakeTeamLeadRepo{leads: make(map[string]map[string]time.Time)}
+}
+
+func (r *fakeTeamLeadRepo) List(_ context.Context, teamName string) ([]api.TeamLead, error) {
+	var res []api.TeamLead
+	for userID, at := range r.leads[teamName] {
+		res = append(res, api.TeamLead{TeamName: teamName, UserId: userID, GrantedAt: at})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].UserId < res[j].UserId })
+	return res, nil
+}
+
+func (r *fakeTeamLeadRepo) Add(_ context.Context, teamName, userID string) (*api.TeamLead, error) {
+	if r.leads[teamName] == nil {
+		r.leads[teamName] = make(map[string]time.Time)
+	}
+	at, ok := r.leads[teamName][userID]
+	if !ok {
+		at = time.Now()
+		r.leads[teamName][userID] = at
+	}
+	return &api.TeamLead{TeamName: teamName, UserId: userID, GrantedAt: at}, nil
+}
+
+func (r *fakeTeamLeadRepo) Remove(_ context.Context, teamName, userID string) (*api.TeamLead, error) {
+	at, ok := r.leads[teamName][userID]
+	if !ok {
+		return nil, nil
+	}
+	delete(r.leads[teamName], userID)
+	return &api.TeamLead{TeamName: teamName, UserId: userID, GrantedAt: at}, nil
+}
+
+func (r *fakeTeamLeadRepo) ListTeamsByUser(_ context.Context, userID string) ([]string, error) {
+	var teams []string
+	for team, users := range r.leads {
+		if _, ok := users[userID]; ok {
+			teams = append(teams, team)
+		}
+	}
+	sort.Strings(teams)
+	return teams, nil
+}
+
+var _ repository.TeamLeadRepository = (*fakeTeamLeadRepo)(nil)
+
+// fakeAuditRepo пишется из горутины тестового HTTP-сервера, поэтому под мьютексом.
+type fakeAuditRepo struct {
+	mu      sync.Mutex
+	records []api.AuditRecord
+}
+
+func newFakeAuditRepo() *fakeAuditRepo {
+	return &fakeAuditRepo{}
+}
+
+func (r *fakeAuditRepo) Insert(_ context.Context, rec api.AuditRecord) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	rec.Id = int64(len(r.records) + 1)
+	r.records = append(r.records, rec)
+	return nil
+}
+
+func (r *fakeAuditRepo) List(_ context.Context, filter repository.AuditFilter) ([]api.AuditRecord, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var res []api.AuditRecord
+	for i := len(r.records) - 1; i >= 0 && len(res) < filter.Limit; i-- {
+		rec := r.records[i]
+		switch {
+		case filter.BeforeID != nil && rec.Id >= *filter.BeforeID:
+			continue
+		case filter.Actor != nil && (rec.Actor == nil || *rec.Actor != *filter.Actor) &&
+			(rec.ActorUserId == nil || *rec.ActorUserId != *filter.Actor):
+			continue
+		case filter.OperationID != nil && rec.OperationId != *filter.OperationID:
+			continue
+		case filter.Result != nil && rec.Result != *filter.Result:
+			continue
+		case filter.From != nil && rec.OccurredAt.Before(*filter.From):
+			continue
+		case filter.To != nil && !rec.OccurredAt.Before(*filter.To):
+			continue
+		}
+		res = append(res, rec)
+	}
+	return res, nil
+}
+
+var _ repository.AuditRepository = (*fakeAuditRepo)(nil)
@@ -0,0 +1,154 @@
+package tests
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/service"
+	"context"
+	"errors"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestUserService_ApplyDueActivations_DeactivatesAndReassigns(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := newTeamManagementFixture()
+	schedules := newFakeActivationScheduleRepo()
+	svc := service.NewUserService(f.userRepo, f.prRepo, schedules, fakeTxManager{})
+
+	leaveAt := time.Now().Add(time.Hour)
+	leave, err := svc.ScheduleActivation(ctx, api.PostUsersScheduleActivationJSONRequestBody{
+		UserId:      "u_dev1",
+		IsActive:    false,
+		EffectiveAt: leaveAt,
+	})
+	require.NoError(t, err)
+	require.Equal(t, api.ScheduledActivationStatusPENDING, leave.Status)
+
+	_, err = svc.ScheduleActivation(ctx, api.PostUsersScheduleActivationJSONRequestBody{
+		UserId:      "u_dev1",
+		IsActive:    true,
+		EffectiveAt: leaveAt.Add(48 * time.Hour),
+	})
+	require.NoError(t, err)
+
+	applied, failed, err := svc.ApplyDueActivations(ctx, time.Now())
+	require.NoError(t, err)
+	require.Zero(t, applied, "срок ещё не наступил")
+
+	applied, failed, err = svc.ApplyDueActivations(ctx, leaveAt)
+	require.NoError(t, err)
+	require.Equal(t, 1, applied)
+	require.Zero(t, failed)
+
+	u, err := f.userRepo.GetByID(ctx, "u_dev1")
+	require.NoError(t, err)
+	require.False(t, u.IsActive)
+	require.Len(t, f.prRepo.replaceCalls, 1)
+	require.Equal(t, "u_dev2", f.prRepo.replaceCalls[0].NewReviewerID)
+
+	pending, err := svc.ListScheduledActivations(ctx, api.GetUsersScheduledActivationsParams{})
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.True(t, pending[0].IsActive)
+
+	status := api.GetUsersScheduledActivationsParamsStatusAPPLIED
+	done, err := svc.ListScheduledActivations(ctx, api.GetUsersScheduledActivationsParams{Status: &status})
+	require.NoError(t, err)
+	require.Len(t, done, 1)
+	require.NotNil(t, done[0].AppliedAt)
+}
+
+func TestUserService_ScheduleActivation_ValidatesAndCancels(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := newTeamManagementFixture()
+	svc := service.NewUserService(f.userRepo, f.prRepo, newFakeActivationScheduleRepo(), fakeTxManager{})
+
+	_, err := svc.ScheduleActivation(ctx, api.PostUsersScheduleActivationJSONRequestBody{
+		UserId:      "u_dev1",
+		EffectiveAt: time.Now().Add(-time.Minute),
+	})
+	require.ErrorIs(t, err, service.ErrInvalidArgument)
+
+	_, err = svc.ScheduleActivation(ctx, api.PostUsersScheduleActivationJSONRequestBody{
+		UserId:      "u_ghost",
+		EffectiveAt: time.Now().Add(time.Hour),
+	})
+	require.ErrorIs(t, err, service.ErrNotFound)
+
+	s, err := svc.ScheduleActivation(ctx, api.PostUsersScheduleActivationJSONRequestBody{
+		UserId:      "u_dev1",
+		EffectiveAt: time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	cancelled, err := svc.CancelScheduledActivation(ctx, s.Id)
+	require.NoError(t, err)
+	require.Equal(t, api.ScheduledActivationStatusCANCELLED, cancelled.Status)
+
+	_, err = svc.CancelScheduledActivation(ctx, s.Id)
+	require.ErrorIs(t, err, service.ErrScheduleNotPending)
+	_, err = svc.CancelScheduledActivation(ctx, 99)
+	require.ErrorIs(t, err, service.ErrNotFound)
+
+	applied, _, err := svc.ApplyDueActivations(ctx, time.Now().Add(2*time.Hour))
+	require.NoError(t, err)
+	require.Zero(t, applied, "отменённое изменение не применяется")
+}
+
+// failingActivationUserRepo не даёт поменять is_active одному пользователю.
+type failingActivationUserRepo struct {
+	*fakeUserRepo
+	failFor string
+}
+
+func (r failingActivationUserRepo) SetIsActive(ctx context.Context, userID string, isActive bool) (*api.User, error) {
+	if userID == r.failFor {
+		return nil, errors.New("set is_active failed")
+	}
+	return r.fakeUserRepo.SetIsActive(ctx, userID, isActive)
+}
+
+func TestUserService_ApplyDueActivations_FailedChangeDoesNotBlockOthers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := newTeamManagementFixture()
+	schedules := newFakeActivationScheduleRepo()
+	userRepo := failingActivationUserRepo{fakeUserRepo: f.userRepo, failFor: "u_dev1"}
+	svc := service.NewUserService(userRepo, f.prRepo, schedules, fakeTxManager{})
+
+	at := time.Now().Add(time.Hour)
+	for i, userID := range []string{"u_dev1", "u_plat"} {
+		_, err := svc.ScheduleActivation(ctx, api.PostUsersScheduleActivationJSONRequestBody{
+			UserId:      userID,
+			IsActive:    false,
+			EffectiveAt: at.Add(time.Duration(i) * time.Minute),
+		})
+		require.NoError(t, err)
+	}
+
+	applied, failed, err := svc.ApplyDueActivations(ctx, at.Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 1, applied, "следующее изменение применяется после неудачного")
+	require.Equal(t, 1, failed)
+
+	u, err := f.userRepo.GetByID(ctx, "u_plat")
+	require.NoError(t, err)
+	require.False(t, u.IsActive)
+
+	status := api.GetUsersScheduledActivationsParamsStatusFAILED
+	failures, err := svc.ListScheduledActivations(ctx, api.GetUsersScheduledActivationsParams{Status: &status})
+	require.NoError(t, err)
+	require.Len(t, failures, 1)
+	require.Equal(t, "u_dev1", failures[0].UserId)
+	require.NotNil(t, failures[0].FailureReason)
+
+	applied, failed, err = svc.ApplyDueActivations(ctx, at.Add(time.Hour))
+	require.NoError(t, err)
+	require.Zero(t, applied+failed, "FAILED не выбирается повторно")
+}
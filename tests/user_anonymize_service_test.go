@@ -0,0 +1,56 @@
+package tests
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/service"
+	"context"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestUserService_AnonymizeUser(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := newTeamManagementFixture()
+	ok, err := f.userRepo.LinkExternalAccount(ctx, api.ExternalAccount{
+		UserId:   "u_dev1",
+		Provider: api.Github,
+		Login:    "dev-one",
+	})
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	userSvc := service.NewUserService(f.userRepo, f.prRepo, newFakeActivationScheduleRepo(), fakeTxManager{})
+
+	reason := "LEGAL-1"
+	res, err := userSvc.AnonymizeUser(ctx, api.PostUsersAnonymizeJSONRequestBody{UserId: "u_dev1", Reason: &reason})
+	require.NoError(t, err)
+
+	require.Equal(t, "u_dev1", res.User.UserId)
+	require.NotEqual(t, "dev1", res.User.Username)
+	require.False(t, res.User.IsActive)
+	require.Equal(t, "", res.User.TeamName)
+	require.Equal(t, 1, res.Reassignment.ReassignedCount)
+	require.Len(t, f.prRepo.replaceCalls, 1)
+	require.Equal(t, "u_dev2", f.prRepo.replaceCalls[0].NewReviewerID)
+
+	linked, err := f.userRepo.GetByExternalLogin(ctx, api.Github, "dev-one")
+	require.NoError(t, err)
+	require.Nil(t, linked)
+
+	again, err := userSvc.AnonymizeUser(ctx, api.PostUsersAnonymizeJSONRequestBody{UserId: "u_dev1"})
+	require.NoError(t, err)
+	require.Equal(t, res.AnonymizedAt, again.AnonymizedAt)
+	require.Equal(t, res.User.Username, again.User.Username)
+	require.Zero(t, again.Reassignment.ReassignedCount)
+}
+
+func TestUserService_AnonymizeUser_NotFound(t *testing.T) {
+	t.Parallel()
+
+	userSvc := service.NewUserService(newFakeUserRepo(), newFakePRRepo(), newFakeActivationScheduleRepo(), fakeTxManager{})
+
+	_, err := userSvc.AnonymizeUser(context.Background(), api.PostUsersAnonymizeJSONRequestBody{UserId: "ghost"})
+	require.ErrorIs(t, err, service.ErrNotFound)
+}
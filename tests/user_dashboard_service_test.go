@@ -0,0 +1,73 @@
+package tests
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/service"
+	"context"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestUserService_GetDashboard(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	userRepo := newFakeUserRepo()
+	userRepo.AddUser(api.User{UserId: "u1", Username: "Alice", TeamName: "backend", IsActive: true})
+	userRepo.AddUser(api.User{UserId: "u2", Username: "Bob", TeamName: "backend", IsActive: false})
+	userRepo.AddUser(api.User{UserId: "u3", Username: "Carol", TeamName: "backend", IsActive: true})
+
+	now := time.Now()
+	ago := func(days int) *time.Time {
+		v := now.AddDate(0, 0, -days)
+		return &v
+	}
+	prRepo := newFakePRRepo()
+	prRepo.AddPR(&api.PullRequest{
+		PullRequestId: "pr-new", AuthorId: "u3", Status: api.PullRequestStatusOPEN,
+		AssignedReviewers: []string{"u1"}, CreatedAt: ago(1),
+	})
+	prRepo.AddPR(&api.PullRequest{
+		PullRequestId: "pr-old", AuthorId: "u2", Status: api.PullRequestStatusOPEN,
+		AssignedReviewers: []string{"u1"}, CreatedAt: ago(40),
+	})
+	prRepo.AddPR(&api.PullRequest{
+		PullRequestId: "pr-merged", AuthorId: "u3", Status: api.PullRequestStatusMERGED,
+		AssignedReviewers: []string{"u1"}, CreatedAt: ago(10),
+	})
+	prRepo.AddPR(&api.PullRequest{
+		PullRequestId: "pr-mine", AuthorId: "u1", Status: api.PullRequestStatusOPEN,
+		AssignedReviewers: []string{"u2", "u3"}, CreatedAt: ago(2),
+	})
+
+	svc := service.NewUserService(userRepo, prRepo, newFakeActivationScheduleRepo(), fakeTxManager{})
+	leave, err := svc.ScheduleActivation(ctx, api.PostUsersScheduleActivationJSONRequestBody{
+		UserId:      "u1",
+		IsActive:    false,
+		EffectiveAt: now.Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	dashboard, err := svc.GetDashboard(ctx, "u1")
+	require.NoError(t, err)
+	require.Equal(t, "Alice", dashboard.User.Username)
+	require.True(t, dashboard.Availability.IsActive)
+	require.Equal(t, leave.Id, dashboard.Availability.NextChange.Id)
+
+	require.Len(t, dashboard.OpenReviews, 2)
+	require.Equal(t, "pr-old", dashboard.OpenReviews[0].PullRequestId, "сначала самые старые")
+	require.Equal(t, "pr-new", dashboard.OpenReviews[1].PullRequestId)
+
+	require.Len(t, dashboard.Authored, 1)
+	require.Equal(t, "pr-mine", dashboard.Authored[0].PullRequest.PullRequestId)
+	require.Equal(t, []api.ReviewerState{
+		{UserId: "u2", Username: "Bob", IsActive: false},
+		{UserId: "u3", Username: "Carol", IsActive: true},
+	}, dashboard.Authored[0].Reviewers)
+
+	require.Equal(t, api.ReviewCounts{AllTime: 3, Last30Days: 2}, dashboard.ReviewCounts)
+
+	_, err = svc.GetDashboard(ctx, "ghost")
+	require.ErrorIs(t, err, service.ErrNotFound)
+}
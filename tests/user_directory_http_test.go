@@ -0,0 +1,111 @@
+package tests
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	nethttp "avito-autumn2025-internship/internal/http"
+	"avito-autumn2025-internship/internal/service"
+	"encoding/json"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func newUserDirectoryServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	userRepo := newFakeUserRepo()
+	userRepo.AddUser(api.User{UserId: "u1", Username: "Alice", TeamName: "backend", IsActive: true})
+	userRepo.AddUser(api.User{UserId: "u2", Username: "bob", TeamName: "backend", IsActive: true})
+	userRepo.AddUser(api.User{UserId: "u3", Username: "Carol", TeamName: "backend", IsActive: false})
+	userRepo.AddUser(api.User{UserId: "u4", Username: "alina", TeamName: "platform", IsActive: true})
+	userRepo.addMembership("u4", "backend")
+
+	prRepo := newFakePRRepo()
+	prSvc := service.NewPRService(prRepo, userRepo, newFakeRepoRepo(), newFakeTeamRepo())
+	userSvc := service.NewUserService(userRepo, prRepo, newFakeActivationScheduleRepo(), fakeTxManager{})
+
+	ts := httptest.NewServer(nethttp.NewRouter(prSvc, newTeamServiceStub(), userSvc, newRepositoryServiceStub(), ""))
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func getUserPage(t *testing.T, baseURL string, query url.Values) (int, api.UserListPage) {
+	t.Helper()
+
+	resp, err := http.Get(baseURL + "/users/list?" + query.Encode())
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	var page api.UserListPage
+	if resp.StatusCode == http.StatusOK {
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&page))
+	}
+	return resp.StatusCode, page
+}
+
+func TestHTTP_UsersList_FiltersAndPaginates(t *testing.T) {
+	t.Parallel()
+
+	ts := newUserDirectoryServer(t)
+
+	query := url.Values{
+		"team_name": {"backend"},
+		"is_active": {"true"},
+		"limit":     {"2"},
+	}
+	status, page := getUserPage(t, ts.URL, query)
+	require.Equal(t, http.StatusOK, status)
+	require.Len(t, page.Users, 2)
+	require.Equal(t, "u1", page.Users[0].UserId)
+	require.Equal(t, "u2", page.Users[1].UserId)
+	require.NotNil(t, page.NextCursor)
+
+	query.Set("cursor", *page.NextCursor)
+	status, page = getUserPage(t, ts.URL, query)
+	require.Equal(t, http.StatusOK, status)
+	require.Len(t, page.Users, 1)
+	require.Equal(t, "u4", page.Users[0].UserId)
+	require.Equal(t, []string{"backend", "platform"}, *page.Users[0].Teams)
+	require.Nil(t, page.NextCursor)
+
+	query.Set("sort", "username")
+	status, _ = getUserPage(t, ts.URL, query)
+	require.Equal(t, http.StatusBadRequest, status, "курсор выдан для другой сортировки")
+}
+
+func TestHTTP_UsersList_SearchByName(t *testing.T) {
+	t.Parallel()
+
+	ts := newUserDirectoryServer(t)
+
+	status, page := getUserPage(t, ts.URL, url.Values{"q": {"ALI"}, "sort": {"username"}, "order": {"desc"}})
+	require.Equal(t, http.StatusOK, status)
+	require.Len(t, page.Users, 2)
+	require.Equal(t, "alina", page.Users[0].Username)
+	require.Equal(t, "Alice", page.Users[1].Username)
+}
+
+func TestHTTP_UsersGet(t *testing.T) {
+	t.Parallel()
+
+	ts := newUserDirectoryServer(t)
+
+	resp, err := http.Get(ts.URL + "/users/get?user_id=u4")
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body struct {
+		User api.User `json:"user"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	require.Equal(t, "platform", body.User.TeamName)
+	require.Equal(t, []string{"backend", "platform"}, *body.User.Teams)
+
+	missing, err := http.Get(ts.URL + "/users/get?user_id=nobody")
+	require.NoError(t, err)
+	defer func() { _ = missing.Body.Close() }()
+	require.Equal(t, http.StatusNotFound, missing.StatusCode)
+}
@@ -0,0 +1,33 @@
+package tests
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/service"
+	"context"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestUserService_LinkExternalAccount_LoginTaken(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	userRepo := newFakeUserRepo()
+	userRepo.AddUser(api.User{UserId: "u1", Username: "Alice", TeamName: "backend", IsActive: true})
+	userRepo.AddUser(api.User{UserId: "u2", Username: "Bob", TeamName: "backend", IsActive: true})
+	svc := service.NewUserService(userRepo, newFakePRRepo(), newFakeActivationScheduleRepo(), fakeTxManager{})
+
+	_, err := svc.LinkExternalAccount(ctx, api.ExternalAccount{UserId: "u1", Provider: api.Gitlab, Login: "alice"})
+	require.NoError(t, err)
+
+	_, err = svc.LinkExternalAccount(ctx, api.ExternalAccount{UserId: "u2", Provider: api.Gitlab, Login: "alice"})
+	require.ErrorIs(t, err, service.ErrLoginTaken)
+
+	_, err = svc.LinkExternalAccount(ctx, api.ExternalAccount{UserId: "u1", Provider: api.Gitlab, Login: "alice"})
+	require.NoError(t, err, "повторная привязка того же логина")
+
+	_, err = svc.LinkExternalAccount(ctx, api.ExternalAccount{UserId: "u1", Provider: api.Gitlab, Login: "alice.new"})
+	require.NoError(t, err)
+	_, err = svc.LinkExternalAccount(ctx, api.ExternalAccount{UserId: "u2", Provider: api.Gitlab, Login: "alice"})
+	require.NoError(t, err, "прежний логин освобождается при смене")
+}
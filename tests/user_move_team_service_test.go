@@ -0,0 +1,155 @@
+package tests
+
+import (
+	"avito-autumn2025-internship/internal/api"
+	"avito-autumn2025-internship/internal/service"
+	"context"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestTeamService_MoveUser_ReportsAndReassignsOpenReviews(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := newTeamManagementFixture()
+	f.prRepo.AddShortForReviewer("u_dev1", api.PullRequestShort{
+		PullRequestId: "pr-merged",
+		Status:        api.PullRequestShortStatusMERGED,
+	})
+
+	reassign := true
+	res, err := f.svc.MoveUser(ctx, api.PostUsersMoveTeamJSONRequestBody{
+		UserId:          "u_dev1",
+		TeamName:        "platform",
+		ReassignReviews: &reassign,
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, "backend", res.PreviousTeamName)
+	require.Equal(t, "platform", res.User.TeamName)
+	require.Len(t, res.OpenReviews, 1)
+	require.Equal(t, "pr-1", res.OpenReviews[0].PullRequestId)
+
+	require.NotNil(t, res.Reassignment)
+	require.Equal(t, 1, res.Reassignment.ReassignedCount)
+	require.Len(t, f.prRepo.replaceCalls, 1)
+	require.Equal(t, "u_dev2", f.prRepo.replaceCalls[0].NewReviewerID)
+}
+
+func TestTeamService_MoveUser_WithoutReassignKeepsReviews(t *testing.T) {
+	t.Parallel()
+
+	f := newTeamManagementFixture()
+
+	res, err := f.svc.MoveUser(context.Background(), api.PostUsersMoveTeamJSONRequestBody{
+		UserId:   "u_dev1",
+		TeamName: "platform",
+	})
+	require.NoError(t, err)
+	require.Len(t, res.OpenReviews, 1)
+	require.Nil(t, res.Reassignment)
+	require.Empty(t, f.prRepo.replaceCalls)
+}
+
+func TestTeamService_AddTeam_RefusesSilentMove(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := newTeamManagementFixture()
+
+	body := api.PostTeamAddJSONRequestBody{
+		TeamName: "payments",
+		Members: []api.TeamMember{
+			{UserId: "u_pay", Username: "pay", IsActive: true},
+			{UserId: "u_dev1", Username: "dev1", IsActive: true},
+		},
+	}
+
+	team, err := f.svc.AddTeam(ctx, body, service.MembershipRefuse)
+	require.ErrorIs(t, err, service.ErrUserInOtherTeam)
+	require.Nil(t, team)
+
+	dev1, err := f.userRepo.GetByID(ctx, "u_dev1")
+	require.NoError(t, err)
+	require.Equal(t, "backend", dev1.TeamName)
+
+	team, err = f.svc.AddTeam(ctx, body, service.MembershipMove)
+	require.NoError(t, err)
+	require.Len(t, team.Members, 2)
+
+	dev1, err = f.userRepo.GetByID(ctx, "u_dev1")
+	require.NoError(t, err)
+	require.Equal(t, "payments", dev1.TeamName)
+}
+
+func TestTeamService_AddTeam_SecondaryKeepsOtherTeamMember(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := newTeamManagementFixture()
+
+	team, err := f.svc.AddTeam(ctx, api.PostTeamAddJSONRequestBody{
+		TeamName: "payments",
+		Members: []api.TeamMember{
+			{UserId: "u_pay", Username: "pay", IsActive: true},
+			{UserId: "u_dev1", Username: "renamed", IsActive: false},
+		},
+	}, service.MembershipSecondary)
+	require.NoError(t, err)
+	require.Len(t, team.Members, 2)
+
+	dev1, err := f.userRepo.GetByID(ctx, "u_dev1")
+	require.NoError(t, err)
+	require.Equal(t, "backend", dev1.TeamName)
+	require.Equal(t, "dev1", dev1.Username, "имя участника другой команды не меняется")
+	require.True(t, dev1.IsActive, "и активность тоже")
+
+	teams, err := f.userRepo.ListTeams(ctx, "u_dev1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"backend", "payments"}, teams)
+
+	require.NoError(t, f.teamRepo.Create(ctx, "billing"))
+	_, _, err = f.svc.UpdateTeam(ctx, api.PatchTeamUpdateJSONRequestBody{
+		TeamName:   "billing",
+		AddMembers: &[]api.TeamMember{{UserId: "u_dev2", Username: "dev2", IsActive: true}},
+	})
+	require.ErrorIs(t, err, service.ErrUserInOtherTeam)
+
+	allowTeamMove := true
+	_, res, err := f.svc.UpdateTeam(ctx, api.PatchTeamUpdateJSONRequestBody{
+		TeamName:      "billing",
+		AddMembers:    &[]api.TeamMember{{UserId: "u_dev2", Username: "dev2", IsActive: true}},
+		AllowTeamMove: &allowTeamMove,
+	})
+	require.NoError(t, err)
+	require.Zero(t, res.ReassignedCount)
+
+	dev2, err := f.userRepo.GetByID(ctx, "u_dev2")
+	require.NoError(t, err)
+	require.Equal(t, "billing", dev2.TeamName)
+
+	teams, err = f.userRepo.ListTeams(ctx, "u_dev2")
+	require.NoError(t, err)
+	require.Equal(t, []string{"billing"}, teams)
+}
+
+func TestTeamService_MoveUser_KeepsReviewsOutsidePreviousTeam(t *testing.T) {
+	t.Parallel()
+
+	f := newTeamManagementFixture()
+	f.addPlatformReview(t)
+
+	reassign := true
+	res, err := f.svc.MoveUser(context.Background(), api.PostUsersMoveTeamJSONRequestBody{
+		UserId:          "u_dev1",
+		TeamName:        "platform",
+		ReassignReviews: &reassign,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.OpenReviews, 1)
+	require.Equal(t, "pr-1", res.OpenReviews[0].PullRequestId)
+	require.Equal(t, 1, res.Reassignment.ReassignedCount)
+	require.Len(t, f.prRepo.replaceCalls, 1)
+	require.Equal(t, "pr-1", f.prRepo.replaceCalls[0].PRID, "ревью на PR platform не переназначается")
+}